@@ -0,0 +1,118 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/nomkhonwaan/myblog/pkg/backup"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	// BackupCmd is a command of "backup" for archiving all collections and storage objects into a single tarball
+	BackupCmd = &cobra.Command{
+		Use:     "backup",
+		Short:   "Archive all collections and storage objects into a compressed tarball",
+		PreRunE: bindFlagsPreRunE,
+		RunE:    backupRunE,
+	}
+
+	// RestoreCmd is a command of "restore" for loading a tarball which created by the "backup" command
+	RestoreCmd = &cobra.Command{
+		Use:     "restore <file.tar.gz>",
+		Short:   "Verify and load a backup tarball into an empty database and bucket",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: bindFlagsPreRunE,
+		RunE:    restoreRunE,
+	}
+)
+
+func init() {
+	BackupCmd.Flags().StringP("output", "o", fmt.Sprintf("myblog-%s.tar.gz", time.Now().Format("20060102150405")), "")
+	addDatabaseFlags(BackupCmd.Flags())
+	addStorageFlags(BackupCmd.Flags())
+
+	RestoreCmd.Flags().Bool("dry-run", false, "Verify the tarball integrity and the target emptiness without loading anything")
+	addDatabaseFlags(RestoreCmd.Flags())
+	addStorageFlags(RestoreCmd.Flags())
+}
+
+func backupRunE(_ *cobra.Command, _ []string) error {
+	db, err := newMongoDB(viper.GetString("mongodb-uri"), viper.GetString("db-name"))
+	if err != nil {
+		return err
+	}
+
+	bucket, err := newBlobStorage()
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	f, err := os.Create(viper.GetString("output"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	manifest, err := backup.NewArchiver(db, bucket).Create(context.Background(), f)
+	if err != nil {
+		return err
+	}
+
+	logrus.Infof("%d collections and %d storage objects have been archived to %s",
+		len(manifest.Collections), len(manifest.Objects), f.Name())
+	return nil
+}
+
+func restoreRunE(_ *cobra.Command, args []string) error {
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	manifest, err := backup.Verify(f)
+	if err != nil {
+		return err
+	}
+	for _, c := range manifest.Collections {
+		logrus.Infof("%s: %d documents", c.Name, c.Documents)
+	}
+	logrus.Infof("storage: %d objects", len(manifest.Objects))
+
+	db, err := newMongoDB(viper.GetString("mongodb-uri"), viper.GetString("db-name"))
+	if err != nil {
+		return err
+	}
+
+	bucket, err := newBlobStorage()
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	archiver := backup.NewArchiver(db, bucket)
+	if err = archiver.CheckEmpty(context.Background(), manifest); err != nil {
+		return err
+	}
+
+	if viper.GetBool("dry-run") {
+		logrus.Infof("%s has been verified successfully, nothing was restored", args[0])
+		return nil
+	}
+
+	if _, err = f.Seek(0, 0); err != nil {
+		return err
+	}
+	if err = archiver.Restore(context.Background(), f); err != nil {
+		return err
+	}
+
+	logrus.Infof("%s has been restored successfully", args[0])
+	return nil
+}
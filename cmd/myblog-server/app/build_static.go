@@ -0,0 +1,72 @@
+package app
+
+import (
+	"context"
+	"html/template"
+
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/data"
+	"github.com/nomkhonwaan/myblog/pkg/image"
+	"github.com/nomkhonwaan/myblog/pkg/static"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	// BuildStaticCmd is a command of "build-static" for rendering all published content to a static site
+	BuildStaticCmd = &cobra.Command{
+		Use:     "build-static <outdir>",
+		Short:   "Render all published content to HTML files for hosting on static file servers",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: bindFlagsPreRunE,
+		RunE:    buildStaticRunE,
+	}
+)
+
+func init() {
+	BuildStaticCmd.Flags().String("base-url", "https://www.nomkhonwaan.com", "")
+	BuildStaticCmd.Flags().String("site-name", "Nomkhonwaan", "")
+	BuildStaticCmd.Flags().Int("items-per-page", 5, "Number of posts on each listing page")
+	BuildStaticCmd.Flags().IntSlice("image-widths", []int{420, 768, 1024, 1440}, "List of image widths for the srcset attribute")
+	addDatabaseFlags(BuildStaticCmd.Flags())
+	addStorageFlags(BuildStaticCmd.Flags())
+}
+
+func buildStaticRunE(_ *cobra.Command, args []string) error {
+	db, err := newMongoDB(viper.GetString("mongodb-uri"), viper.GetString("db-name"))
+	if err != nil {
+		return err
+	}
+
+	bucket, err := newBlobStorage()
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	tmplData, _ := unzip(data.MustGzipAsset("data/static-site-template.html"))
+	tmpl, err := template.New("data/static-site-template.html").Funcs(static.FuncMap).Parse(string(tmplData))
+	if err != nil {
+		return err
+	}
+
+	g := static.Generator{
+		BaseURL:            viper.GetString("base-url"),
+		SiteName:           viper.GetString("site-name"),
+		Fs:                 afero.NewOsFs(),
+		OutDir:             args[0],
+		Template:           tmpl,
+		ItemsPerPage:       viper.GetInt("items-per-page"),
+		ImageWidths:        viper.GetIntSlice("image-widths"),
+		Storage:            bucket,
+		Resizer:            image.NewLanczosResizer(),
+		CategoryRepository: blog.NewCategoryRepository(db),
+		TagRepository:      blog.NewTagRepository(db),
+		PostRepository:     blog.NewPostRepository(db),
+		FileRepository:     storage.NewFileRepository(db),
+	}
+
+	return g.Generate(context.Background())
+}
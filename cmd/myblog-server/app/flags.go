@@ -0,0 +1,30 @@
+package app
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func addDatabaseFlags(flags *pflag.FlagSet) {
+	flags.String("mongodb-uri", "mongodb://localhost/nomkhonwaan_com", "")
+	flags.String("db-name", "nomkhonwaan_com", "")
+}
+
+func addStorageFlags(flags *pflag.FlagSet) {
+	flags.String("storage-driver", "s3", "")
+	flags.String("amazon-s3-region", "ap-southeast-1", "")
+	flags.String("amazon-s3-access-key", "", "")
+	flags.String("amazon-s3-secret-key", "", "")
+	flags.String("amazon-s3-bucket-name", "", "")
+}
+
+// bindFlagsPreRunE binds the command flags to viper at run time.
+// The "serve" command binds the same keys on init, which is fine since only one command is executed per process.
+func bindFlagsPreRunE(cmd *cobra.Command, args []string) error {
+	if err := viper.BindPFlags(cmd.Flags()); err != nil {
+		return err
+	}
+
+	return preRunE(cmd, args)
+}
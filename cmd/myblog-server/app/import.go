@@ -0,0 +1,108 @@
+package app
+
+import (
+	"context"
+	"encoding/csv"
+	"os"
+	"path"
+
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"github.com/nomkhonwaan/myblog/pkg/wordpress"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	// ImportCmd is a root command of "import" for importing content from other blog platforms
+	ImportCmd = &cobra.Command{
+		Use:   "import",
+		Short: "Import content from other blog platforms",
+	}
+
+	// ImportWordPressCmd is a command of "import wordpress" for importing a WordPress eXtended RSS (WXR) export file
+	ImportWordPressCmd = &cobra.Command{
+		Use:     "wordpress <file.xml>",
+		Short:   "Import posts, pages, categories, tags and media from a WordPress export file",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: bindFlagsPreRunE,
+		RunE:    importWordPressRunE,
+	}
+)
+
+func init() {
+	workingDirectory, _ := os.Getwd()
+
+	ImportWordPressCmd.Flags().String("base-url", "https://www.nomkhonwaan.com", "")
+	addDatabaseFlags(ImportWordPressCmd.Flags())
+	addStorageFlags(ImportWordPressCmd.Flags())
+	ImportWordPressCmd.Flags().String("author-id", "", "An author ID who will own all imported posts and files")
+	ImportWordPressCmd.Flags().String("uploads-dir", path.Join(workingDirectory, "wp-content", "uploads"), "A local copy of the WordPress uploads directory")
+	ImportWordPressCmd.Flags().String("redirect-map", path.Join(workingDirectory, "redirects.csv"), "A CSV file to write the original and new URLs")
+	_ = ImportWordPressCmd.MarkFlagRequired("author-id")
+
+	ImportCmd.AddCommand(ImportWordPressCmd)
+}
+
+func importWordPressRunE(_ *cobra.Command, args []string) error {
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	ch, err := wordpress.Parse(f)
+	if err != nil {
+		return err
+	}
+
+	db, err := newMongoDB(viper.GetString("mongodb-uri"), viper.GetString("db-name"))
+	if err != nil {
+		return err
+	}
+
+	bucket, err := newBlobStorage()
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	im := wordpress.Importer{
+		AuthorID:           viper.GetString("author-id"),
+		BaseURL:            viper.GetString("base-url"),
+		UploadsDir:         viper.GetString("uploads-dir"),
+		Fs:                 afero.NewOsFs(),
+		Storage:            bucket,
+		CategoryRepository: blog.NewCategoryRepository(db),
+		TagRepository:      blog.NewTagRepository(db),
+		PostRepository:     blog.NewPostRepository(db),
+		FileRepository:     storage.NewFileRepository(db),
+	}
+
+	redirects, err := im.Import(context.Background(), ch)
+	if err != nil {
+		return err
+	}
+
+	return writeRedirectMap(viper.GetString("redirect-map"), redirects)
+}
+
+func writeRedirectMap(name string, redirects []wordpress.Redirect) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	_ = w.Write([]string{"from", "to"})
+	for _, r := range redirects {
+		_ = w.Write([]string{r.From, r.To})
+	}
+	w.Flush()
+
+	logrus.Infof("%d redirects have been written to %s", len(redirects), name)
+	return w.Error()
+}
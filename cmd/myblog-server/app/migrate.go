@@ -0,0 +1,100 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/nomkhonwaan/myblog/pkg/migration"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	// MigrateCmd is a command of "migrate" for managing the database schema
+	MigrateCmd = &cobra.Command{
+		Use:   "migrate",
+		Short: "Manage versioned database migrations",
+	}
+
+	// MigrateUpCmd is a sub-command of "migrate" for applying all pending migrations
+	MigrateUpCmd = &cobra.Command{
+		Use:     "up",
+		Short:   "Apply all pending migrations in the version order",
+		PreRunE: bindFlagsPreRunE,
+		RunE:    migrateUpRunE,
+	}
+
+	// MigrateStatusCmd is a sub-command of "migrate" for listing all migrations with their statuses
+	MigrateStatusCmd = &cobra.Command{
+		Use:     "status",
+		Short:   "List all migrations and whether they have been applied",
+		PreRunE: bindFlagsPreRunE,
+		RunE:    migrateStatusRunE,
+	}
+)
+
+func init() {
+	addDatabaseFlags(MigrateUpCmd.Flags())
+	addDatabaseFlags(MigrateStatusCmd.Flags())
+
+	MigrateCmd.AddCommand(MigrateUpCmd)
+	MigrateCmd.AddCommand(MigrateStatusCmd)
+}
+
+func migrateUpRunE(_ *cobra.Command, _ []string) error {
+	db, err := newMongoDB(viper.GetString("mongodb-uri"), viper.GetString("db-name"))
+	if err != nil {
+		return err
+	}
+
+	applied, err := migration.NewMigrator(db, migration.Migrations).Up(context.Background())
+	for _, m := range applied {
+		logrus.Infof("migration %d: %s has been applied", m.Version, m.Description)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(applied) == 0 {
+		logrus.Info("the database schema is up to date")
+	}
+	return nil
+}
+
+func migrateStatusRunE(cmd *cobra.Command, _ []string) error {
+	db, err := newMongoDB(viper.GetString("mongodb-uri"), viper.GetString("db-name"))
+	if err != nil {
+		return err
+	}
+
+	statuses, err := migration.NewMigrator(db, migration.Migrations).Status(context.Background())
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "VERSION\tDESCRIPTION\tAPPLIED AT")
+	for _, s := range statuses {
+		appliedAt := "pending"
+		if !s.IsPending() {
+			appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\n", s.Version, s.Description, appliedAt)
+	}
+
+	return w.Flush()
+}
+
+// checkMigrations returns an error if there is any pending migration
+func checkMigrations(ctx context.Context, m migration.Migrator) error {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("the database schema is behind by %d migrations, please run \"migrate up\" first", len(pending))
+	}
+	return nil
+}
@@ -5,8 +5,10 @@ import (
 	"compress/gzip"
 	"context"
 	"errors"
+	"fmt"
 	"html/template"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -18,21 +20,32 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/disintegration/imaging"
 	"github.com/go-chi/chi"
 	"github.com/nomkhonwaan/myblog/internal/blob"
+	"github.com/nomkhonwaan/myblog/pkg/analytics"
 	"github.com/nomkhonwaan/myblog/pkg/auth"
 	"github.com/nomkhonwaan/myblog/pkg/blog"
 	"github.com/nomkhonwaan/myblog/pkg/data"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
 	"github.com/nomkhonwaan/myblog/pkg/facebook"
+	"github.com/nomkhonwaan/myblog/pkg/feed"
 	"github.com/nomkhonwaan/myblog/pkg/github"
 	"github.com/nomkhonwaan/myblog/pkg/graphql"
 	"github.com/nomkhonwaan/myblog/pkg/image"
+	"github.com/nomkhonwaan/myblog/pkg/indexnow"
+	"github.com/nomkhonwaan/myblog/pkg/migration"
 	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	"github.com/nomkhonwaan/myblog/pkg/oembed"
 	"github.com/nomkhonwaan/myblog/pkg/opengraph"
+	"github.com/nomkhonwaan/myblog/pkg/ratelimit"
+	"github.com/nomkhonwaan/myblog/pkg/robots"
 	"github.com/nomkhonwaan/myblog/pkg/server"
 	"github.com/nomkhonwaan/myblog/pkg/sitemap"
+	"github.com/nomkhonwaan/myblog/pkg/static"
 	"github.com/nomkhonwaan/myblog/pkg/storage"
 	"github.com/nomkhonwaan/myblog/pkg/web"
+	"github.com/nomkhonwaan/myblog/pkg/webhook"
 	"github.com/samsarahq/thunder/graphql/introspection"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
@@ -41,6 +54,7 @@ import (
 	"go.mongodb.org/mongo-driver/x/mongo/driver/connstring"
 	"gocloud.dev/blob/s3blob"
 	_ "gocloud.dev/blob/s3blob"
+	"golang.org/x/image/font/gofont/gomedium"
 )
 
 var (
@@ -59,6 +73,10 @@ func init() {
 	Cmd.Flags().Bool("allow-cors", false, "")
 	Cmd.Flags().String("listen-address", "0.0.0.0:8080", "")
 	Cmd.Flags().String("base-url", "https://www.nomkhonwaan.com", "")
+	Cmd.Flags().String("site-name", "Nomkhonwaan", "")
+	Cmd.Flags().String("site-description", "", "")
+	Cmd.Flags().String("author-name", "Natcha Luangaroonchai", "")
+	Cmd.Flags().StringSlice("admin-user-ids", nil, "")
 	Cmd.Flags().String("cache-file-path", path.Join(workingDirectory, ".cache"), "")
 	Cmd.Flags().String("static-file-path", path.Join(workingDirectory, "dist", "web"), "")
 	Cmd.Flags().String("mongodb-uri", "mongodb://localhost/nomkhonwaan_com", "")
@@ -72,10 +90,36 @@ func init() {
 	Cmd.Flags().String("auth0-issuer", "https://nomkhonwaan.auth0.com/", "")
 	Cmd.Flags().String("auth0-jwks-uri", "https://nomkhonwaan.auth0.com/.well-known/jwks.json", "")
 	Cmd.Flags().String("facebook-app-access-token", "", "")
+	Cmd.Flags().Duration("view-flush-interval", time.Minute, "")
+	Cmd.Flags().Int("webhook-max-attempts", 5, "")
+	Cmd.Flags().Duration("webhook-backoff", time.Second*10, "")
+	Cmd.Flags().Int("graphql-max-depth", 10, "")
+	Cmd.Flags().Int("graphql-max-cost", 1000, "")
+	Cmd.Flags().String("graphql-persisted-queries-file", "", "")
+	Cmd.Flags().Bool("graphql-allow-list-only", false, "")
+	Cmd.Flags().StringSlice("rate-limit-trusted-proxies", nil, "")
+	Cmd.Flags().String("rate-limit-api", "600/1m", "")
+	Cmd.Flags().String("rate-limit-image", "300/1m", "")
+	Cmd.Flags().String("rate-limit-upload", "30/1h", "")
+	Cmd.Flags().String("rate-limit-graphql", "300/1m", "")
+	Cmd.Flags().StringSlice("robots-allow", []string{"/api/v2.1/storage/"}, "")
+	Cmd.Flags().StringSlice("robots-disallow", []string{"/graphiql", "/api/"}, "")
+	Cmd.Flags().String("indexnow-endpoint", indexnow.DefaultEndpoint, "")
+	Cmd.Flags().String("indexnow-key", "", "")
+	Cmd.Flags().Duration("indexnow-batch-interval", time.Minute, "")
+	Cmd.Flags().StringSlice("opengraph-crawlers", opengraph.DefaultCrawlers, "")
+	Cmd.Flags().String("search-url-template", "", "")
+	Cmd.Flags().StringSlice("prerender-bots", opengraph.DefaultBots, "")
+	Cmd.Flags().Int("items-per-page", 5, "")
+	Cmd.Flags().String("cover-template-file", "", "")
 
 	_ = viper.BindPFlag("allow-cors", Cmd.Flags().Lookup("allow-cors"))
 	_ = viper.BindPFlag("listen-address", Cmd.Flags().Lookup("listen-address"))
 	_ = viper.BindPFlag("base-url", Cmd.Flags().Lookup("base-url"))
+	_ = viper.BindPFlag("site-name", Cmd.Flags().Lookup("site-name"))
+	_ = viper.BindPFlag("site-description", Cmd.Flags().Lookup("site-description"))
+	_ = viper.BindPFlag("author-name", Cmd.Flags().Lookup("author-name"))
+	_ = viper.BindPFlag("admin-user-ids", Cmd.Flags().Lookup("admin-user-ids"))
 	_ = viper.BindPFlag("cache-file-path", Cmd.Flags().Lookup("cache-file-path"))
 	_ = viper.BindPFlag("static-file-path", Cmd.Flags().Lookup("static-file-path"))
 	_ = viper.BindPFlag("mongodb-uri", Cmd.Flags().Lookup("mongodb-uri"))
@@ -89,6 +133,28 @@ func init() {
 	_ = viper.BindPFlag("auth0-issuer", Cmd.Flags().Lookup("auth0-issuer"))
 	_ = viper.BindPFlag("auth0-jwks-uri", Cmd.Flags().Lookup("auth0-jwks-uri"))
 	_ = viper.BindPFlag("facebook-app-access-token", Cmd.Flags().Lookup("facebook-app-access-token"))
+	_ = viper.BindPFlag("view-flush-interval", Cmd.Flags().Lookup("view-flush-interval"))
+	_ = viper.BindPFlag("webhook-max-attempts", Cmd.Flags().Lookup("webhook-max-attempts"))
+	_ = viper.BindPFlag("webhook-backoff", Cmd.Flags().Lookup("webhook-backoff"))
+	_ = viper.BindPFlag("graphql-max-depth", Cmd.Flags().Lookup("graphql-max-depth"))
+	_ = viper.BindPFlag("graphql-max-cost", Cmd.Flags().Lookup("graphql-max-cost"))
+	_ = viper.BindPFlag("graphql-persisted-queries-file", Cmd.Flags().Lookup("graphql-persisted-queries-file"))
+	_ = viper.BindPFlag("graphql-allow-list-only", Cmd.Flags().Lookup("graphql-allow-list-only"))
+	_ = viper.BindPFlag("rate-limit-trusted-proxies", Cmd.Flags().Lookup("rate-limit-trusted-proxies"))
+	_ = viper.BindPFlag("rate-limit-api", Cmd.Flags().Lookup("rate-limit-api"))
+	_ = viper.BindPFlag("rate-limit-image", Cmd.Flags().Lookup("rate-limit-image"))
+	_ = viper.BindPFlag("rate-limit-upload", Cmd.Flags().Lookup("rate-limit-upload"))
+	_ = viper.BindPFlag("rate-limit-graphql", Cmd.Flags().Lookup("rate-limit-graphql"))
+	_ = viper.BindPFlag("robots-allow", Cmd.Flags().Lookup("robots-allow"))
+	_ = viper.BindPFlag("robots-disallow", Cmd.Flags().Lookup("robots-disallow"))
+	_ = viper.BindPFlag("indexnow-endpoint", Cmd.Flags().Lookup("indexnow-endpoint"))
+	_ = viper.BindPFlag("indexnow-key", Cmd.Flags().Lookup("indexnow-key"))
+	_ = viper.BindPFlag("indexnow-batch-interval", Cmd.Flags().Lookup("indexnow-batch-interval"))
+	_ = viper.BindPFlag("opengraph-crawlers", Cmd.Flags().Lookup("opengraph-crawlers"))
+	_ = viper.BindPFlag("search-url-template", Cmd.Flags().Lookup("search-url-template"))
+	_ = viper.BindPFlag("prerender-bots", Cmd.Flags().Lookup("prerender-bots"))
+	_ = viper.BindPFlag("items-per-page", Cmd.Flags().Lookup("items-per-page"))
+	_ = viper.BindPFlag("cover-template-file", Cmd.Flags().Lookup("cover-template-file"))
 }
 
 func preRunE(cmd *cobra.Command, _ []string) error {
@@ -113,13 +179,26 @@ func runE(_ *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if err = checkMigrations(context.Background(), migration.NewMigrator(db, migration.Migrations)); err != nil {
+		return err
+	}
+
 	var (
 		fileRepository     = storage.NewFileRepository(db)
 		categoryRepository = blog.NewCategoryRepository(db)
 		postRepository     = blog.NewPostRepository(db)
 		tagRepository      = blog.NewTagRepository(db)
+		viewRepository     = analytics.NewViewRepository(db)
+		webhookRepository  = webhook.NewWebhookRepository(db)
+		deliveryRepository = webhook.NewDeliveryRepository(db)
 	)
 
+	dispatcher := webhook.NewHTTPDispatcher(webhookRepository, deliveryRepository, webhook.NewPublicTransport(),
+		viper.GetInt("webhook-max-attempts"), viper.GetDuration("webhook-backoff"))
+	if err = dispatcher.Resume(context.Background()); err != nil {
+		return err
+	}
+
 	cache, err := storage.NewDiskCache(afero.NewOsFs(), viper.GetString("cache-file-path"))
 	if err != nil {
 		return err
@@ -132,22 +211,86 @@ func runE(_ *cobra.Command, _ []string) error {
 	}
 	defer bucket.Close()
 
+	siteMapSections := []sitemap.Section{
+		{Name: "pages", GenerateURLs: sitemap.GenerateFixedURLs(baseURL)},
+		{Name: "posts", GenerateURLs: sitemap.GeneratePostURLs(baseURL, postRepository, fileRepository)},
+		{Name: "categories", GenerateURLs: sitemap.GenerateCategoryURLs(baseURL, categoryRepository, postRepository)},
+		{Name: "tags", GenerateURLs: sitemap.GenerateTagURLs(baseURL, tagRepository, postRepository)},
+	}
+
+	bus := eventbus.NewLocalBus()
+	webhook.Subscribe(bus, dispatcher)
+	sitemap.Subscribe(bus, cache, siteMapSections...)
+	feed.Subscribe(bus, cache)
+	oembed.Subscribe(bus, cache)
+	opengraph.Subscribe(bus, cache, categoryRepository)
+
+	indexNowKey := viper.GetString("indexnow-key")
+	submitter, err := indexnow.NewHTTPSubmitter(viper.GetString("indexnow-endpoint"), baseURL, indexNowKey, http.DefaultTransport)
+	if err != nil {
+		return err
+	}
+	batchSubmitter, err := indexnow.NewBatchSubmitter(submitter, viper.GetDuration("indexnow-batch-interval"))
+	if err != nil {
+		return fmt.Errorf("indexnow-batch-interval: %s", err)
+	}
+	if indexNowKey != "" {
+		indexnow.Subscribe(bus, batchSubmitter, baseURL)
+	}
+
 	ogTmplData, _ := unzip(data.MustGzipAsset("data/opengraph-template.html"))
 	ogTmpl := template.Must(template.New("data/opengraph-template.html").Parse(string(ogTmplData)))
+	prerenderTmplData, _ := unzip(data.MustGzipAsset("data/prerender-template.html"))
+	prerenderTmpl := template.Must(template.Must(ogTmpl.Clone()).New("data/prerender-template.html").
+		Funcs(static.FuncMap).Parse(string(prerenderTmplData)))
+
+	coverDrawer, err := newCoverDrawer(viper.GetString("cover-template-file"))
+	if err != nil {
+		return err
+	}
 
 	schema, err := graphql.BuildSchema(
-		graphql.BuildCategorySchema(categoryRepository),
+		graphql.BuildCategorySchema(categoryRepository, bus),
 		graphql.BuildTagSchema(tagRepository),
-		graphql.BuildPostSchema(postRepository),
+		graphql.BuildPostSchema(postRepository, categoryRepository,
+			graphql.NewPostValidator(categoryRepository, tagRepository, fileRepository), bus),
 		graphql.BuildFileSchema(fileRepository),
 		graphql.BuildGraphAPISchema(baseURL, facebook.NewClient(
 			viper.GetString("facebook-app-access-token"), http.DefaultTransport)),
+		graphql.BuildViewSchema(viewRepository, postRepository),
+		graphql.BuildWebhookSchema(webhookRepository, deliveryRepository, net.DefaultResolver),
 	)
 	if err != nil {
 		return err
 	}
 	introspection.AddIntrospectionToSchema(schema)
 
+	persistedQueries, err := newPersistedQueries(viper.GetString("graphql-persisted-queries-file"))
+	if err != nil {
+		return err
+	}
+	if viper.GetBool("graphql-allow-list-only") && len(persistedQueries) == 0 {
+		return errors.New("allow-list mode requires the persisted queries file")
+	}
+
+	trustedProxies, err := ratelimit.ParseTrustedProxies(viper.GetStringSlice("rate-limit-trusted-proxies"))
+	if err != nil {
+		return err
+	}
+	rateLimitPolicies, err := newRateLimitPolicies("api", "image", "upload", "graphql")
+	if err != nil {
+		return err
+	}
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.NewKeyFunc(trustedProxies))
+
+	stopCh := handleSignals()
+	recorder := analytics.NewRecorder(viewRepository, viper.GetDuration("view-flush-interval"))
+	recorderDoneCh := recorder.Start(stopCh)
+	var batchSubmitterDoneCh <-chan struct{}
+	if indexNowKey != "" {
+		batchSubmitterDoneCh = batchSubmitter.Start(stopCh)
+	}
+
 	r := chi.NewRouter()
 
 	if viper.GetBool("allow-cors") {
@@ -161,31 +304,103 @@ func runE(_ *cobra.Command, _ []string) error {
 	).Handler)
 
 	r.Route("/api/v2.1", func(r chi.Router) {
-		r.Route("/github", func(r chi.Router) {
-			r.Get("/gist", github.GetGistHandlerFunc(cache, http.DefaultTransport))
+		// Each route is counted by only one policy, the image and upload routes have their own policies
+		apiLimit := limiter.Middleware(rateLimitPolicies["api"])
+
+		r.Group(func(r chi.Router) {
+			r.Use(apiLimit)
+
+			r.Route("/posts", func(r chi.Router) {
+				r.Get("/", blog.FindAllPublishedPostsHandlerFunc(postRepository))
+				r.Get("/{slug}", blog.FindPostBySlugHandlerFunc(postRepository))
+			})
+			r.Route("/categories", func(r chi.Router) {
+				r.Get("/", blog.FindAllCategoriesHandlerFunc(categoryRepository))
+				r.Get("/{slug}", blog.FindCategoryBySlugHandlerFunc(categoryRepository))
+				r.Get("/{slug}/posts", blog.FindAllPublishedPostsBelongedToCategoryHandlerFunc(postRepository, categoryRepository))
+			})
+			r.Route("/tags", func(r chi.Router) {
+				r.Get("/", blog.FindAllTagsHandlerFunc(tagRepository))
+				r.Get("/{slug}", blog.FindTagBySlugHandlerFunc(tagRepository))
+				r.Get("/{slug}/posts", blog.FindAllPublishedPostsBelongedToTagHandlerFunc(postRepository, tagRepository))
+			})
+			r.Route("/github", func(r chi.Router) {
+				r.Get("/gist", github.GetGistHandlerFunc(cache, http.DefaultTransport))
+			})
+			r.Post("/views/{slug}", analytics.RecordViewHandlerFunc(recorder, postRepository))
 		})
 		r.Route("/storage", func(r chi.Router) {
-			r.Get("/{slug}", storage.DownloadHandlerFunc(bucket, cache, image.NewLanczosResizer(), fileRepository))
-			r.Delete("/{slug}/delete", storage.DeleteHandlerFunc(bucket, fileRepository))
-			r.Post("/upload", storage.UploadHandlerFunc(bucket, fileRepository))
+			r.With(limiter.Middleware(rateLimitPolicies["image"])).
+				Get("/{slug}", storage.DownloadHandlerFunc(bucket, cache, image.NewLanczosResizer(), fileRepository))
+			r.With(apiLimit).
+				Delete("/{slug}/delete", storage.DeleteHandlerFunc(bucket, fileRepository, bus))
+			r.With(limiter.Middleware(rateLimitPolicies["upload"])).
+				Post("/upload", storage.UploadHandlerFunc(bucket, fileRepository, bus))
 		})
 	})
-	r.With(opengraph.ServeStaticSinglePageMiddleware(baseURL, ogTmpl, postRepository, fileRepository)).
-		Get("/*", web.ServeStaticHandlerFunc(viper.GetString("static-file-path")))
+	ogRenderer := opengraph.Renderer{
+		BaseURL:            baseURL,
+		SiteName:           viper.GetString("site-name"),
+		Description:        viper.GetString("site-description"),
+		AuthorName:         viper.GetString("author-name"),
+		SearchURLTemplate:  viper.GetString("search-url-template"),
+		Template:           ogTmpl,
+		CategoryRepository: categoryRepository,
+		TagRepository:      tagRepository,
+		PostRepository:     postRepository,
+		FileRepository:     fileRepository,
+	}
+	r.With(
+		opengraph.ServeCrawlerPageMiddleware(viper.GetStringSlice("opengraph-crawlers"), ogRenderer),
+		opengraph.ServePrerenderedPageMiddleware(viper.GetStringSlice("prerender-bots"), cache, opengraph.Prerenderer{
+			Renderer:     ogRenderer,
+			Template:     prerenderTmpl,
+			ItemsPerPage: viper.GetInt("items-per-page"),
+		}),
+	).Get("/*", web.ServeStaticHandlerFunc(viper.GetString("static-file-path")))
+	r.Get("/covers/{slug}.png", opengraph.ServeCoverHandlerFunc(cache, coverDrawer, ogRenderer))
 	r.Get("/graphiql", graphql.ServeGraphiqlHandlerFunc(data.MustGzipAsset("data/graphql-playground.html")))
-	r.Handle("/graphql", graphql.Handler(schema, graphql.VerifyAuthorityMiddleware))
-	r.Get("/sitemap.xml", sitemap.ServeSiteMapHandlerFunc(cache,
-		sitemap.GenerateFixedURLs(baseURL),
-		sitemap.GeneratePostURLs(baseURL, postRepository),
-		sitemap.GenerateCategoryURLs(baseURL, categoryRepository),
-		sitemap.GenerateTagURLs(baseURL, tagRepository),
-	))
+	verifyAdmin := graphql.VerifyAdminMiddleware(viper.GetStringSlice("admin-user-ids"))
+	complexityLimit := graphql.ComplexityLimitMiddleware(schema, viper.GetInt("graphql-max-depth"), viper.GetInt("graphql-max-cost"))
+	graphqlRateLimit := limiter.Middleware(rateLimitPolicies["graphql"])
+	r.With(graphqlRateLimit, graphql.PersistedQueryMiddleware(cache, persistedQueries, viper.GetBool("graphql-allow-list-only"))).
+		Handle("/graphql", graphql.Handler(schema, complexityLimit, graphql.VerifyAuthorityMiddleware, verifyAdmin))
+	r.With(graphqlRateLimit).
+		Handle("/graphql/ws", graphql.WebSocketHandler(schema,
+			graphql.AllowListMiddleware(persistedQueries, viper.GetBool("graphql-allow-list-only")),
+			complexityLimit, graphql.VerifyAuthorityMiddleware, verifyAdmin))
+	r.Get("/sitemap.xml", sitemap.ServeSiteMapIndexHandlerFunc(baseURL, cache, siteMapSections...))
+	r.Get("/sitemap-{name}-{page}.xml", sitemap.ServeSiteMapHandlerFunc(cache, siteMapSections...))
+	r.Get("/robots.txt", robots.ServeRobotsHandlerFunc(baseURL, viper.GetStringSlice("robots-allow"), viper.GetStringSlice("robots-disallow")))
+	if indexNowKey != "" {
+		r.Get("/"+indexNowKey+".txt", indexnow.ServeKeyHandlerFunc(indexNowKey))
+	}
+	feedGenerator := feed.Generator{
+		BaseURL:            baseURL,
+		SiteName:           viper.GetString("site-name"),
+		CategoryRepository: categoryRepository,
+		TagRepository:      tagRepository,
+		PostRepository:     postRepository,
+		FileRepository:     fileRepository,
+	}
+	for _, format := range feed.Formats {
+		r.Get("/"+format.FileName(), feed.ServeFeedHandlerFunc(cache, feedGenerator, format))
+		r.Get("/category/{slug}/"+format.FileName(), feed.ServeCategoryFeedHandlerFunc(cache, feedGenerator, format))
+		r.Get("/tag/{slug}/"+format.FileName(), feed.ServeTagFeedHandlerFunc(cache, feedGenerator, format))
+	}
+	r.Get(oembed.EndpointPath, oembed.ServeOEmbedHandlerFunc(cache, oembed.Provider{
+		BaseURL:        baseURL,
+		SiteName:       viper.GetString("site-name"),
+		AuthorName:     viper.GetString("author-name"),
+		Storage:        bucket,
+		PostRepository: postRepository,
+		FileRepository: fileRepository,
+	}))
 
 	s := server.InsecureServer{
 		Handler:         r,
 		ShutdownTimeout: time.Minute * 5,
 	}
-	stopCh := handleSignals()
 
 	err = s.ListenAndServe(viper.GetString("listen-address"), stopCh)
 	if err != nil {
@@ -193,6 +408,12 @@ func runE(_ *cobra.Command, _ []string) error {
 	}
 
 	<-stopCh
+	<-recorderDoneCh
+	if batchSubmitterDoneCh != nil {
+		<-batchSubmitterDoneCh
+	}
+	dispatcher.Shutdown()
+	submitter.Wait()
 
 	return nil
 }
@@ -214,6 +435,50 @@ func newMongoDB(uri, dbName string) (mongo.Database, error) {
 	return client.Database(dbName), nil
 }
 
+func newPersistedQueries(filePath string) (graphql.PersistedQueries, error) {
+	if filePath == "" {
+		return nil, nil
+	}
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return graphql.LoadPersistedQueries(f)
+}
+
+// newCoverDrawer returns a cover drawer which draws the Latin characters with the Go font and the Thai characters
+// with the Noto Sans Thai font, the cover is drawn on a plain background if the template file is not provided
+func newCoverDrawer(templateFilePath string) (image.TemplateCoverDrawer, error) {
+	thai, err := unzip(data.MustGzipAsset("data/fonts/NotoSansThai-Regular.ttf"))
+	if err != nil {
+		return image.TemplateCoverDrawer{}, err
+	}
+	if templateFilePath == "" {
+		return image.NewTemplateCoverDrawer(nil, gomedium.TTF, thai)
+	}
+
+	tmpl, err := imaging.Open(templateFilePath)
+	if err != nil {
+		return image.TemplateCoverDrawer{}, err
+	}
+	return image.NewTemplateCoverDrawer(tmpl, gomedium.TTF, thai)
+}
+
+func newRateLimitPolicies(names ...string) (map[string]ratelimit.Policy, error) {
+	policies := make(map[string]ratelimit.Policy, len(names))
+	for _, name := range names {
+		p, err := ratelimit.ParsePolicy(name, viper.GetString("rate-limit-"+name))
+		if err != nil {
+			return nil, err
+		}
+		policies[name] = p
+	}
+	return policies, nil
+}
+
 func newBlobStorage() (*blob.Bucket, error) {
 	switch viper.GetString("storage-driver") {
 	case "s3":
@@ -18,6 +18,11 @@ var (
 func main() {
 	cmd := cobra.Command{Version: fmt.Sprintf("%s %s", Version, Revision)}
 	cmd.AddCommand(app.Cmd)
+	cmd.AddCommand(app.ImportCmd)
+	cmd.AddCommand(app.BuildStaticCmd)
+	cmd.AddCommand(app.BackupCmd)
+	cmd.AddCommand(app.RestoreCmd)
+	cmd.AddCommand(app.MigrateCmd)
 
 	if err := cmd.Execute(); err != nil {
 		logrus.Fatalf("server: %s", err)
@@ -0,0 +1,58 @@
+package analytics
+
+import (
+	"github.com/go-chi/chi"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	botUserAgentRegExp = regexp.MustCompile(`(?i)bot|crawl|spider|slurp|archiver|facebookexternalhit|embedly|preview|headless|lighthouse|curl|wget|python|go-http-client|java/|okhttp|axios|node-fetch`)
+)
+
+// IsBot returns true if the request comes from a crawler, a link previewer or a prefetching browser
+func IsBot(r *http.Request) bool {
+	ua := r.UserAgent()
+	if ua == "" || botUserAgentRegExp.MatchString(ua) {
+		return true
+	}
+
+	for _, name := range []string{"Purpose", "Sec-Purpose", "X-Purpose", "X-Moz"} {
+		if strings.Contains(strings.ToLower(r.Header.Get(name)), "prefetch") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RecordViewHandlerFunc handles a page view beacon of the published post,
+// neither IP address nor cookie is stored, only the number of views per post per day
+func RecordViewHandlerFunc(recorder *Recorder, repository blog.PostRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+
+		if IsBot(r) {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		id, err := blog.GetIDFromSlug(chi.URLParam(r, "slug"))
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+
+		p, err := repository.FindByID(r.Context(), id)
+		if err != nil || !p.Status.IsPublished() {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+
+		recorder.Record(p.ID, time.Now())
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
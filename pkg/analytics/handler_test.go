@@ -0,0 +1,162 @@
+package analytics_test
+
+import (
+	"context"
+	"errors"
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	. "github.com/nomkhonwaan/myblog/pkg/analytics"
+	mock_analytics "github.com/nomkhonwaan/myblog/pkg/analytics/mock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	mock_blog "github.com/nomkhonwaan/myblog/pkg/blog/mock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIsBot(t *testing.T) {
+	tests := map[string]struct {
+		userAgent string
+		header    http.Header
+		expected  bool
+	}{
+		"With browser user agent": {
+			userAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_4) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/13.1 Safari/605.1.15",
+			expected:  false,
+		},
+		"With Googlebot user agent": {
+			userAgent: "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			expected:  true,
+		},
+		"With Facebook crawler user agent": {
+			userAgent: "facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)",
+			expected:  true,
+		},
+		"With curl user agent": {
+			userAgent: "curl/7.64.1",
+			expected:  true,
+		},
+		"With empty user agent": {
+			expected: true,
+		},
+		"With prefetch request": {
+			userAgent: "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/81.0.4044.92 Safari/537.36",
+			header:    http.Header{"Sec-Purpose": []string{"prefetch;prerender"}},
+			expected:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Given
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			for k, v := range test.header {
+				r.Header[k] = v
+			}
+			r.Header.Set("User-Agent", test.userAgent)
+
+			// When
+			result := IsBot(r)
+
+			// Then
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestRecordViewHandlerFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		viewRepository = mock_analytics.NewMockViewRepository(ctrl)
+		repository     = mock_blog.NewMockPostRepository(ctrl)
+	)
+
+	browser := "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_4) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/13.1 Safari/605.1.15"
+
+	newRecordViewRequest := func(slug, userAgent string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/api/v2.1/views/"+slug, nil)
+		req.Header.Set("User-Agent", userAgent)
+		return req.WithContext(
+			context.WithValue(req.Context(), chi.RouteCtxKey,
+				&chi.Context{
+					URLParams: chi.RouteParams{Keys: []string{"slug"}, Values: []string{slug}},
+				}))
+	}
+
+	t.Run("With successful recording a page view", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		id := primitive.NewObjectID()
+		recorder := NewRecorder(viewRepository, time.Minute)
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{ID: id, Status: blog.StatusPublished}, nil)
+		viewRepository.EXPECT().Increase(gomock.Any(), []View{{PostID: id, Date: Day(time.Now()), Count: 1}}).Return(nil)
+
+		// When
+		RecordViewHandlerFunc(recorder, repository).ServeHTTP(w, newRecordViewRequest("test-"+id.Hex(), browser))
+
+		// Then
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Nil(t, recorder.Flush(context.Background()))
+	})
+
+	t.Run("When the request comes from a bot", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		recorder := NewRecorder(viewRepository, time.Minute)
+
+		// When
+		RecordViewHandlerFunc(recorder, repository).ServeHTTP(w, newRecordViewRequest("test-"+primitive.NewObjectID().Hex(), "Googlebot/2.1"))
+
+		// Then
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Nil(t, recorder.Flush(context.Background()))
+	})
+
+	t.Run("When the post is not published", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		id := primitive.NewObjectID()
+		recorder := NewRecorder(viewRepository, time.Minute)
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{ID: id, Status: blog.StatusDraft}, nil)
+
+		// When
+		RecordViewHandlerFunc(recorder, repository).ServeHTTP(w, newRecordViewRequest("test-"+id.Hex(), browser))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("When unable to find the post", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		id := primitive.NewObjectID()
+		recorder := NewRecorder(viewRepository, time.Minute)
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{}, errors.New("test unable to find the post"))
+
+		// When
+		RecordViewHandlerFunc(recorder, repository).ServeHTTP(w, newRecordViewRequest("test-"+id.Hex(), browser))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("With invalid slug", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		recorder := NewRecorder(viewRepository, time.Minute)
+
+		// When
+		RecordViewHandlerFunc(recorder, repository).ServeHTTP(w, newRecordViewRequest("test-invalid", browser))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/nomkhonwaan/myblog/pkg/analytics (interfaces: ViewRepository)
+
+// Package mock_analytics is a generated GoMock package.
+package mock_analytics
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	analytics "github.com/nomkhonwaan/myblog/pkg/analytics"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+	reflect "reflect"
+	time "time"
+)
+
+// MockViewRepository is a mock of ViewRepository interface
+type MockViewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewRepositoryMockRecorder
+}
+
+// MockViewRepositoryMockRecorder is the mock recorder for MockViewRepository
+type MockViewRepositoryMockRecorder struct {
+	mock *MockViewRepository
+}
+
+// NewMockViewRepository creates a new mock instance
+func NewMockViewRepository(ctrl *gomock.Controller) *MockViewRepository {
+	mock := &MockViewRepository{ctrl: ctrl}
+	mock.recorder = &MockViewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockViewRepository) EXPECT() *MockViewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountAllByPostIDs mocks base method
+func (m *MockViewRepository) CountAllByPostIDs(arg0 context.Context, arg1 interface{}) (map[primitive.ObjectID]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAllByPostIDs", arg0, arg1)
+	ret0, _ := ret[0].(map[primitive.ObjectID]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAllByPostIDs indicates an expected call of CountAllByPostIDs
+func (mr *MockViewRepositoryMockRecorder) CountAllByPostIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAllByPostIDs", reflect.TypeOf((*MockViewRepository)(nil).CountAllByPostIDs), arg0, arg1)
+}
+
+// FindAllPopular mocks base method
+func (m *MockViewRepository) FindAllPopular(arg0 context.Context, arg1 time.Time, arg2 int64) ([]analytics.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllPopular", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]analytics.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllPopular indicates an expected call of FindAllPopular
+func (mr *MockViewRepositoryMockRecorder) FindAllPopular(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllPopular", reflect.TypeOf((*MockViewRepository)(nil).FindAllPopular), arg0, arg1, arg2)
+}
+
+// Increase mocks base method
+func (m *MockViewRepository) Increase(arg0 context.Context, arg1 []analytics.View) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Increase", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Increase indicates an expected call of Increase
+func (mr *MockViewRepositoryMockRecorder) Increase(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increase", reflect.TypeOf((*MockViewRepository)(nil).Increase), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+package analytics
+
+import "time"
+
+// Period for indicating the range of page views to be counted for popular posts
+type Period string
+
+func (p Period) String() string {
+	return string(p)
+}
+
+// IsValid returns "true" if the period is one of the known periods
+func (p Period) IsValid() bool {
+	switch p {
+	case PeriodDay, PeriodWeek, PeriodMonth, PeriodYear, PeriodAll:
+		return true
+	default:
+		return false
+	}
+}
+
+// Since returns the beginning of the period which ends at the given date-time,
+// returns zero date-time if the period is all time or unknown
+func (p Period) Since(now time.Time) time.Time {
+	switch p {
+	case PeriodDay:
+		return Day(now)
+	case PeriodWeek:
+		return Day(now).AddDate(0, 0, -6)
+	case PeriodMonth:
+		return Day(now).AddDate(0, 0, -29)
+	case PeriodYear:
+		return Day(now).AddDate(0, 0, -364)
+	default:
+		return time.Time{}
+	}
+}
+
+// PeriodDay indicates page views of today
+const PeriodDay Period = "DAY"
+
+// PeriodWeek indicates page views of the last 7 days
+const PeriodWeek Period = "WEEK"
+
+// PeriodMonth indicates page views of the last 30 days
+const PeriodMonth Period = "MONTH"
+
+// PeriodYear indicates page views of the last 365 days
+const PeriodYear Period = "YEAR"
+
+// PeriodAll indicates page views of all time
+const PeriodAll Period = "ALL"
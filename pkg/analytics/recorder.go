@@ -0,0 +1,98 @@
+package analytics
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"sync"
+	"time"
+)
+
+type viewKey struct {
+	postID primitive.ObjectID
+	date   time.Time
+}
+
+// Recorder counts page views in memory and writes them to the repository in batches
+type Recorder struct {
+	repository ViewRepository
+	interval   time.Duration
+
+	mu     sync.Mutex
+	counts map[viewKey]int64
+}
+
+// NewRecorder returns a new Recorder which writes all recorded page views on every interval
+func NewRecorder(repository ViewRepository, interval time.Duration) *Recorder {
+	return &Recorder{
+		repository: repository,
+		interval:   interval,
+		counts:     make(map[viewKey]int64),
+	}
+}
+
+// Record counts a single page view of the post at the given date-time
+func (r *Recorder) Record(postID primitive.ObjectID, t time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.counts[viewKey{postID: postID, date: Day(t)}]++
+}
+
+// Flush writes all recorded page views to the repository,
+// the page views will be kept for the next flushing if unable to write
+func (r *Recorder) Flush(ctx context.Context) error {
+	r.mu.Lock()
+	counts := r.counts
+	r.counts = make(map[viewKey]int64)
+	r.mu.Unlock()
+
+	if len(counts) == 0 {
+		return nil
+	}
+
+	views := make([]View, 0, len(counts))
+	for k, n := range counts {
+		views = append(views, View{PostID: k.postID, Date: k.date, Count: n})
+	}
+
+	if err := r.repository.Increase(ctx, views); err != nil {
+		r.mu.Lock()
+		for k, n := range counts {
+			r.counts[k] += n
+		}
+		r.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// Start flushes the recorded page views on every interval until the stop channel is closed,
+// the returned channel will be closed after the last flushing
+func (r *Recorder) Start(stopCh <-chan struct{}) <-chan struct{} {
+	doneCh := make(chan struct{})
+
+	go func() {
+		defer close(doneCh)
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := r.Flush(context.Background()); err != nil {
+					logrus.Errorf("unable to write page views: %s", err)
+				}
+			case <-stopCh:
+				if err := r.Flush(context.Background()); err != nil {
+					logrus.Errorf("unable to write page views: %s", err)
+				}
+				return
+			}
+		}
+	}()
+
+	return doneCh
+}
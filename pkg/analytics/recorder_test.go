@@ -0,0 +1,89 @@
+package analytics_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	. "github.com/nomkhonwaan/myblog/pkg/analytics"
+	mock_analytics "github.com/nomkhonwaan/myblog/pkg/analytics/mock"
+	"github.com/nomkhonwaan/myblog/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+	"time"
+)
+
+func TestRecorder_Flush(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository = mock_analytics.NewMockViewRepository(ctrl)
+	)
+
+	ctx := context.Background()
+	postID := primitive.NewObjectID()
+	now := time.Date(2020, 3, 29, 10, 0, 0, 0, timeutil.TimeZoneAsiaBangkok)
+
+	t.Run("With successful writing recorded page views", func(t *testing.T) {
+		// Given
+		recorder := NewRecorder(repository, time.Minute)
+		recorder.Record(postID, now)
+		recorder.Record(postID, now.Add(time.Hour))
+
+		repository.EXPECT().Increase(ctx, []View{{PostID: postID, Date: Day(now), Count: 2}}).Return(nil)
+
+		// When
+		err := recorder.Flush(ctx)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Nil(t, recorder.Flush(ctx))
+	})
+
+	t.Run("When unable to write recorded page views", func(t *testing.T) {
+		// Given
+		recorder := NewRecorder(repository, time.Minute)
+		recorder.Record(postID, now)
+
+		repository.EXPECT().Increase(ctx, gomock.Any()).Return(errors.New("test unable to write page views"))
+		repository.EXPECT().Increase(ctx, []View{{PostID: postID, Date: Day(now), Count: 2}}).Return(nil)
+
+		// When
+		err := recorder.Flush(ctx)
+		recorder.Record(postID, now)
+
+		// Then
+		assert.EqualError(t, err, "test unable to write page views")
+		assert.Nil(t, recorder.Flush(ctx))
+	})
+}
+
+func TestRecorder_Start(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository = mock_analytics.NewMockViewRepository(ctrl)
+	)
+
+	// Given
+	postID := primitive.NewObjectID()
+	now := time.Now()
+	recorder := NewRecorder(repository, time.Hour)
+	recorder.Record(postID, now)
+	stopCh := make(chan struct{})
+
+	repository.EXPECT().Increase(gomock.Any(), []View{{PostID: postID, Date: Day(now), Count: 1}}).Return(nil)
+
+	// When
+	doneCh := recorder.Start(stopCh)
+	close(stopCh)
+
+	// Then
+	select {
+	case <-doneCh:
+	case <-time.After(time.Second):
+		t.Fatal("the recorder was not stopped")
+	}
+}
@@ -0,0 +1,120 @@
+//go:generate mockgen -destination=./mock/view_mock.go github.com/nomkhonwaan/myblog/pkg/analytics ViewRepository
+
+package analytics
+
+import (
+	"context"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	"github.com/nomkhonwaan/myblog/pkg/timeutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mgo "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+// View is a number of page views of the post on a single day, no visitor information is stored
+type View struct {
+	// Identifier of the post
+	PostID primitive.ObjectID `bson:"postId" json:"postId"`
+
+	// Beginning of the day in Asia/Bangkok that the post was viewed, zero on the aggregated result
+	Date time.Time `bson:"date" json:"date"`
+
+	// Number of page views
+	Count int64 `bson:"count" json:"count"`
+}
+
+// Day returns the beginning of the day in Asia/Bangkok
+func Day(t time.Time) time.Time {
+	t = t.In(timeutil.TimeZoneAsiaBangkok)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, timeutil.TimeZoneAsiaBangkok)
+}
+
+// A ViewRepository interface
+type ViewRepository interface {
+	CountAllByPostIDs(ctx context.Context, ids interface{}) (map[primitive.ObjectID]int64, error)
+	FindAllPopular(ctx context.Context, since time.Time, limit int64) ([]View, error)
+	Increase(ctx context.Context, views []View) error
+}
+
+// NewViewRepository returns a MongoViewRepository instance
+func NewViewRepository(db mongo.Database) MongoViewRepository {
+	return MongoViewRepository{col: mongo.NewCollection(db.Collection("post_views"))}
+}
+
+// MongoViewRepository implements ViewRepository interface
+type MongoViewRepository struct{ col mongo.Collection }
+
+// CountAllByPostIDs returns total page views of all posts from list of IDs
+func (repo MongoViewRepository) CountAllByPostIDs(ctx context.Context, ids interface{}) (map[primitive.ObjectID]int64, error) {
+	views, err := repo.aggregate(ctx, bson.M{"postId": bson.M{"$in": ids.([]primitive.ObjectID)}})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[primitive.ObjectID]int64, len(views))
+	for _, v := range views {
+		counts[v.PostID] = v.Count
+	}
+
+	return counts, nil
+}
+
+// FindAllPopular returns list of the most viewed published posts since the given date-time, sorted by page views,
+// the posts are joined and filtered by status before limiting so that unpublished posts do not take up the result
+func (repo MongoViewRepository) FindAllPopular(ctx context.Context, since time.Time, limit int64) ([]View, error) {
+	return repo.aggregate(ctx, bson.M{"date": bson.M{"$gte": since}},
+		bson.D{{"$lookup", bson.M{"from": "posts", "localField": "_id", "foreignField": "_id", "as": "post"}}},
+		bson.D{{"$match", bson.M{"post.status": blog.StatusPublished}}},
+		bson.D{{"$sort", bson.D{{"count", -1}, {"_id", 1}}}},
+		bson.D{{"$limit", limit}},
+	)
+}
+
+// Increase adds page views of each post and day in a single bulk write
+func (repo MongoViewRepository) Increase(ctx context.Context, views []View) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	models := make([]mgo.WriteModel, len(views))
+	for i, v := range views {
+		models[i] = mgo.NewUpdateOneModel().
+			SetFilter(bson.M{"postId": v.PostID, "date": v.Date}).
+			SetUpdate(bson.M{"$inc": bson.M{"count": v.Count}}).
+			SetUpsert(true)
+	}
+
+	_, err := repo.col.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+func (repo MongoViewRepository) aggregate(ctx context.Context, match bson.M, stages ...bson.D) ([]View, error) {
+	pipeline := append(mgo.Pipeline{
+		{{"$match", match}},
+		{{"$group", bson.M{"_id": "$postId", "count": bson.M{"$sum": "$count"}}}},
+	}, stages...)
+
+	cur, err := repo.col.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var results []struct {
+		ID    primitive.ObjectID `bson:"_id"`
+		Count int64              `bson:"count"`
+	}
+	if err = cur.Decode(&results); err != nil {
+		return nil, err
+	}
+
+	views := make([]View, len(results))
+	for i, r := range results {
+		views[i] = View{PostID: r.ID, Count: r.Count}
+	}
+
+	return views, nil
+}
@@ -0,0 +1,217 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	mock_mongo "github.com/nomkhonwaan/myblog/pkg/mongo/mock"
+	"github.com/nomkhonwaan/myblog/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mgo "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func decodeViews(results ...bson.M) func(val interface{}) error {
+	return func(val interface{}) error {
+		v := reflect.ValueOf(val).Elem()
+		for _, r := range results {
+			data, _ := bson.Marshal(r)
+			elem := reflect.New(v.Type().Elem())
+			_ = bson.Unmarshal(data, elem.Interface())
+			v.Set(reflect.Append(v, elem.Elem()))
+		}
+		return nil
+	}
+}
+
+func TestDay(t *testing.T) {
+	// Given
+	now := time.Date(2020, 3, 29, 18, 30, 0, 0, time.UTC)
+
+	// When
+	result := Day(now)
+
+	// Then
+	assert.Equal(t, time.Date(2020, 3, 30, 0, 0, 0, 0, timeutil.TimeZoneAsiaBangkok), result)
+}
+
+func TestPeriod_Since(t *testing.T) {
+	// Given
+	now := time.Date(2020, 3, 29, 10, 0, 0, 0, timeutil.TimeZoneAsiaBangkok)
+
+	tests := map[Period]time.Time{
+		PeriodDay:   time.Date(2020, 3, 29, 0, 0, 0, 0, timeutil.TimeZoneAsiaBangkok),
+		PeriodWeek:  time.Date(2020, 3, 23, 0, 0, 0, 0, timeutil.TimeZoneAsiaBangkok),
+		PeriodMonth: time.Date(2020, 2, 29, 0, 0, 0, 0, timeutil.TimeZoneAsiaBangkok),
+		PeriodYear:  time.Date(2019, 3, 31, 0, 0, 0, 0, timeutil.TimeZoneAsiaBangkok),
+		PeriodAll:   {},
+	}
+
+	// When
+	for p, expected := range tests {
+		t.Run(p.String(), func(t *testing.T) {
+			// Then
+			assert.Equal(t, expected, p.Since(now))
+		})
+	}
+}
+
+func TestPeriod_IsValid(t *testing.T) {
+	// Given
+	tests := map[Period]bool{
+		PeriodDay:   true,
+		PeriodWeek:  true,
+		PeriodMonth: true,
+		PeriodYear:  true,
+		PeriodAll:   true,
+		"DECADE":    false,
+		"":          false,
+	}
+
+	// When
+	for p, expected := range tests {
+		t.Run(p.String(), func(t *testing.T) {
+			// Then
+			assert.Equal(t, expected, p.IsValid())
+		})
+	}
+}
+
+func TestMongoViewRepository_CountAllByPostIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		col = mock_mongo.NewMockCollection(ctrl)
+		cur = mock_mongo.NewMockCursor(ctrl)
+	)
+
+	ctx := context.Background()
+	repo := MongoViewRepository{col: col}
+	postID := primitive.NewObjectID()
+
+	t.Run("With successful counting page views", func(t *testing.T) {
+		// Given
+		col.EXPECT().Aggregate(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, pipeline interface{}) (mongo.Cursor, error) {
+			assert.Equal(t, bson.D{{"$match", bson.M{"postId": bson.M{"$in": []primitive.ObjectID{postID}}}}},
+				pipeline.(mgo.Pipeline)[0])
+			return cur, nil
+		})
+		cur.EXPECT().Decode(gomock.Any()).DoAndReturn(decodeViews(bson.M{"_id": postID, "count": int64(42)}))
+		cur.EXPECT().Close(ctx).Return(nil)
+
+		// When
+		counts, err := repo.CountAllByPostIDs(ctx, []primitive.ObjectID{postID})
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, map[primitive.ObjectID]int64{postID: 42}, counts)
+	})
+
+	t.Run("When unable to aggregate page views", func(t *testing.T) {
+		// Given
+		col.EXPECT().Aggregate(ctx, gomock.Any()).Return(nil, errors.New("test unable to aggregate page views"))
+
+		// When
+		_, err := repo.CountAllByPostIDs(ctx, []primitive.ObjectID{postID})
+
+		// Then
+		assert.EqualError(t, err, "test unable to aggregate page views")
+	})
+}
+
+func TestMongoViewRepository_FindAllPopular(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		col = mock_mongo.NewMockCollection(ctrl)
+		cur = mock_mongo.NewMockCursor(ctrl)
+	)
+
+	ctx := context.Background()
+	repo := MongoViewRepository{col: col}
+	since := time.Date(2020, 3, 23, 0, 0, 0, 0, timeutil.TimeZoneAsiaBangkok)
+	firstID, secondID := primitive.NewObjectID(), primitive.NewObjectID()
+
+	// Given
+	col.EXPECT().Aggregate(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, pipeline interface{}) (mongo.Cursor, error) {
+		p := pipeline.(mgo.Pipeline)
+		assert.Equal(t, bson.D{{"$match", bson.M{"date": bson.M{"$gte": since}}}}, p[0])
+		assert.Equal(t, bson.D{{"$match", bson.M{"post.status": blog.StatusPublished}}}, p[3])
+		assert.Equal(t, bson.D{{"$limit", int64(5)}}, p[len(p)-1])
+		return cur, nil
+	})
+	cur.EXPECT().Decode(gomock.Any()).DoAndReturn(decodeViews(
+		bson.M{"_id": firstID, "count": int64(20)},
+		bson.M{"_id": secondID, "count": int64(10)},
+	))
+	cur.EXPECT().Close(ctx).Return(nil)
+
+	// When
+	views, err := repo.FindAllPopular(ctx, since, 5)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []View{{PostID: firstID, Count: 20}, {PostID: secondID, Count: 10}}, views)
+}
+
+func TestMongoViewRepository_Increase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		col = mock_mongo.NewMockCollection(ctrl)
+	)
+
+	ctx := context.Background()
+	repo := MongoViewRepository{col: col}
+	postID := primitive.NewObjectID()
+	date := time.Date(2020, 3, 29, 0, 0, 0, 0, timeutil.TimeZoneAsiaBangkok)
+
+	t.Run("With successful increasing page views", func(t *testing.T) {
+		// Given
+		col.EXPECT().BulkWrite(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, models []mgo.WriteModel, opts ...*options.BulkWriteOptions) (*mgo.BulkWriteResult, error) {
+			m := models[0].(*mgo.UpdateOneModel)
+			assert.Equal(t, bson.M{"postId": postID, "date": date}, m.Filter)
+			assert.Equal(t, bson.M{"$inc": bson.M{"count": int64(3)}}, m.Update)
+			assert.True(t, *m.Upsert)
+			assert.False(t, *opts[0].Ordered)
+			return &mgo.BulkWriteResult{}, nil
+		})
+
+		// When
+		err := repo.Increase(ctx, []View{{PostID: postID, Date: date, Count: 3}})
+
+		// Then
+		assert.Nil(t, err)
+	})
+
+	t.Run("With empty list of page views", func(t *testing.T) {
+		// Given
+
+		// When
+		err := repo.Increase(ctx, nil)
+
+		// Then
+		assert.Nil(t, err)
+	})
+
+	t.Run("When unable to write page views", func(t *testing.T) {
+		// Given
+		col.EXPECT().BulkWrite(ctx, gomock.Any(), gomock.Any()).Return(nil, errors.New("test unable to write page views"))
+
+		// When
+		err := repo.Increase(ctx, []View{{PostID: postID, Date: date, Count: 3}})
+
+		// Then
+		assert.EqualError(t, err, "test unable to write page views")
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/auth0/go-jwt-middleware"
 	"github.com/dgrijalva/jwt-go"
 	"net/http"
+	"strings"
 )
 
 // UserProperty is a name of the property in the request where the user information stored
@@ -22,7 +23,8 @@ func GetAuthorizedUserID(ctx context.Context) interface{} {
 }
 
 // NewJWTMiddleware returns a new jwtmiddleware.JWTMiddleware instance.
-// This middleware uses to looking for the "access_token" in the request header and call to the auth server for validating it.
+// This middleware uses to looking for the "access_token" in the request header and call to the auth server for validating it,
+// a WebSocket handshake request can also provide the "access_token" in the query string since browsers are unable to set the header.
 func NewJWTMiddleware(audience, issuer, jwksURI string, transport http.RoundTripper) *jwtmiddleware.JWTMiddleware {
 	return jwtmiddleware.New(jwtmiddleware.Options{
 		ValidationKeyGetter: func(token *jwt.Token) (interface{}, error) {
@@ -45,9 +47,20 @@ func NewJWTMiddleware(audience, issuer, jwksURI string, transport http.RoundTrip
 		UserProperty:        UserProperty,
 		CredentialsOptional: true,
 		SigningMethod:       jwt.SigningMethodRS256,
+		Extractor:           jwtmiddleware.FromFirst(jwtmiddleware.FromAuthHeader, fromWebSocketParameter("access_token")),
 	})
 }
 
+// fromWebSocketParameter returns a token extractor which looks for the token in the query string of the WebSocket handshake request only
+func fromWebSocketParameter(param string) jwtmiddleware.TokenExtractor {
+	return func(r *http.Request) (string, error) {
+		if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			return "", nil
+		}
+		return jwtmiddleware.FromParameter(param)(r)
+	}
+}
+
 func getPEMCertificate(token *jwt.Token, jwksURI string, c *http.Client) (string, error) {
 	req, _ := http.NewRequest(http.MethodGet, jwksURI, nil)
 	res, err := c.Do(req)
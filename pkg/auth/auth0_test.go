@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -200,6 +201,49 @@ func TestNewJWTMiddleware(t *testing.T) {
 	})
 }
 
+func TestNewJWTMiddleware_Extractor(t *testing.T) {
+	mw := NewJWTMiddleware("", "", "", http.DefaultTransport)
+	extractor := mw.Options.Extractor
+
+	t.Run("With token in the authorization header", func(t *testing.T) {
+		// Given
+		r := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		r.Header.Set("Authorization", "Bearer token")
+
+		// When
+		token, err := extractor(r)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, "token", token)
+	})
+
+	t.Run("With token in the query string of the WebSocket handshake request", func(t *testing.T) {
+		// Given
+		r := httptest.NewRequest(http.MethodGet, "/graphql/ws?access_token=token", nil)
+		r.Header.Set("Upgrade", "websocket")
+
+		// When
+		token, err := extractor(r)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, "token", token)
+	})
+
+	t.Run("When the token is in the query string of the normal request", func(t *testing.T) {
+		// Given
+		r := httptest.NewRequest(http.MethodGet, "/graphql?access_token=token", nil)
+
+		// When
+		token, err := extractor(r)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, "", token)
+	})
+}
+
 func TestGetAuthorizedUserID(t *testing.T) {
 	t.Run("When able to retrieve authorized ID from the context", func(t *testing.T) {
 		// Given
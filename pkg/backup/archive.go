@@ -0,0 +1,391 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	// ManifestFileName is a name of the manifest entry which is always the last entry in the archive
+	ManifestFileName = "manifest.json"
+
+	// maxDocumentSize is the maximum size of a MongoDB document
+	maxDocumentSize = 16 * 1024 * 1024
+
+	collectionsDir = "collections/"
+	storageDir     = "storage/"
+)
+
+var (
+	// Collections is a list of MongoDB collections to be archived, the "files" collection must be included
+	// for archiving all storage objects
+	Collections = []string{"posts", "categories", "tags", "files", "post_views", "webhooks", "webhook_deliveries"}
+)
+
+// Manifest describes all entries in the archive
+type Manifest struct {
+	// Date-time that the archive was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// List of archived collections
+	Collections []Entry `json:"collections"`
+
+	// List of archived storage objects
+	Objects []Entry `json:"objects"`
+}
+
+// Entry is a single file in the archive
+type Entry struct {
+	// Name of the collection or a storage object path
+	Name string `json:"name"`
+
+	// A path of the entry in the archive
+	File string `json:"file"`
+
+	// Number of documents in the collection, for a collection entry only
+	Documents int `json:"documents,omitempty"`
+
+	// Size of the entry in bytes
+	Size int64 `json:"size"`
+
+	// SHA-256 checksum of the entry in hex
+	SHA256 string `json:"sha256"`
+}
+
+// Archiver creates or restores a gzip compressed tarball of all collections and storage objects
+type Archiver struct {
+	collections map[string]mongo.Collection
+	storage     storage.Storage
+}
+
+// NewArchiver returns a new Archiver instance
+func NewArchiver(db mongo.Database, s storage.Storage) Archiver {
+	collections := make(map[string]mongo.Collection)
+	for _, name := range Collections {
+		collections[name] = mongo.NewCollection(db.Collection(name))
+	}
+	return Archiver{collections: collections, storage: s}
+}
+
+// Create writes all collections as BSON documents, then all storage objects which are referenced by the files collection
+// and the manifest with checksums at the end of the archive.
+// Every entry is streamed into the archive, a collection is spooled to a temporary file since its size must be known
+// before writing the tar header.
+func (a Archiver) Create(ctx context.Context, w io.Writer) (Manifest, error) {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	manifest := Manifest{CreatedAt: time.Now()}
+
+	var (
+		paths []string
+		seen  = make(map[string]bool)
+	)
+	for _, name := range Collections {
+		entry, err := a.archiveCollection(ctx, tw, name, func(raw bson.Raw) {
+			if name != "files" {
+				return
+			}
+			// the same storage object can be referenced by many files, but it must be archived only once
+			if val, err := raw.LookupErr("path"); err == nil && !seen[val.StringValue()] {
+				seen[val.StringValue()] = true
+				paths = append(paths, val.StringValue())
+			}
+		})
+		if err != nil {
+			return manifest, err
+		}
+		manifest.Collections = append(manifest.Collections, entry)
+	}
+
+	for _, p := range paths {
+		logrus.Infof("archiving storage object %s...", p)
+		entry, err := a.archiveObject(ctx, tw, p)
+		if err != nil {
+			return manifest, err
+		}
+		manifest.Objects = append(manifest.Objects, entry)
+	}
+
+	data, _ := json.MarshalIndent(manifest, "", "  ")
+	if _, err := writeEntry(tw, ManifestFileName, int64(len(data)), bytes.NewReader(data)); err != nil {
+		return manifest, err
+	}
+
+	if err := tw.Close(); err != nil {
+		return manifest, err
+	}
+	return manifest, gw.Close()
+}
+
+func (a Archiver) archiveCollection(ctx context.Context, tw *tar.Writer, name string, fn func(raw bson.Raw)) (Entry, error) {
+	tmp, err := ioutil.TempFile("", "myblog-backup-*.bson")
+	if err != nil {
+		return Entry{}, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	cur, err := a.collections[name].Find(ctx, bson.D{})
+	if err != nil {
+		return Entry{}, err
+	}
+	defer cur.Close(ctx)
+
+	var (
+		n    int
+		size int64
+	)
+	for cur.Next(ctx) {
+		var raw bson.Raw
+		if err = cur.Decode(&raw); err != nil {
+			return Entry{}, err
+		}
+		if _, err = tmp.Write(raw); err != nil {
+			return Entry{}, err
+		}
+		fn(raw)
+		n++
+		size += int64(len(raw))
+	}
+	if err = cur.Err(); err != nil {
+		return Entry{}, fmt.Errorf("unable to read the %s collection: %s", name, err)
+	}
+
+	if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+		return Entry{}, err
+	}
+
+	logrus.Infof("archiving %d documents from the %s collection...", n, name)
+	entry, err := writeEntry(tw, collectionsDir+name+".bson", size, tmp)
+	if err != nil {
+		return Entry{}, err
+	}
+	entry.Name, entry.Documents = name, n
+	return entry, nil
+}
+
+// archiveObject copies the storage object into the archive, the size is taken from the downloaded object
+// if the storage provides it (e.g. the blob.Reader), otherwise the object is spooled to a temporary file
+func (a Archiver) archiveObject(ctx context.Context, tw *tar.Writer, p string) (Entry, error) {
+	body, err := a.storage.Download(ctx, p)
+	if err != nil {
+		return Entry{}, fmt.Errorf("unable to download %s: %s", p, err)
+	}
+	defer body.Close()
+
+	var (
+		r    io.Reader = body
+		size int64
+	)
+	if s, ok := body.(interface{ Size() int64 }); ok {
+		size = s.Size()
+	} else {
+		tmp, err := ioutil.TempFile("", "myblog-backup-*")
+		if err != nil {
+			return Entry{}, err
+		}
+		defer os.Remove(tmp.Name())
+		defer tmp.Close()
+
+		if size, err = io.Copy(tmp, body); err != nil {
+			return Entry{}, err
+		}
+		if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+			return Entry{}, err
+		}
+		r = tmp
+	}
+
+	entry, err := writeEntry(tw, storageDir+p, size, r)
+	if err != nil {
+		return Entry{}, err
+	}
+	entry.Name = p
+	return entry, nil
+}
+
+// Verify reads the whole archive and compares every entry with the checksum in the manifest
+func Verify(r io.Reader) (Manifest, error) {
+	var manifest Manifest
+	checksums := make(map[string]string)
+	sizes := make(map[string]int64)
+
+	err := walk(r, func(name string, body io.Reader) error {
+		if name == ManifestFileName {
+			return json.NewDecoder(body).Decode(&manifest)
+		}
+
+		h := sha256.New()
+		n, err := io.Copy(h, body)
+		if err != nil {
+			return err
+		}
+		checksums[name], sizes[name] = hex.EncodeToString(h.Sum(nil)), n
+		return nil
+	})
+	if err != nil {
+		return manifest, err
+	}
+	if manifest.CreatedAt.IsZero() {
+		return manifest, errors.New("manifest not found")
+	}
+
+	entries := append(append([]Entry{}, manifest.Collections...), manifest.Objects...)
+	for _, e := range entries {
+		sum, ok := checksums[e.File]
+		if !ok {
+			return manifest, fmt.Errorf("%s: missing from the archive", e.File)
+		}
+		if sum != e.SHA256 || sizes[e.File] != e.Size {
+			return manifest, fmt.Errorf("%s: checksum mismatch", e.File)
+		}
+		delete(checksums, e.File)
+	}
+	for name := range checksums {
+		return manifest, fmt.Errorf("%s: not listed in the manifest", name)
+	}
+
+	return manifest, nil
+}
+
+// CheckEmpty returns an error if any collection has a document or any storage object in the manifest already exists
+func (a Archiver) CheckEmpty(ctx context.Context, manifest Manifest) error {
+	for _, name := range Collections {
+		n, err := a.collections[name].CountDocuments(ctx, bson.D{})
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			return fmt.Errorf("the %s collection is not empty", name)
+		}
+	}
+
+	for _, e := range manifest.Objects {
+		exists, err := a.storage.Exists(ctx, e.Name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("storage object %s already exists", e.Name)
+		}
+	}
+
+	return nil
+}
+
+// Restore loads all collections and storage objects from the archive, the archive should be verified before restoring
+func (a Archiver) Restore(ctx context.Context, r io.Reader) error {
+	return walk(r, func(name string, body io.Reader) error {
+		switch {
+		case strings.HasPrefix(name, collectionsDir):
+			col, ok := a.collections[strings.TrimSuffix(path.Base(name), ".bson")]
+			if !ok {
+				return fmt.Errorf("%s: unknown collection", name)
+			}
+
+			logrus.Infof("restoring %s...", name)
+			for {
+				raw, err := readDocument(body)
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return fmt.Errorf("%s: invalid BSON document", name)
+				}
+				if _, err = col.InsertOne(ctx, raw); err != nil {
+					return err
+				}
+			}
+		case strings.HasPrefix(name, storageDir):
+			logrus.Infof("restoring storage object %s...", name)
+			return a.storage.Upload(ctx, body, strings.TrimPrefix(name, storageDir))
+		}
+		return nil
+	})
+}
+
+// writeEntry copies exactly the given size of the reader into a new entry and computes its checksum on the fly
+func writeEntry(tw *tar.Writer, name string, size int64, r io.Reader) (Entry, error) {
+	err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    size,
+		ModTime: time.Now(),
+	})
+	if err != nil {
+		return Entry{}, err
+	}
+
+	h := sha256.New()
+	if _, err = io.CopyN(io.MultiWriter(tw, h), r, size); err != nil {
+		return Entry{}, fmt.Errorf("%s: %s", name, err)
+	}
+
+	return Entry{File: name, Size: size, SHA256: hex.EncodeToString(h.Sum(nil))}, nil
+}
+
+func walk(r io.Reader, fn func(name string, body io.Reader) error) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err = fn(hdr.Name, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// readDocument reads the next BSON document from the concatenated documents,
+// every BSON document starts with its total length as a little-endian int32.
+// It returns io.EOF only if there is no more document.
+func readDocument(r io.Reader) (bson.Raw, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+	length := int(int32(header[0]) | int32(header[1])<<8 | int32(header[2])<<16 | int32(header[3])<<24)
+	if length < 5 || length > maxDocumentSize {
+		return nil, errors.New("invalid document length")
+	}
+
+	doc := make([]byte, length)
+	copy(doc, header[:])
+	if _, err := io.ReadFull(r, doc[4:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return bson.Raw(doc), nil
+}
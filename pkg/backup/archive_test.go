@@ -0,0 +1,250 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	mock_mongo "github.com/nomkhonwaan/myblog/pkg/mongo/mock"
+	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mgo "go.mongodb.org/mongo-driver/mongo"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+type sizedReadCloser struct {
+	io.ReadCloser
+	size int64
+}
+
+func (r sizedReadCloser) Size() int64 { return r.size }
+
+func TestArchiver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		bucket      = mock_storage.NewMockStorage(ctrl)
+		collections = make(map[string]*mock_mongo.MockCollection)
+	)
+
+	archiver := Archiver{collections: make(map[string]mongo.Collection), storage: bucket}
+	for _, name := range Collections {
+		collections[name] = mock_mongo.NewMockCollection(ctrl)
+		archiver.collections[name] = collections[name]
+	}
+
+	docs := map[string]bson.Raw{}
+	docs["posts"], _ = bson.Marshal(bson.M{"title": "Test"})
+	docs["categories"], _ = bson.Marshal(bson.M{"name": "Web"})
+	docs["tags"], _ = bson.Marshal(bson.M{"name": "Go"})
+	docs["files"], _ = bson.Marshal(bson.M{"path": "author/test.png"})
+	docs["post_views"], _ = bson.Marshal(bson.M{"postId": primitive.NewObjectID(), "count": 1})
+	docs["webhooks"], _ = bson.Marshal(bson.M{"url": "https://example.com/hook"})
+	docs["webhook_deliveries"], _ = bson.Marshal(bson.M{"event": "post.published", "pending": true})
+
+	expectFind := func(name string, raws ...bson.Raw) {
+		cur := mock_mongo.NewMockCursor(ctrl)
+		collections[name].EXPECT().Find(gomock.Any(), bson.D{}).Return(cur, nil)
+
+		var calls []*gomock.Call
+		for _, raw := range raws {
+			raw := raw
+			calls = append(calls,
+				cur.EXPECT().Next(gomock.Any()).Return(true),
+				cur.EXPECT().Decode(gomock.Any()).DoAndReturn(func(val interface{}) error {
+					*val.(*bson.Raw) = raw
+					return nil
+				}),
+			)
+		}
+		calls = append(calls, cur.EXPECT().Next(gomock.Any()).Return(false))
+		gomock.InOrder(calls...)
+		cur.EXPECT().Err().Return(nil)
+		cur.EXPECT().Close(gomock.Any()).Return(nil)
+	}
+
+	createArchive := func(body io.ReadCloser) []byte {
+		for _, name := range Collections {
+			expectFind(name, docs[name])
+		}
+		bucket.EXPECT().Download(gomock.Any(), "author/test.png").Return(body, nil)
+
+		var buf bytes.Buffer
+		manifest, err := archiver.Create(context.Background(), &buf)
+		assert.Nil(t, err)
+		assert.Len(t, manifest.Collections, len(Collections))
+		assert.Equal(t, "post_views", manifest.Collections[4].Name)
+		assert.Equal(t, "webhooks", manifest.Collections[5].Name)
+		assert.Equal(t, "webhook_deliveries", manifest.Collections[6].Name)
+		assert.Equal(t, []Entry{{Name: "author/test.png", File: "storage/author/test.png", Size: 5,
+			SHA256: "6105d6cc76af400325e94d588ce511be5bfdbb73b437dc51eca43917d7a43e3d"}}, manifest.Objects)
+		return buf.Bytes()
+	}
+
+	t.Run("With successful creating, verifying and restoring the archive", func(t *testing.T) {
+		// Given
+		data := createArchive(ioutil.NopCloser(bytes.NewBufferString("image")))
+
+		for _, name := range Collections {
+			collections[name].EXPECT().CountDocuments(gomock.Any(), bson.D{}).Return(int64(0), nil)
+			collections[name].EXPECT().InsertOne(gomock.Any(), docs[name]).Return(&mgo.InsertOneResult{}, nil)
+		}
+		bucket.EXPECT().Exists(gomock.Any(), "author/test.png").Return(false, nil)
+		bucket.EXPECT().Upload(gomock.Any(), gomock.Any(), "author/test.png").DoAndReturn(func(_ context.Context, body io.Reader, _ string) error {
+			b, _ := ioutil.ReadAll(body)
+			assert.Equal(t, "image", string(b))
+			return nil
+		})
+
+		// When
+		manifest, err := Verify(bytes.NewReader(data))
+		assert.Nil(t, err)
+		assert.Nil(t, archiver.CheckEmpty(context.Background(), manifest))
+		err = archiver.Restore(context.Background(), bytes.NewReader(data))
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, 1, manifest.Collections[0].Documents)
+	})
+
+	t.Run("With the storage object which provides its size", func(t *testing.T) {
+		// Given
+		data := createArchive(sizedReadCloser{ReadCloser: ioutil.NopCloser(bytes.NewBufferString("image")), size: 5})
+
+		// When
+		_, err := Verify(bytes.NewReader(data))
+
+		// Then
+		assert.Nil(t, err)
+	})
+
+	t.Run("With the same storage object referenced by many files", func(t *testing.T) {
+		// Given
+		for _, name := range Collections {
+			if name == "files" {
+				expectFind(name, docs[name], docs[name])
+				continue
+			}
+			expectFind(name, docs[name])
+		}
+		bucket.EXPECT().Download(gomock.Any(), "author/test.png").Return(ioutil.NopCloser(bytes.NewBufferString("image")), nil)
+
+		var buf bytes.Buffer
+
+		// When
+		manifest, err := archiver.Create(context.Background(), &buf)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Len(t, manifest.Objects, 1)
+		_, err = Verify(&buf)
+		assert.Nil(t, err)
+	})
+
+	t.Run("When the collection has a truncated document", func(t *testing.T) {
+		// Given
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gw)
+		truncated := append(append([]byte{}, docs["posts"]...), docs["posts"][:6]...)
+		_ = tw.WriteHeader(&tar.Header{Name: "collections/posts.bson", Mode: 0644, Size: int64(len(truncated)), Typeflag: tar.TypeReg})
+		_, _ = tw.Write(truncated)
+		_ = tw.Close()
+		_ = gw.Close()
+
+		collections["posts"].EXPECT().InsertOne(gomock.Any(), docs["posts"]).Return(&mgo.InsertOneResult{}, nil)
+
+		// When
+		err := archiver.Restore(context.Background(), &buf)
+
+		// Then
+		assert.EqualError(t, err, "collections/posts.bson: invalid BSON document")
+	})
+
+	t.Run("When the archive has been modified", func(t *testing.T) {
+		// Given
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gw)
+
+		_ = walk(bytes.NewReader(createArchive(ioutil.NopCloser(bytes.NewBufferString("image")))), func(name string, body io.Reader) error {
+			b, _ := ioutil.ReadAll(body)
+			if name == "storage/author/test.png" {
+				b = []byte("IMAGE")
+			}
+			_ = tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(b))})
+			_, _ = tw.Write(b)
+			return nil
+		})
+		_ = tw.Close()
+		_ = gw.Close()
+
+		// When
+		_, err := Verify(&buf)
+
+		// Then
+		assert.EqualError(t, err, "storage/author/test.png: checksum mismatch")
+	})
+
+	t.Run("When the database is not empty", func(t *testing.T) {
+		// Given
+		collections["posts"].EXPECT().CountDocuments(gomock.Any(), bson.D{}).Return(int64(1), nil)
+
+		// When
+		err := archiver.CheckEmpty(context.Background(), Manifest{})
+
+		// Then
+		assert.EqualError(t, err, "the posts collection is not empty")
+	})
+
+	t.Run("When unable to find documents in the collection", func(t *testing.T) {
+		// Given
+		collections["posts"].EXPECT().Find(gomock.Any(), bson.D{}).Return(nil, errors.New("test unable to find documents"))
+
+		// When
+		_, err := archiver.Create(context.Background(), ioutil.Discard)
+
+		// Then
+		assert.EqualError(t, err, "test unable to find documents")
+	})
+
+	t.Run("When unable to iterate over the collection", func(t *testing.T) {
+		// Given
+		cur := mock_mongo.NewMockCursor(ctrl)
+		collections["posts"].EXPECT().Find(gomock.Any(), bson.D{}).Return(cur, nil)
+		cur.EXPECT().Next(gomock.Any()).Return(false)
+		cur.EXPECT().Err().Return(errors.New("test cursor error"))
+		cur.EXPECT().Close(gomock.Any()).Return(nil)
+
+		// When
+		_, err := archiver.Create(context.Background(), ioutil.Discard)
+
+		// Then
+		assert.EqualError(t, err, "unable to read the posts collection: test cursor error")
+	})
+}
+
+func TestVerify(t *testing.T) {
+	t.Run("Without manifest", func(t *testing.T) {
+		// Given
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gw)
+		_ = tw.Close()
+		_ = gw.Close()
+
+		// When
+		_, err := Verify(&buf)
+
+		// Then
+		assert.EqualError(t, err, "manifest not found")
+	})
+}
@@ -5,7 +5,9 @@ package blog
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	slugify "github.com/nomkhonwaan/myblog/pkg/slug"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -21,6 +23,9 @@ type Category struct {
 
 	// Valid URL string composes with name and ID
 	Slug string `bson:"slug" json:"slug" graphql:"slug"`
+
+	// An optional parent category (in reference to the category collection), the category is a root category if empty
+	Parent mongo.DBRef `bson:"parent" json:"-" graphql:"-"`
 }
 
 // MarshalJSON is a custom JSON marshaling function of category entity
@@ -37,9 +42,11 @@ func (cat Category) MarshalJSON() ([]byte, error) {
 
 // A CategoryRepository interface
 type CategoryRepository interface {
+	Create(ctx context.Context, name string) (Category, error)
 	FindAll(ctx context.Context) ([]Category, error)
 	FindAllByIDs(ctx context.Context, ids interface{}) ([]Category, error)
 	FindByID(ctx context.Context, id interface{}) (Category, error)
+	UpdateParent(ctx context.Context, id, parentID interface{}) (Category, error)
 }
 
 // NewCategoryRepository returns a MongoCategoryRepository instance
@@ -50,6 +57,24 @@ func NewCategoryRepository(db mongo.Database) MongoCategoryRepository {
 // MongoCategoryRepository implements CategoryRepository interface
 type MongoCategoryRepository struct{ col mongo.Collection }
 
+// Create inserts a new category with the given name and generates its slug from the name and ID
+func (repo MongoCategoryRepository) Create(ctx context.Context, name string) (Category, error) {
+	id := primitive.NewObjectID()
+	cat := Category{
+		ID:   id,
+		Name: name,
+		Slug: fmt.Sprintf("%s-%s", slugify.Make(name), id.Hex()),
+	}
+
+	doc, _ := bson.Marshal(cat)
+	_, err := repo.col.InsertOne(ctx, doc)
+	if err != nil {
+		return Category{}, err
+	}
+
+	return cat, nil
+}
+
 // FindAll returns list of categories
 func (repo MongoCategoryRepository) FindAll(ctx context.Context) ([]Category, error) {
 	opts := options.Find().SetSort(bson.D{{"name", 1}})
@@ -90,3 +115,18 @@ func (repo MongoCategoryRepository) FindByID(ctx context.Context, id interface{}
 
 	return cat, err
 }
+
+// UpdateParent sets the parent category, the category becomes a root category if the parent ID is zero
+func (repo MongoCategoryRepository) UpdateParent(ctx context.Context, id, parentID interface{}) (Category, error) {
+	parent := mongo.DBRef{}
+	if pid := parentID.(primitive.ObjectID); !pid.IsZero() {
+		parent = mongo.DBRef{Ref: "categories", ID: pid}
+	}
+
+	_, err := repo.col.UpdateOne(ctx, bson.M{"_id": id.(primitive.ObjectID)}, bson.M{"$set": bson.M{"parent": parent}})
+	if err != nil {
+		return Category{}, err
+	}
+
+	return repo.FindByID(ctx, id)
+}
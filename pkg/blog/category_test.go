@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
 	mock_mongo "github.com/nomkhonwaan/myblog/pkg/mongo/mock"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	mgo "go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"testing"
 )
@@ -30,6 +32,46 @@ func TestCategory_MarshalJSON(t *testing.T) {
 	assert.Equal(t, "{\"id\":\""+id.Hex()+"\",\"name\":\"Test\",\"slug\":\"test-"+id.Hex()+"\"}", string(result))
 }
 
+func TestMongoCategoryRepository_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		col = mock_mongo.NewMockCollection(ctrl)
+	)
+
+	repo := MongoCategoryRepository{col: col}
+
+	t.Run("With successful creating a new category", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+
+		col.EXPECT().InsertOne(ctx, gomock.Any()).Return(&mgo.InsertOneResult{}, nil)
+
+		// When
+		result, err := repo.Create(ctx, "Web Development")
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, "Web Development", result.Name)
+		assert.Equal(t, "web-development-"+result.ID.Hex(), result.Slug)
+	})
+
+	t.Run("When unable to create a new category", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+
+		col.EXPECT().InsertOne(ctx, gomock.Any()).Return(nil, errors.New("test unable to create a new category"))
+
+		// When
+		result, err := repo.Create(ctx, "Web Development")
+
+		// Then
+		assert.EqualError(t, err, "test unable to create a new category")
+		assert.Equal(t, Category{}, result)
+	})
+}
+
 func TestMongoCategoryRepository_FindAll(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -159,3 +201,57 @@ func TestMongoCategoryRepository_FindByID(t *testing.T) {
 
 	// Then
 }
+
+func TestMongoCategoryRepository_UpdateParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		col          = mock_mongo.NewMockCollection(ctrl)
+		singleResult = mock_mongo.NewMockSingleResult(ctrl)
+	)
+
+	ctx := context.Background()
+	repo := MongoCategoryRepository{col: col}
+	id := primitive.NewObjectID()
+	parentID := primitive.NewObjectID()
+
+	t.Run("With successful setting the parent category", func(t *testing.T) {
+		// Given
+		col.EXPECT().UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"parent": mongo.DBRef{Ref: "categories", ID: parentID}}}).
+			Return(&mgo.UpdateResult{}, nil)
+		col.EXPECT().FindOne(ctx, bson.M{"_id": id}).Return(singleResult)
+		singleResult.EXPECT().Decode(gomock.Any()).Return(nil)
+
+		// When
+		_, err := repo.UpdateParent(ctx, id, parentID)
+
+		// Then
+		assert.Nil(t, err)
+	})
+
+	t.Run("With successful removing the parent category", func(t *testing.T) {
+		// Given
+		col.EXPECT().UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"parent": mongo.DBRef{}}}).
+			Return(&mgo.UpdateResult{}, nil)
+		col.EXPECT().FindOne(ctx, bson.M{"_id": id}).Return(singleResult)
+		singleResult.EXPECT().Decode(gomock.Any()).Return(nil)
+
+		// When
+		_, err := repo.UpdateParent(ctx, id, primitive.NilObjectID)
+
+		// Then
+		assert.Nil(t, err)
+	})
+
+	t.Run("When unable to update the category", func(t *testing.T) {
+		// Given
+		col.EXPECT().UpdateOne(ctx, gomock.Any(), gomock.Any()).Return(nil, errors.New("test unable to update the category"))
+
+		// When
+		_, err := repo.UpdateParent(ctx, id, parentID)
+
+		// Then
+		assert.EqualError(t, err, "test unable to update the category")
+	})
+}
@@ -0,0 +1,136 @@
+package blog
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Breadcrumb is a single level of the navigation path to the post
+type Breadcrumb struct {
+	// Name of the category or title of the post
+	Name string `json:"name" graphql:"name"`
+
+	// A URL path of the category or post
+	Path string `json:"path" graphql:"path"`
+}
+
+// CategoryTree is an in-memory hierarchy which built from list of all categories,
+// a category without parent or with an unknown parent is a root category
+type CategoryTree struct {
+	categories map[primitive.ObjectID]Category
+	children   map[primitive.ObjectID][]Category
+}
+
+// NewCategoryTree returns a CategoryTree from list of all categories, the order of children follows the given list
+func NewCategoryTree(categories []Category) CategoryTree {
+	t := CategoryTree{
+		categories: make(map[primitive.ObjectID]Category, len(categories)),
+		children:   make(map[primitive.ObjectID][]Category),
+	}
+	for _, c := range categories {
+		t.categories[c.ID] = c
+	}
+	for _, c := range categories {
+		parentID := primitive.NilObjectID
+		if _, ok := t.categories[c.Parent.ID]; ok && c.Parent.ID != c.ID {
+			parentID = c.Parent.ID
+		}
+		t.children[parentID] = append(t.children[parentID], c)
+	}
+	return t
+}
+
+// Get returns a category from its ID
+func (t CategoryTree) Get(id primitive.ObjectID) (Category, bool) {
+	c, ok := t.categories[id]
+	return c, ok
+}
+
+// Roots returns list of all root categories
+func (t CategoryTree) Roots() []Category {
+	return t.children[primitive.NilObjectID]
+}
+
+// Parent returns a parent category, returns false if the category is a root category
+func (t CategoryTree) Parent(c Category) (Category, bool) {
+	if c.Parent.ID == c.ID {
+		return Category{}, false
+	}
+	return t.Get(c.Parent.ID)
+}
+
+// Children returns list of direct children of the category
+func (t CategoryTree) Children(c Category) []Category {
+	return t.children[c.ID]
+}
+
+// Ancestors returns list of ancestors from the root category to the direct parent
+func (t CategoryTree) Ancestors(c Category) []Category {
+	var ancestors []Category
+	visited := map[primitive.ObjectID]bool{c.ID: true}
+
+	for parent, ok := t.Parent(c); ok && !visited[parent.ID]; parent, ok = t.Parent(parent) {
+		visited[parent.ID] = true
+		ancestors = append([]Category{parent}, ancestors...)
+	}
+
+	return ancestors
+}
+
+// Descendants returns list of all descendants in depth-first order
+func (t CategoryTree) Descendants(c Category) []Category {
+	var descendants []Category
+	visited := map[primitive.ObjectID]bool{c.ID: true}
+
+	var walk func(Category)
+	walk = func(parent Category) {
+		for _, child := range t.children[parent.ID] {
+			if visited[child.ID] {
+				continue
+			}
+			visited[child.ID] = true
+			descendants = append(descendants, child)
+			walk(child)
+		}
+	}
+	walk(c)
+
+	return descendants
+}
+
+// IsDescendant returns true if the category is the ancestor itself or one of its descendants
+func (t CategoryTree) IsDescendant(c, ancestor Category) bool {
+	if c.ID == ancestor.ID {
+		return true
+	}
+	for _, d := range t.Descendants(ancestor) {
+		if d.ID == c.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// Permalink returns a URL path of the category which composes with slugs of all ancestors,
+// e.g. "/category/programming-{id}/go-{id}/concurrency-{id}"
+func (t CategoryTree) Permalink(c Category) string {
+	path := "/category"
+	for _, a := range t.Ancestors(c) {
+		path += "/" + a.Slug
+	}
+	return path + "/" + c.Slug
+}
+
+// Breadcrumbs returns the navigation path to the post through the ancestors of its first category
+func (t CategoryTree) Breadcrumbs(p Post) []Breadcrumb {
+	var breadcrumbs []Breadcrumb
+
+	if len(p.Categories) > 0 {
+		if c, ok := t.Get(p.Categories[0].ID); ok {
+			for _, a := range append(t.Ancestors(c), c) {
+				breadcrumbs = append(breadcrumbs, Breadcrumb{Name: a.Name, Path: t.Permalink(a)})
+			}
+		}
+	}
+
+	return append(breadcrumbs, Breadcrumb{Name: p.Title, Path: p.Permalink()})
+}
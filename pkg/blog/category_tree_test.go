@@ -0,0 +1,92 @@
+package blog
+
+import (
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+	"time"
+)
+
+func TestCategoryTree(t *testing.T) {
+	newCategory := func(slug string, parent Category) Category {
+		c := Category{ID: primitive.NewObjectID(), Name: slug, Slug: slug}
+		if !parent.ID.IsZero() {
+			c.Parent = mongo.DBRef{Ref: "categories", ID: parent.ID}
+		}
+		return c
+	}
+
+	programming := newCategory("programming", Category{})
+	golang := newCategory("go", programming)
+	concurrency := newCategory("concurrency", golang)
+	unitTesting := newCategory("testing", golang)
+	orphan := newCategory("orphan", Category{ID: primitive.NewObjectID()})
+
+	tree := NewCategoryTree([]Category{programming, golang, concurrency, unitTesting, orphan})
+
+	t.Run("With hierarchical categories", func(t *testing.T) {
+		// Given
+
+		// When
+		parent, ok := tree.Parent(concurrency)
+
+		// Then
+		assert.True(t, ok)
+		assert.Equal(t, golang, parent)
+		assert.Equal(t, []Category{programming, orphan}, tree.Roots())
+		assert.Equal(t, []Category{concurrency, unitTesting}, tree.Children(golang))
+		assert.Equal(t, []Category{programming, golang}, tree.Ancestors(concurrency))
+		assert.Equal(t, []Category{golang, concurrency, unitTesting}, tree.Descendants(programming))
+		assert.True(t, tree.IsDescendant(unitTesting, programming))
+		assert.False(t, tree.IsDescendant(programming, unitTesting))
+		assert.Equal(t, "/category/programming/go/concurrency", tree.Permalink(concurrency))
+	})
+
+	t.Run("With an unknown parent category", func(t *testing.T) {
+		// Given
+
+		// When
+		_, ok := tree.Parent(orphan)
+
+		// Then
+		assert.False(t, ok)
+		assert.Equal(t, "/category/orphan", tree.Permalink(orphan))
+	})
+
+	t.Run("With circular parent references", func(t *testing.T) {
+		// Given
+		a := Category{ID: primitive.NewObjectID(), Slug: "a"}
+		b := Category{ID: primitive.NewObjectID(), Slug: "b", Parent: mongo.DBRef{ID: a.ID}}
+		a.Parent = mongo.DBRef{ID: b.ID}
+		tree := NewCategoryTree([]Category{a, b})
+
+		// When
+		ancestors := tree.Ancestors(a)
+
+		// Then
+		assert.Equal(t, []Category{b}, ancestors)
+		assert.Equal(t, []Category{b}, tree.Descendants(a))
+	})
+
+	t.Run("With breadcrumbs of the post", func(t *testing.T) {
+		// Given
+		p := Post{
+			Title:       "Test",
+			Slug:        "test",
+			PublishedAt: time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC),
+			Categories:  []mongo.DBRef{{ID: concurrency.ID}},
+		}
+
+		// When
+		breadcrumbs := tree.Breadcrumbs(p)
+
+		// Then
+		assert.Equal(t, []Breadcrumb{
+			{Name: "programming", Path: "/category/programming"},
+			{Name: "go", Path: "/category/programming/go"},
+			{Name: "concurrency", Path: "/category/programming/go/concurrency"},
+			{Name: "Test", Path: "/2020/3/29/test"},
+		}, breadcrumbs)
+	})
+}
@@ -4,4 +4,7 @@ package blog
 type Engagement struct {
 	// Total object shared counter
 	ShareCount int `bson:"-" json:"shareCount" graphql:"shareCount"`
+
+	// Total comments on the object including comments plugin
+	CommentCount int `bson:"-" json:"commentCount" graphql:"commentCount"`
 }
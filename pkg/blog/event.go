@@ -0,0 +1,26 @@
+package blog
+
+// PostPublished is an event which occurs when a draft post has been published
+type PostPublished struct{ Post Post }
+
+// EventName returns a name of the event
+func (PostPublished) EventName() string { return "post.published" }
+
+// PostUnpublished is an event which occurs when a published post has been moved back to draft
+type PostUnpublished struct{ Post Post }
+
+// EventName returns a name of the event
+func (PostUnpublished) EventName() string { return "post.unpublished" }
+
+// PostContentChanged is an event which occurs when a post has been saved without changing its visibility,
+// the previous state is for invalidating anything which belongs to the removed categories, tags or the old slug
+type PostContentChanged struct{ Post, Previous Post }
+
+// EventName returns a name of the event
+func (PostContentChanged) EventName() string { return "post.content_changed" }
+
+// CategoryParentChanged is an event which occurs when a category has been moved in the hierarchy
+type CategoryParentChanged struct{ Category Category }
+
+// EventName returns a name of the event
+func (CategoryParentChanged) EventName() string { return "category.parent_changed" }
@@ -0,0 +1,299 @@
+package blog
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/nomkhonwaan/myblog/pkg/auth"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultPostLimit is a number of posts per page when the "limit" query string is omitted
+	DefaultPostLimit = 10
+
+	// MaxPostLimit is a maximum number of posts per page
+	MaxPostLimit = 100
+)
+
+// FindAllPublishedPostsHandlerFunc handles a request of the latest published posts
+// with the optional "offset" and "limit" query strings
+func FindAllPublishedPostsHandlerFunc(repository PostRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		offset, limit, err := getOffsetAndLimitFromQuery(r.URL.Query())
+		if err != nil {
+			respondError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		posts, err := repository.FindAll(r.Context(), NewPostQueryBuilder().WithStatus(StatusPublished).
+			WithOffset(offset).WithLimit(limit).Build())
+		if err != nil {
+			respondError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if posts == nil {
+			posts = []Post{}
+		}
+		respondJSON(w, r, posts)
+	}
+}
+
+// FindPostBySlugHandlerFunc handles a request of a single post, the draft post is only visible to its author
+func FindPostBySlugHandlerFunc(repository PostRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := GetIDFromSlug(chi.URLParam(r, "slug"))
+		if err != nil {
+			respondError(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+
+		p, err := repository.FindByID(r.Context(), id)
+		if err != nil {
+			respondError(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+
+		if !p.Status.IsPublished() {
+			if authID, ok := auth.GetAuthorizedUserID(r.Context()).(string); !ok || p.AuthorID != authID {
+				respondError(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+			w.Header().Set("Cache-Control", "private, no-cache")
+		}
+
+		respondJSON(w, r, p)
+	}
+}
+
+// FindAllCategoriesHandlerFunc handles a request of all categories
+func FindAllCategoriesHandlerFunc(repository CategoryRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cats, err := repository.FindAll(r.Context())
+		if err != nil {
+			respondError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if cats == nil {
+			cats = []Category{}
+		}
+		respondJSON(w, r, cats)
+	}
+}
+
+// FindCategoryBySlugHandlerFunc handles a request of a single category
+func FindCategoryBySlugHandlerFunc(repository CategoryRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c, ok := findCategoryBySlug(w, r, repository)
+		if !ok {
+			return
+		}
+
+		respondJSON(w, r, c)
+	}
+}
+
+// FindAllPublishedPostsBelongedToCategoryHandlerFunc handles a request of the latest published posts of the category
+// with the optional "offset", "limit" and "includeDescendants" query strings
+func FindAllPublishedPostsBelongedToCategoryHandlerFunc(repository PostRepository, categoryRepository CategoryRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		offset, limit, err := getOffsetAndLimitFromQuery(r.URL.Query())
+		if err != nil {
+			respondError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		c, ok := findCategoryBySlug(w, r, categoryRepository)
+		if !ok {
+			return
+		}
+
+		var descendants []Category
+		if includeDescendants, _ := strconv.ParseBool(r.URL.Query().Get("includeDescendants")); includeDescendants {
+			cats, err := categoryRepository.FindAll(r.Context())
+			if err != nil {
+				respondError(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			descendants = NewCategoryTree(cats).Descendants(c)
+		}
+
+		posts, err := repository.FindAll(r.Context(), NewPostQueryBuilder().WithCategory(c, descendants...).
+			WithStatus(StatusPublished).WithOffset(offset).WithLimit(limit).Build())
+		if err != nil {
+			respondError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if posts == nil {
+			posts = []Post{}
+		}
+		respondJSON(w, r, posts)
+	}
+}
+
+// FindAllTagsHandlerFunc handles a request of all tags
+func FindAllTagsHandlerFunc(repository TagRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tags, err := repository.FindAll(r.Context())
+		if err != nil {
+			respondError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if tags == nil {
+			tags = []Tag{}
+		}
+		respondJSON(w, r, tags)
+	}
+}
+
+// FindTagBySlugHandlerFunc handles a request of a single tag
+func FindTagBySlugHandlerFunc(repository TagRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		t, ok := findTagBySlug(w, r, repository)
+		if !ok {
+			return
+		}
+
+		respondJSON(w, r, t)
+	}
+}
+
+// FindAllPublishedPostsBelongedToTagHandlerFunc handles a request of the latest published posts of the tag
+// with the optional "offset" and "limit" query strings
+func FindAllPublishedPostsBelongedToTagHandlerFunc(repository PostRepository, tagRepository TagRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		offset, limit, err := getOffsetAndLimitFromQuery(r.URL.Query())
+		if err != nil {
+			respondError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		t, ok := findTagBySlug(w, r, tagRepository)
+		if !ok {
+			return
+		}
+
+		posts, err := repository.FindAll(r.Context(), NewPostQueryBuilder().WithTag(t).
+			WithStatus(StatusPublished).WithOffset(offset).WithLimit(limit).Build())
+		if err != nil {
+			respondError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if posts == nil {
+			posts = []Post{}
+		}
+		respondJSON(w, r, posts)
+	}
+}
+
+func findCategoryBySlug(w http.ResponseWriter, r *http.Request, repository CategoryRepository) (Category, bool) {
+	id, err := GetIDFromSlug(chi.URLParam(r, "slug"))
+	if err != nil {
+		respondError(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return Category{}, false
+	}
+
+	c, err := repository.FindByID(r.Context(), id)
+	if err != nil {
+		respondError(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return Category{}, false
+	}
+	return c, true
+}
+
+func findTagBySlug(w http.ResponseWriter, r *http.Request, repository TagRepository) (Tag, bool) {
+	id, err := GetIDFromSlug(chi.URLParam(r, "slug"))
+	if err != nil {
+		respondError(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return Tag{}, false
+	}
+
+	t, err := repository.FindByID(r.Context(), id)
+	if err != nil {
+		respondError(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return Tag{}, false
+	}
+	return t, true
+}
+
+func getOffsetAndLimitFromQuery(values url.Values) (int64, int64, error) {
+	offset, limit := int64(0), int64(DefaultPostLimit)
+
+	if v := values.Get("offset"); v != "" {
+		var err error
+		if offset, err = strconv.ParseInt(v, 10, 64); err != nil || offset < 0 {
+			return 0, 0, errors.New("offset must be a non-negative integer")
+		}
+	}
+	if v := values.Get("limit"); v != "" {
+		var err error
+		if limit, err = strconv.ParseInt(v, 10, 64); err != nil || limit < 1 || limit > MaxPostLimit {
+			return 0, 0, fmt.Errorf("limit must be an integer between 1 and %d", MaxPostLimit)
+		}
+	}
+
+	return offset, limit, nil
+}
+
+// respondJSON writes the JSON body along with its ETag,
+// responds "304 Not Modified" without the body if the client already has the same representation
+func respondJSON(w http.ResponseWriter, r *http.Request, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		respondError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	etag := fmt.Sprintf(`"%x"`, sha256.Sum256(body))
+	w.Header().Set("ETag", etag)
+	if w.Header().Get("Cache-Control") == "" {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+
+	if matchETag(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+// matchETag returns "true" if one of the entity tags in the "If-None-Match" header matches the ETag, regardless of weakness
+func matchETag(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func respondError(w http.ResponseWriter, message string, code int) {
+	var data struct {
+		Error struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+
+	data.Error.Code = code
+	data.Error.Message = message
+
+	val, _ := json.Marshal(data)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(val)
+}
@@ -0,0 +1,339 @@
+package blog_test
+
+import (
+	"context"
+	"errors"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/auth"
+	. "github.com/nomkhonwaan/myblog/pkg/blog"
+	mock_blog "github.com/nomkhonwaan/myblog/pkg/blog/mock"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newRouter(repository PostRepository, categoryRepository CategoryRepository, tagRepository TagRepository) http.Handler {
+	r := chi.NewRouter()
+	r.Get("/posts", FindAllPublishedPostsHandlerFunc(repository))
+	r.Get("/posts/{slug}", FindPostBySlugHandlerFunc(repository))
+	r.Get("/categories", FindAllCategoriesHandlerFunc(categoryRepository))
+	r.Get("/categories/{slug}", FindCategoryBySlugHandlerFunc(categoryRepository))
+	r.Get("/categories/{slug}/posts", FindAllPublishedPostsBelongedToCategoryHandlerFunc(repository, categoryRepository))
+	r.Get("/tags", FindAllTagsHandlerFunc(tagRepository))
+	r.Get("/tags/{slug}", FindTagBySlugHandlerFunc(tagRepository))
+	r.Get("/tags/{slug}/posts", FindAllPublishedPostsBelongedToTagHandlerFunc(repository, tagRepository))
+	return r
+}
+
+func TestFindAllPublishedPostsHandlerFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository = mock_blog.NewMockPostRepository(ctrl)
+	)
+
+	router := newRouter(repository, nil, nil)
+	id := primitive.NewObjectID()
+
+	t.Run("With successful finding all published posts", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/posts?offset=5&limit=5", nil)
+
+		repository.EXPECT().FindAll(gomock.Any(), NewPostQueryBuilder().WithStatus(StatusPublished).WithOffset(5).WithLimit(5).Build()).Return([]Post{{ID: id, Title: "Test"}}, nil)
+
+		// When
+		router.ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.NotEmpty(t, w.Header().Get("ETag"))
+		assert.Contains(t, w.Body.String(), `"id":"`+id.Hex()+`","title":"Test"`)
+	})
+
+	t.Run("With default offset and limit", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/posts", nil)
+
+		repository.EXPECT().FindAll(gomock.Any(), NewPostQueryBuilder().WithStatus(StatusPublished).WithOffset(0).WithLimit(DefaultPostLimit).Build()).Return(nil, nil)
+
+		// When
+		router.ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "[]", w.Body.String())
+	})
+
+	t.Run("With matching ETag", func(t *testing.T) {
+		// Given
+		repository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return([]Post{{ID: id, Title: "Test"}}, nil).Times(2)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/posts", nil))
+		etag := w.Header().Get("ETag")
+
+		w = httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/posts", nil)
+		r.Header.Set("If-None-Match", `"other", W/`+etag)
+
+		// When
+		router.ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Equal(t, etag, w.Header().Get("ETag"))
+		assert.Equal(t, "", w.Body.String())
+	})
+
+	t.Run("When the limit is out of range", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/posts?limit=1000", nil)
+
+		// When
+		router.ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, `{"error":{"code":400,"message":"limit must be an integer between 1 and 100"}}`, w.Body.String())
+	})
+
+	t.Run("When unable to find all published posts", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/posts", nil)
+
+		repository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, errors.New("test unable to find all published posts"))
+
+		// When
+		router.ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestFindPostBySlugHandlerFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository = mock_blog.NewMockPostRepository(ctrl)
+	)
+
+	router := newRouter(repository, nil, nil)
+	id := primitive.NewObjectID()
+
+	withAuthorizedID := func(r *http.Request, authorizedID string) *http.Request {
+		return r.WithContext(context.WithValue(r.Context(), auth.UserProperty, &jwt.Token{Claims: jwt.MapClaims{"sub": authorizedID}}))
+	}
+
+	t.Run("With published post", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/posts/test-"+id.Hex(), nil)
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(Post{ID: id, Title: "Test", Status: StatusPublished}, nil)
+
+		// When
+		router.ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+		assert.Contains(t, w.Body.String(), `"status":"PUBLISHED"`)
+	})
+
+	t.Run("With draft post of the author", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := withAuthorizedID(httptest.NewRequest(http.MethodGet, "/posts/test-"+id.Hex(), nil), "authorizedID")
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(Post{ID: id, Title: "Test", Status: StatusDraft, AuthorID: "authorizedID"}, nil)
+
+		// When
+		router.ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "private, no-cache", w.Header().Get("Cache-Control"))
+	})
+
+	t.Run("When the draft post belongs to other author", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := withAuthorizedID(httptest.NewRequest(http.MethodGet, "/posts/test-"+id.Hex(), nil), "otherID")
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(Post{ID: id, Title: "Test", Status: StatusDraft, AuthorID: "authorizedID"}, nil)
+
+		// When
+		router.ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("When the slug is malformed", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/posts/test", nil)
+
+		// When
+		router.ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("When unable to find the post", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/posts/test-"+id.Hex(), nil)
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(Post{}, errors.New("test unable to find the post"))
+
+		// When
+		router.ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestFindAllCategoriesHandlerFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		categoryRepository = mock_blog.NewMockCategoryRepository(ctrl)
+	)
+
+	router := newRouter(nil, categoryRepository, nil)
+
+	// Given
+	id := primitive.NewObjectID()
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/categories", nil)
+
+	categoryRepository.EXPECT().FindAll(gomock.Any()).Return([]Category{{ID: id, Name: "Web Development", Slug: "web-development-" + id.Hex()}}, nil)
+
+	// When
+	router.ServeHTTP(w, r)
+
+	// Then
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"id":"`+id.Hex()+`","name":"Web Development"`)
+}
+
+func TestFindAllPublishedPostsBelongedToCategoryHandlerFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository         = mock_blog.NewMockPostRepository(ctrl)
+		categoryRepository = mock_blog.NewMockCategoryRepository(ctrl)
+	)
+
+	router := newRouter(repository, categoryRepository, nil)
+	parent := Category{ID: primitive.NewObjectID(), Name: "Web Development"}
+	child := Category{ID: primitive.NewObjectID(), Name: "Go", Parent: mongo.DBRef{Ref: "categories", ID: parent.ID}}
+
+	t.Run("With published posts of the category", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/categories/web-development-"+parent.ID.Hex()+"/posts", nil)
+
+		categoryRepository.EXPECT().FindByID(gomock.Any(), parent.ID).Return(parent, nil)
+		repository.EXPECT().FindAll(gomock.Any(), NewPostQueryBuilder().WithCategory(parent).WithStatus(StatusPublished).WithOffset(0).WithLimit(DefaultPostLimit).Build()).Return([]Post{{Title: "Test"}}, nil)
+
+		// When
+		router.ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"title":"Test"`)
+	})
+
+	t.Run("With published posts of the category and its descendants", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/categories/web-development-"+parent.ID.Hex()+"/posts?includeDescendants=true", nil)
+
+		categoryRepository.EXPECT().FindByID(gomock.Any(), parent.ID).Return(parent, nil)
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return([]Category{parent, child}, nil)
+		repository.EXPECT().FindAll(gomock.Any(), NewPostQueryBuilder().WithCategory(parent, child).WithStatus(StatusPublished).WithOffset(0).WithLimit(DefaultPostLimit).Build()).Return([]Post{{Title: "Test"}}, nil)
+
+		// When
+		router.ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("When unable to find the category", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/categories/web-development-"+parent.ID.Hex()+"/posts", nil)
+
+		categoryRepository.EXPECT().FindByID(gomock.Any(), parent.ID).Return(Category{}, errors.New("test unable to find the category"))
+
+		// When
+		router.ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestFindAllPublishedPostsBelongedToTagHandlerFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository    = mock_blog.NewMockPostRepository(ctrl)
+		tagRepository = mock_blog.NewMockTagRepository(ctrl)
+	)
+
+	router := newRouter(repository, nil, tagRepository)
+	tag := Tag{ID: primitive.NewObjectID(), Name: "Go"}
+
+	t.Run("With published posts of the tag", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/tags/go-"+tag.ID.Hex()+"/posts?limit=1", nil)
+
+		tagRepository.EXPECT().FindByID(gomock.Any(), tag.ID).Return(tag, nil)
+		repository.EXPECT().FindAll(gomock.Any(), NewPostQueryBuilder().WithTag(tag).WithStatus(StatusPublished).WithOffset(0).WithLimit(1).Build()).Return([]Post{{Title: "Test"}}, nil)
+
+		// When
+		router.ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"title":"Test"`)
+	})
+
+	t.Run("With single tag", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/tags/go-"+tag.ID.Hex(), nil)
+
+		tagRepository.EXPECT().FindByID(gomock.Any(), tag.ID).Return(tag, nil)
+
+		// When
+		router.ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"name":"Go"`)
+	})
+}
@@ -34,6 +34,21 @@ func (m *MockCategoryRepository) EXPECT() *MockCategoryRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Create mocks base method
+func (m *MockCategoryRepository) Create(arg0 context.Context, arg1 string) (blog.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(blog.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create
+func (mr *MockCategoryRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCategoryRepository)(nil).Create), arg0, arg1)
+}
+
 // FindAll mocks base method
 func (m *MockCategoryRepository) FindAll(arg0 context.Context) ([]blog.Category, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +93,18 @@ func (mr *MockCategoryRepositoryMockRecorder) FindByID(arg0, arg1 interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCategoryRepository)(nil).FindByID), arg0, arg1)
 }
+
+// UpdateParent mocks base method
+func (m *MockCategoryRepository) UpdateParent(arg0 context.Context, arg1, arg2 interface{}) (blog.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateParent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(blog.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateParent indicates an expected call of UpdateParent
+func (mr *MockCategoryRepositoryMockRecorder) UpdateParent(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateParent", reflect.TypeOf((*MockCategoryRepository)(nil).UpdateParent), arg0, arg1, arg2)
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	gomock "github.com/golang/mock/gomock"
 	blog "github.com/nomkhonwaan/myblog/pkg/blog"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
 	reflect "reflect"
 )
 
@@ -64,6 +65,36 @@ func (mr *MockPostRepositoryMockRecorder) FindAll(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPostRepository)(nil).FindAll), arg0, arg1)
 }
 
+// FindAllCategoryStats mocks base method
+func (m *MockPostRepository) FindAllCategoryStats(arg0 context.Context, arg1 interface{}) (map[primitive.ObjectID]blog.Stats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllCategoryStats", arg0, arg1)
+	ret0, _ := ret[0].(map[primitive.ObjectID]blog.Stats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllCategoryStats indicates an expected call of FindAllCategoryStats
+func (mr *MockPostRepositoryMockRecorder) FindAllCategoryStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllCategoryStats", reflect.TypeOf((*MockPostRepository)(nil).FindAllCategoryStats), arg0, arg1)
+}
+
+// FindAllTagStats mocks base method
+func (m *MockPostRepository) FindAllTagStats(arg0 context.Context, arg1 interface{}) (map[primitive.ObjectID]blog.Stats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllTagStats", arg0, arg1)
+	ret0, _ := ret[0].(map[primitive.ObjectID]blog.Stats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllTagStats indicates an expected call of FindAllTagStats
+func (mr *MockPostRepositoryMockRecorder) FindAllTagStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllTagStats", reflect.TypeOf((*MockPostRepository)(nil).FindAllTagStats), arg0, arg1)
+}
+
 // FindByID mocks base method
 func (m *MockPostRepository) FindByID(arg0 context.Context, arg1 interface{}) (blog.Post, error) {
 	m.ctrl.T.Helper()
@@ -34,6 +34,21 @@ func (m *MockTagRepository) EXPECT() *MockTagRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Create mocks base method
+func (m *MockTagRepository) Create(arg0 context.Context, arg1 string) (blog.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(blog.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create
+func (mr *MockTagRepositoryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTagRepository)(nil).Create), arg0, arg1)
+}
+
 // FindAll mocks base method
 func (m *MockTagRepository) FindAll(arg0 context.Context) ([]blog.Tag, error) {
 	m.ctrl.T.Helper()
@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"github.com/nomkhonwaan/myblog/pkg/mongo"
 	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"github.com/nomkhonwaan/myblog/pkg/timeutil"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	mgo "go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"time"
 )
@@ -76,10 +78,26 @@ func (p Post) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// Permalink returns a URL path of the post which composes with the published date (in Asia/Bangkok) and slug
+func (p Post) Permalink() string {
+	return "/" + p.PublishedAt.In(timeutil.TimeZoneAsiaBangkok).Format("2006/1/2") + "/" + p.Slug
+}
+
+// Stats is a statistic of published posts which belong to a category or tag
+type Stats struct {
+	// Number of published posts
+	PostCount int `bson:"postCount" json:"postCount"`
+
+	// Date-time that the latest post was published
+	LatestPublishedAt time.Time `bson:"latestPublishedAt" json:"latestPublishedAt"`
+}
+
 // A PostRepository interface
 type PostRepository interface {
 	Create(ctx context.Context, authorID string) (Post, error)
 	FindAll(ctx context.Context, q PostQuery) ([]Post, error)
+	FindAllCategoryStats(ctx context.Context, ids interface{}) (map[primitive.ObjectID]Stats, error)
+	FindAllTagStats(ctx context.Context, ids interface{}) (map[primitive.ObjectID]Stats, error)
 	FindByID(ctx context.Context, id interface{}) (Post, error)
 	Save(ctx context.Context, id interface{}, q PostQuery) (Post, error)
 }
@@ -133,8 +151,19 @@ func (repo MongoPostRepository) FindAll(ctx context.Context, q PostQuery) ([]Pos
 	if authorID := q.AuthorID(); authorID != nil {
 		filter["authorId"] = authorID
 	}
+	if ids := q.IDs(); ids != nil {
+		filter["_id"] = bson.M{"$in": ids}
+	}
 	if cat := q.Category(); cat != nil {
-		filter["categories.$id"] = cat.ID
+		if descendants := q.CategoryDescendants(); len(descendants) > 0 {
+			ids := []primitive.ObjectID{cat.ID}
+			for _, d := range descendants {
+				ids = append(ids, d.ID)
+			}
+			filter["categories.$id"] = bson.M{"$in": ids}
+		} else {
+			filter["categories.$id"] = cat.ID
+		}
 	}
 	if tag := q.Tag(); tag != nil {
 		filter["tags.$id"] = tag.ID
@@ -154,6 +183,55 @@ func (repo MongoPostRepository) FindAll(ctx context.Context, q PostQuery) ([]Pos
 	return posts, err
 }
 
+// FindAllCategoryStats returns published post statistics of all categories from list of IDs in a single aggregation
+func (repo MongoPostRepository) FindAllCategoryStats(ctx context.Context, ids interface{}) (map[primitive.ObjectID]Stats, error) {
+	return repo.findAllStats(ctx, "categories", ids.([]primitive.ObjectID))
+}
+
+// FindAllTagStats returns published post statistics of all tags from list of IDs in a single aggregation
+func (repo MongoPostRepository) FindAllTagStats(ctx context.Context, ids interface{}) (map[primitive.ObjectID]Stats, error) {
+	return repo.findAllStats(ctx, "tags", ids.([]primitive.ObjectID))
+}
+
+// findAllStats groups published posts by the reference ID in the given field,
+// since an aggregation expression cannot refer to the "$id" field of DBRef directly,
+// the reference is converted to an array of key-value pairs before grouping
+func (repo MongoPostRepository) findAllStats(ctx context.Context, field string, ids []primitive.ObjectID) (map[primitive.ObjectID]Stats, error) {
+	pipeline := mgo.Pipeline{
+		{{"$match", bson.M{"status": StatusPublished, field + ".$id": bson.M{"$in": ids}}}},
+		{{"$unwind", "$" + field}},
+		{{"$project", bson.M{"publishedAt": 1, "ref": bson.M{"$objectToArray": "$" + field}}}},
+		{{"$unwind", "$ref"}},
+		{{"$match", bson.M{"ref.k": "$id", "ref.v": bson.M{"$in": ids}}}},
+		{{"$group", bson.M{
+			"_id":               "$ref.v",
+			"postCount":         bson.M{"$sum": 1},
+			"latestPublishedAt": bson.M{"$max": "$publishedAt"},
+		}}},
+	}
+
+	cur, err := repo.col.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var results []struct {
+		ID    primitive.ObjectID `bson:"_id"`
+		Stats `bson:",inline"`
+	}
+	if err = cur.Decode(&results); err != nil {
+		return nil, err
+	}
+
+	stats := make(map[primitive.ObjectID]Stats, len(results))
+	for _, r := range results {
+		stats[r.ID] = r.Stats
+	}
+
+	return stats, nil
+}
+
 // FindByID returns a single post from its ID
 func (repo MongoPostRepository) FindByID(ctx context.Context, id interface{}) (Post, error) {
 	r := repo.col.FindOne(ctx, bson.M{"_id": id.(primitive.ObjectID)})
@@ -241,6 +319,12 @@ type PostQueryBuilder struct {
 	postQuery PostQuery
 }
 
+// WithIDs allows to set list of IDs to the post query object
+func (qb *PostQueryBuilder) WithIDs(ids []primitive.ObjectID) *PostQueryBuilder {
+	qb.postQuery.ids = ids
+	return qb
+}
+
 // WithTitle allows to set title to the post query object
 func (qb *PostQueryBuilder) WithTitle(title string) *PostQueryBuilder {
 	qb.postQuery.title = &title
@@ -283,9 +367,11 @@ func (qb *PostQueryBuilder) WithAuthorID(authorID string) *PostQueryBuilder {
 	return qb
 }
 
-// WithCategory allows to set category to the post query object
-func (qb *PostQueryBuilder) WithCategory(category Category) *PostQueryBuilder {
+// WithCategory allows to set category to the post query object,
+// the optional descendants are for including posts which belong to the sub-categories as well
+func (qb *PostQueryBuilder) WithCategory(category Category, descendants ...Category) *PostQueryBuilder {
 	qb.postQuery.category = &category
+	qb.postQuery.categoryDescendants = descendants
 	return qb
 }
 
@@ -338,6 +424,7 @@ func (qb *PostQueryBuilder) Build() PostQuery {
 
 // PostQuery uses as medium for communicating between repository and data-access object (DAO)
 type PostQuery struct {
+	ids           []primitive.ObjectID
 	title         *string
 	slug          *string
 	status        *Status
@@ -352,10 +439,17 @@ type PostQuery struct {
 	featuredImage *storage.File
 	attachments   *[]storage.File
 
+	categoryDescendants []Category
+
 	offset int64
 	limit  int64
 }
 
+// IDs returns list of IDs value
+func (q PostQuery) IDs() []primitive.ObjectID {
+	return q.ids
+}
+
 // Title returns title value
 func (q PostQuery) Title() *string {
 	return q.title
@@ -396,6 +490,11 @@ func (q PostQuery) Category() *Category {
 	return q.category
 }
 
+// CategoryDescendants returns list of descendants of the category
+func (q PostQuery) CategoryDescendants() []Category {
+	return q.categoryDescendants
+}
+
 // Categories returns list of categories
 func (q PostQuery) Categories() *[]Category {
 	return q.categories
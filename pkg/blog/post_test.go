@@ -15,6 +15,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	mgo "go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -43,7 +44,7 @@ func TestPost_MarshalJSON(t *testing.T) {
 
 	// Then
 	assert.Nil(t, err)
-	assert.Equal(t, "{\"id\":\""+id.Hex()+"\",\"title\":\"Children of Dune\",\"slug\":\"children-of-dune-"+id.Hex()+"\",\"status\":\"DRAFT\",\"markdown\":\"Integer tincidunt ante vel ipsum. Praesent blandit lacinia erat. Vestibulum sed magna at nunc commodo placerat. Praesent blandit. Nam nulla. Integer pede justo, lacinia eget, tincidunt eget, tempus vel, pede. Morbi porttitor lorem id ligula. Suspendisse ornare consequat lectus. In est risus, auctor sed, tristique in, tempus sit amet, sem.\",\"html\":\"Nullam sit amet turpis elementum ligula vehicula consequat. Morbi a ipsum. Integer a nibh.\",\"publishedAt\":\"0001-01-01T00:00:00Z\",\"authorId\":\"github|c7834cb0-2b79-4d27-a817-520a6420c11b\",\"engagement\":{\"shareCount\":0},\"createdAt\":\""+createdAt.Format(time.RFC3339Nano)+"\",\"updatedAt\":\"0001-01-01T00:00:00Z\"}", string(result))
+	assert.Equal(t, "{\"id\":\""+id.Hex()+"\",\"title\":\"Children of Dune\",\"slug\":\"children-of-dune-"+id.Hex()+"\",\"status\":\"DRAFT\",\"markdown\":\"Integer tincidunt ante vel ipsum. Praesent blandit lacinia erat. Vestibulum sed magna at nunc commodo placerat. Praesent blandit. Nam nulla. Integer pede justo, lacinia eget, tincidunt eget, tempus vel, pede. Morbi porttitor lorem id ligula. Suspendisse ornare consequat lectus. In est risus, auctor sed, tristique in, tempus sit amet, sem.\",\"html\":\"Nullam sit amet turpis elementum ligula vehicula consequat. Morbi a ipsum. Integer a nibh.\",\"publishedAt\":\"0001-01-01T00:00:00Z\",\"authorId\":\"github|c7834cb0-2b79-4d27-a817-520a6420c11b\",\"engagement\":{\"shareCount\":0,\"commentCount\":0},\"createdAt\":\""+createdAt.Format(time.RFC3339Nano)+"\",\"updatedAt\":\"0001-01-01T00:00:00Z\"}", string(result))
 }
 
 func TestMongoPostRepository_Create(t *testing.T) {
@@ -113,6 +114,7 @@ func TestMongoPostRepository_FindAll(t *testing.T) {
 	published := StatusPublished
 	draft := StatusDraft
 	catID := primitive.NewObjectID()
+	subCatID := primitive.NewObjectID()
 	tagID := primitive.NewObjectID()
 
 	tests := map[string]struct {
@@ -170,6 +172,17 @@ func TestMongoPostRepository_FindAll(t *testing.T) {
 				SetSkip(0).
 				SetLimit(5),
 		},
+		"With list of IDs": {
+			q:      NewPostQueryBuilder().WithIDs([]primitive.ObjectID{catID, tagID}).Build(),
+			filter: bson.M{"_id": bson.M{"$in": []primitive.ObjectID{catID, tagID}}},
+			options: options.Find().
+				SetSort(bson.D{
+					{"status", 1},
+					{"createdAt", -1},
+				}).
+				SetSkip(0).
+				SetLimit(5),
+		},
 		"With specific category": {
 			q:      NewPostQueryBuilder().WithCategory(Category{ID: catID}).Build(),
 			filter: bson.M{"categories.$id": catID},
@@ -181,6 +194,17 @@ func TestMongoPostRepository_FindAll(t *testing.T) {
 				SetSkip(0).
 				SetLimit(5),
 		},
+		"With specific category and its descendants": {
+			q:      NewPostQueryBuilder().WithCategory(Category{ID: catID}, Category{ID: subCatID}).Build(),
+			filter: bson.M{"categories.$id": bson.M{"$in": []primitive.ObjectID{catID, subCatID}}},
+			options: options.Find().
+				SetSort(bson.D{
+					{"status", 1},
+					{"createdAt", -1},
+				}).
+				SetSkip(0).
+				SetLimit(5),
+		},
 		"With specific tag": {
 			q:      NewPostQueryBuilder().WithTag(Tag{ID: tagID}).Build(),
 			filter: bson.M{"tags.$id": tagID},
@@ -232,6 +256,57 @@ func TestMongoPostRepository_FindAll(t *testing.T) {
 	// Then
 }
 
+func TestMongoPostRepository_FindAllCategoryStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		col = mock_mongo.NewMockCollection(ctrl)
+		cur = mock_mongo.NewMockCursor(ctrl)
+	)
+
+	ctx := context.Background()
+	repo := MongoPostRepository{col: col}
+	catID := primitive.NewObjectID()
+	publishedAt := time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC)
+
+	t.Run("With successful aggregating category statistics", func(t *testing.T) {
+		// Given
+		col.EXPECT().Aggregate(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, pipeline interface{}) (mongo.Cursor, error) {
+			assert.Equal(t, bson.D{{"$match", bson.M{"status": StatusPublished, "categories.$id": bson.M{"$in": []primitive.ObjectID{catID}}}}},
+				pipeline.(mgo.Pipeline)[0])
+			return cur, nil
+		})
+		cur.EXPECT().Decode(gomock.Any()).DoAndReturn(func(val interface{}) error {
+			data, _ := bson.Marshal(bson.M{"_id": catID, "postCount": 2, "latestPublishedAt": publishedAt})
+			v := reflect.ValueOf(val).Elem()
+			elem := reflect.New(v.Type().Elem())
+			_ = bson.Unmarshal(data, elem.Interface())
+			v.Set(reflect.Append(v, elem.Elem()))
+			return nil
+		})
+		cur.EXPECT().Close(ctx).Return(nil)
+
+		// When
+		stats, err := repo.FindAllCategoryStats(ctx, []primitive.ObjectID{catID})
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, map[primitive.ObjectID]Stats{catID: {PostCount: 2, LatestPublishedAt: publishedAt}}, stats)
+	})
+
+	t.Run("When unable to aggregate tag statistics", func(t *testing.T) {
+		// Given
+		col.EXPECT().Aggregate(ctx, gomock.Any()).Return(nil, errors.New("test unable to aggregate tag statistics"))
+
+		// When
+		_, err := repo.FindAllTagStats(ctx, []primitive.ObjectID{catID})
+
+		// Then
+		assert.EqualError(t, err, "test unable to aggregate tag statistics")
+	})
+}
+
 func TestMongoPostRepository_FindByID(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -387,3 +462,14 @@ func TestMongoPostRepository_Save(t *testing.T) {
 		})
 	}
 }
+
+func TestPost_Permalink(t *testing.T) {
+	// Given
+	p := Post{Slug: "test-post", PublishedAt: time.Date(2020, 3, 29, 18, 0, 0, 0, time.UTC)}
+
+	// When
+	result := p.Permalink()
+
+	// Then
+	assert.Equal(t, "/2020/3/30/test-post", result)
+}
@@ -0,0 +1,12 @@
+package blog
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"strings"
+)
+
+// GetIDFromSlug returns an ID at the end of the slug string, e.g. "children-of-dune-5e2a8f0d3f8a2b0001c3d4e5"
+func GetIDFromSlug(slug string) (primitive.ObjectID, error) {
+	sl := strings.Split(slug, "-")
+	return primitive.ObjectIDFromHex(sl[len(sl)-1])
+}
@@ -0,0 +1,32 @@
+package blog
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+)
+
+func TestGetIDFromSlug(t *testing.T) {
+	id := primitive.NewObjectID()
+
+	t.Run("With valid slug", func(t *testing.T) {
+		// Given
+
+		// When
+		result, err := GetIDFromSlug("children-of-dune-" + id.Hex())
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, id, result)
+	})
+
+	t.Run("With invalid slug", func(t *testing.T) {
+		// Given
+
+		// When
+		_, err := GetIDFromSlug("children-of-dune")
+
+		// Then
+		assert.NotNil(t, err)
+	})
+}
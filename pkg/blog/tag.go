@@ -5,7 +5,9 @@ package blog
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	slugify "github.com/nomkhonwaan/myblog/pkg/slug"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -35,6 +37,7 @@ func (tag Tag) MarshalJSON() ([]byte, error) {
 
 // A TagRepository interface
 type TagRepository interface {
+	Create(ctx context.Context, name string) (Tag, error)
 	FindAll(ctx context.Context) ([]Tag, error)
 	FindAllByIDs(ctx context.Context, ids interface{}) ([]Tag, error)
 	FindByID(ctx context.Context, id interface{}) (Tag, error)
@@ -48,6 +51,24 @@ func NewTagRepository(db mongo.Database) MongoTagRepository {
 // MongoTagRepository implements TagRepository interface
 type MongoTagRepository struct{ col mongo.Collection }
 
+// Create inserts a new tag with the given name and generates its slug from the name and ID
+func (repo MongoTagRepository) Create(ctx context.Context, name string) (Tag, error) {
+	id := primitive.NewObjectID()
+	tag := Tag{
+		ID:   id,
+		Name: name,
+		Slug: fmt.Sprintf("%s-%s", slugify.Make(name), id.Hex()),
+	}
+
+	doc, _ := bson.Marshal(tag)
+	_, err := repo.col.InsertOne(ctx, doc)
+	if err != nil {
+		return Tag{}, err
+	}
+
+	return tag, nil
+}
+
 // FindAll returns list of tags
 func (repo MongoTagRepository) FindAll(ctx context.Context) ([]Tag, error) {
 	opts := options.Find().SetSort(bson.D{{"name", 1}})
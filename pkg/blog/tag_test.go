@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	mgo "go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"testing"
 )
@@ -30,6 +31,46 @@ func TestTag_MarshalJSON(t *testing.T) {
 	assert.Equal(t, "{\"id\":\""+id.Hex()+"\",\"name\":\"Golang\",\"slug\":\"golang-"+id.Hex()+"\"}", string(result))
 }
 
+func TestMongoTagRepository_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		col = mock_mongo.NewMockCollection(ctrl)
+	)
+
+	repo := MongoTagRepository{col: col}
+
+	t.Run("With successful creating a new tag", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+
+		col.EXPECT().InsertOne(ctx, gomock.Any()).Return(&mgo.InsertOneResult{}, nil)
+
+		// When
+		result, err := repo.Create(ctx, "Web Development")
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, "Web Development", result.Name)
+		assert.Equal(t, "web-development-"+result.ID.Hex(), result.Slug)
+	})
+
+	t.Run("When unable to create a new tag", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+
+		col.EXPECT().InsertOne(ctx, gomock.Any()).Return(nil, errors.New("test unable to create a new tag"))
+
+		// When
+		result, err := repo.Create(ctx, "Web Development")
+
+		// Then
+		assert.EqualError(t, err, "test unable to create a new tag")
+		assert.Equal(t, Tag{}, result)
+	})
+}
+
 func TestMongoTagRepository_FindAll(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1,7 +1,11 @@
 // Code generated by bindata. DO NOT EDIT.
 // sources:
+// data/fonts/NotoSansThai-Regular.ttf
+// data/fonts/OFL.txt
 // data/graphql-playground.html
 // data/opengraph-template.html
+// data/prerender-template.html
+// data/static-site-template.html
 
 package data
 
@@ -52,6 +56,510 @@ func (fi gzipBindataFileInfo) Sys() interface{} {
 	return nil
 }
 
+var _gzipBindataDataFontsNotosansthairegularttf = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x7c\x09\x78\x9b\x47\x99\xf0\xcc\xf7\xe9\xb6\x75\xdf\xb6\x6c\xcb\x92\x7c" +
+		"\xdb\xba\x0f\xdf\x87\x6c\xcb\x67\xec\xc4\x76\x62\x2b\x71\x9c\x28\xb6\x13\x3b\xb1\x2d\x37\x71\xae\xa6\x27\xf4\x6e\xa1" +
+		"\xe5\x68\xe9\x42\xcb\xd2\xc2\xb6\xdd\x65\xa1\x94\x16\xd8\x65\xa1\x47\x5a\xba\x94\xa3\x3f\xb4\x5d\xe8\xdf\x83\x63\x0b" +
+		"\x6c\x69\xcb\xd1\xd2\x0b\x58\x4b\xff\x3b\xf3\x8d\x64\xc9\x71\x5a\xf8\x9f\x5d\xfc\x65\xae\x77\x66\xde\x7b\xde\x99\xf9" +
+		"\x24\x05\x61\x84\x90\x12\x5d\x86\x78\xb4\xbd\xaf\xa7\x37\x26\xfa\xb3\xe8\x6e\x80\xbc\x00\x69\xa5\x6f\xfb\xe8\xf8\x7f" +
+		"\xef\x7e\x57\x84\x10\x1e\x46\x48\xda\xd9\x37\xbe\x33\xea\xfa\x42\xd3\x36\x84\x64\x37\x20\x24\x0a\x8e\x8e\x7b\xfc\xd7" +
+		"\x3e\x2c\x9a\x45\xa8\xf0\x61\x18\xbf\x7f\x76\x39\xb1\xfa\x40\x4f\xd3\xbd\x80\xd0\x0e\x73\x62\x87\x96\x4e\x1f\x7c\x24" +
+		"\x76\xf2\x3e\x84\x54\x0b\x08\x79\xbf\xb8\x30\x9f\x98\x93\x77\xfd\xdb\xef\x10\xea\xb0\xc0\xf8\xf0\x02\x00\x44\x4f\xe3" +
+		"\xff\x84\xf6\x76\x68\xbb\x16\x96\xd7\x4e\xdd\x55\xf9\xf2\xbf\x40\x7b\x0d\xe6\x3f\xb7\x94\x9c\x4d\xfc\xf1\xa1\xdf\xd6" +
+		"\x20\xd4\x05\xfd\xb8\x68\x39\x71\x6a\x15\xdd\x49\x18\x8e\x2e\x41\x66\x5f\x49\x2c\xcf\xdf\xb2\xed\x21\x1b\xb4\x81\x1f" +
+		"\xc9\xd4\x6a\xf2\xd8\xda\xdb\x37\xef\x7b\x06\xa1\xfe\x5f\x00\x4f\x2f\x20\x8c\x38\xa4\x42\xc2\x5f\x2b\xd4\xa5\x48\x04" +
+		"\x6d\x8c\xb4\xa8\x19\xf2\x1e\x78\x30\x9a\x46\x7b\x41\xf6\xfd\xe8\x0c\xe4\x97\xa1\x2b\x21\xbf\x0a\x5d\x0d\x70\x32\x17" +
+		"\x90\x40\xb2\x41\x8d\x83\x51\x33\x50\x27\x23\x48\x8b\x87\x79\xd3\x44\x66\x98\x87\xe9\x8c\x12\x68\x11\xd6\x14\xa8\x1a" +
+		"\x8d\x21\xae\x27\x36\x3c\x81\x54\x6b\x0b\x89\x45\xa0\x28\x22\x0c\xa4\xd3\x30\x0b\x51\xbc\xfc\x91\xf9\xa3\x2b\xc8\xb0" +
+		"\x9c\x38\x7a\x04\x15\x2d\x1f\x59\x3e\x82\x1c\x6c\x36\x82\x5e\x81\x32\xa1\x21\x42\x62\x54\x88\x17\x78\x4e\xf4\x61\x71" +
+		"\x18\x20\x32\x48\x0a\x74\x11\x8c\x28\xa5\x38\x15\x28\x9c\x7d\x86\xe1\x19\x83\x67\x0a\x7a\x15\xa8\x03\x75\xa2\x2e\x14" +
+		"\x45\xdd\xa8\x17\xc5\xd0\x00\x1a\x44\x23\x80\xcb\x95\x7e\x0d\x55\xa4\xdf\x46\x55\x90\xaa\xd3\xcf\x22\x5f\xfa\x3f\x61" +
+		"\xf4\x10\x40\x31\xda\x06\x30\x09\xea\x85\x3c\x06\x69\x00\xd2\x20\xa4\x21\xc0\x45\x7a\x38\xd0\x15\xa1\x38\x01\x5a\xe0" +
+		"\xc0\x5f\x50\xfa\xf5\xf4\x9b\xe9\xb7\xd2\x6f\xa7\x7f\x03\xe9\x25\x68\xbf\x4d\x9f\x3f\xa1\x4d\x7f\x80\x3b\xf3\x47\xf4" +
+		"\xd6\x01\x1c\x21\xca\x8f\x18\x68\x0e\x50\x69\x89\xbc\x46\x68\x13\x88\x14\x64\xc4\x48\x0d\x0f\xb1\x92\x0e\x72\x3d\x32" +
+		"\x41\xbf\x19\x59\x81\x7e\x11\x2a\x06\x1e\x4b\xe0\x91\x83\x06\x4a\xc1\x3a\x76\x54\x0e\x63\x1c\xf0\x48\x90\x13\x1e\x39" +
+		"\xaa\x84\x47\x8a\x6a\x50\x2d\xe4\x75\xf0\xf0\xa8\x1e\x35\xa0\x02\xe4\x46\x5e\xa8\x07\x51\x08\xe8\x44\xe0\x11\xa3\x26" +
+		"\xf0\x02\x05\xd5\x8f\x8c\xca\x86\x09\x6d\x3e\x08\x58\x31\x3f\x02\x18\x05\x0b\x10\xff\x21\xfe\x52\x40\xfd\x25\x04\x9a" +
+		"\x5e\x41\x6b\xe8\x42\xf0\x84\xab\xd0\x0d\xe8\xa3\xd9\x74\x2b\xfa\x34\xfa\x27\xf4\x39\x48\x5f\x42\x5f\x45\xdf\xc0\x36" +
+		"\x74\x16\xc7\xd0\xb7\xd1\x93\xe8\x19\xf4\x1c\xfa\x19\xfa\x15\x7a\x0d\xbd\x81\xde\x45\x29\x6c\xc2\x22\xac\xc0\x1a\x28" +
+		"\x6d\xd8\x01\x4f\x35\x3c\x6e\x1c\xc4\xcd\xf0\x74\xe2\x18\x1e\xc6\x63\x78\x0a\xcf\xe0\x39\x7c\x18\x61\x4e\x46\x38\xe3" +
+		"\xe4\x34\x57\x08\xf5\x74\x37\xcd\x67\x08\x84\xd6\x15\xa4\xce\xa3\xf4\x3e\xc8\xc9\xca\xc2\xf8\x61\x9a\x7f\x93\xe6\x8f" +
+		"\xd1\x59\x46\x9a\x17\xd3\xdc\x4c\x73\x0d\xcd\x9d\x34\x2f\xa4\x23\xd3\x24\xe7\x5d\x34\x0f\xd2\xbc\x83\xc2\xdf\xa0\xf9" +
+		"\x83\x34\xbf\x91\xe6\x8b\x34\xbf\x9e\xe6\xdf\x27\x3c\xe0\xcf\x53\x3c\x05\x14\xf2\x0a\xcd\xdf\xa6\xf9\x9f\x68\xfe\x2a" +
+		"\xed\xf5\xd2\xbc\x8a\xe6\x75\x34\x97\xd0\xbc\x88\xe6\x5a\x3a\xf2\x6b\x34\x7f\x9c\x42\xc8\xaa\xc8\x5a\x86\x8b\x12\xcb" +
+		"\x70\x0b\xb0\xbe\x38\x80\x66\x2c\x13\x06\xcb\x34\x82\x7d\x7c\xd4\x3e\xc4\xbe\x4b\xe8\x0a\x74\x09\x3a\x8a\xed\x50\x9e" +
+		"\x82\x44\x5a\x57\xa0\xef\xa1\xeb\xd0\x4d\x78\x3b\xba\x05\xdd\x86\x55\xe8\x4e\x78\xee\x41\x5f\x44\xcf\xa2\x07\xd0\xd7" +
+		"\xd1\xc3\xe8\x71\xe8\x7f\x0a\x5a\x3f\x41\xbf\x40\xaf\xa0\xdf\xa3\xb7\xd1\x7f\x63\x0e\xdb\xf1\x20\x96\x61\x15\x36\xe0" +
+		"\x22\xa8\x57\xe2\x7a\xec\xc7\x8d\xb8\x1d\xf7\x00\x7c\x3b\xde\x05\x7c\xae\x73\x16\xc8\xdf\x25\x39\x87\xb9\x12\xa2\x43" +
+		"\x0a\x49\x91\x9c\xaf\xa5\xf5\x1f\xd2\xfc\x45\xce\x04\xf9\x6f\xe8\x48\x0d\xcd\xd5\x34\x2f\xa3\xb9\x85\xe6\x85\x34\x37" +
+		"\xd2\xb9\x8d\x34\x0f\xd2\x7c\x8c\xc2\xc5\x14\xcf\xb3\x34\x7f\x98\xe6\x8f\xd1\xfc\x76\x9a\x7f\x8d\xe6\x4f\xd1\xfc\xa7" +
+		"\x34\x7f\x85\xce\x52\xd0\xfa\x85\xb4\x6e\x65\x7c\x92\xdc\x4b\xe1\x1f\x67\xbc\x11\xc8\x1c\xcd\xe5\x34\xaf\xa6\xf0\xe7" +
+		"\x69\xfe\x0c\x85\x38\x20\x97\x20\x0d\x8d\x2e\xc4\x22\x85\x10\x17\x31\xac\xb5\xa5\x2d\xa2\x23\xa6\x35\xd8\x26\x20\x11" +
+		"\x1b\x56\x43\x6a\x86\xe4\x66\x65\x90\x95\x9d\x08\xa7\x5f\x24\xd8\xd2\xdf\xa6\xf9\xbd\xc4\x8f\x21\xdf\x0b\xf9\xdd\x14" +
+		"\xf2\x25\xa8\x4b\xc0\xd2\x06\x58\xfb\x76\xa0\x56\x4f\x7a\xd2\x31\xd2\x93\xfa\x47\x52\x4f\xbd\x4e\x66\xa4\xe2\xb4\xfe" +
+		"\x0e\x85\xc7\xb3\x7c\x62\xca\xa7\x03\x72\x3f\xac\xc1\xad\xa3\x36\xe1\x97\xb4\x11\x6d\xdb\x50\x19\xd4\x2e\x81\x74\x0b" +
+		"\xa4\x9b\x84\x12\x8f\x41\x79\x67\x4e\xfd\x8a\xf3\xc0\x33\xe5\x75\x6c\xfe\x6d\xac\xcc\xad\x6f\x2e\x6f\xca\x69\xdf\x09" +
+		"\xfc\x7f\x99\x68\x3b\x6d\xe3\xac\x08\xa7\x5e\xa2\xf5\x52\x9a\xdf\x4d\xf3\x12\xd6\x6b\x81\xe8\xd7\x0e\xfe\x3e\x08\xb1" +
+		"\x68\x3b\xda\x05\xf9\x34\x3a\x00\xf9\xc2\xa6\xe8\x44\xa2\x92\x10\x93\xce\x0a\x71\x09\x4a\x12\x93\x70\xfa\x5b\xdc\x77" +
+		"\x81\xc2\x6b\xdc\x0b\x04\x37\xbf\x0d\x72\xc4\x0f\x43\xde\x49\xea\xa9\x57\x28\xa4\x91\xd6\xff\x99\xd6\xf7\xd3\xfc\x6e" +
+		"\x9e\xe8\xfb\xe3\x3c\x4f\xeb\x6f\x40\xef\x0f\xb8\xef\x43\xbd\x95\x8e\x7c\x94\x6f\x84\xfa\x27\xb8\x14\xe5\xf7\x09\xca" +
+		"\xef\x13\x74\xa4\x88\xd4\x69\x7e\x90\xe4\xa9\x9f\xd3\xfa\x75\xb4\xfe\x03\x42\x37\xf5\x1c\xa9\xb3\x3d\xed\x40\xde\xfe" +
+		"\x69\xcb\xee\x9f\xc2\xbe\x78\xce\x6e\x9a\x48\x2c\xad\x21\xc3\xec\xec\xf2\x2a\xb2\x2d\x2d\x1e\x4a\x80\x9f\x20\x3a\x96" +
+		"\xe0\xe3\x59\x1c\x27\x2d\x39\x60\x37\x81\x6e\xbe\x8a\x9e\xc2\x36\xee\x01\xde\xcd\xaf\xf2\x97\xf0\x9f\xa3\xe3\x30\xdd" +
+		"\x5d\xfd\xd0\x2f\x83\x51\x85\xe0\x73\x3a\xd8\x43\x1a\xc1\xaf\x13\xe8\x02\xd0\xe9\x09\x88\x25\x17\x82\x87\x5c\x83\xae" +
+		"\x05\xfb\x5e\x8f\x2e\x47\x1f\x04\x1f\xc7\x30\x5e\x0a\x78\x0b\x60\x4f\xd4\xc2\xae\x13\x81\x95\xb0\x1f\xad\xa2\x63\xe8" +
+		"\x38\x3a\x89\x4e\x83\x5f\x5d\x84\x2e\x06\x7f\xba\x14\xa8\x7e\x00\xfc\x86\xcf\xee\x33\x2a\xd8\x81\x34\x30\xdb\x06\xfe" +
+		"\x49\x22\xda\x2c\x9a\x83\xfc\x20\x3a\x04\xf9\x22\x3a\x0c\xf9\x12\xd8\x90\x03\x7b\x26\x99\x2c\x32\x96\x54\xd4\xaf\xc9" +
+		"\x79\xe6\x0c\x6a\xd9\x88\x8f\xa0\x37\x12\x13\x2d\x80\x0f\x56\x09\xd4\x2f\xa2\x23\x48\xed\xe2\x6c\xed\x92\x6c\xed\xd2" +
+		"\x6c\xed\x00\x9a\x07\xbf\x39\x82\x24\x54\xfb\x45\x80\x79\x37\xf8\xcd\x93\xb0\x67\xfd\x37\xec\x50\x3d\xf8\x04\xe3\x1a" +
+		"\x76\x63\x6c\xa2\xba\x22\x3b\xb5\x24\x4f\x6e\x5e\xe8\x47\x6f\x32\xad\x8b\x29\xe6\x8c\x26\x59\x2f\x67\xcf\xe9\x35\x80" +
+		"\x9e\x12\x59\xda\xcb\xa0\xb3\x0b\xd0\x51\xd0\xdb\x1a\x68\xee\x04\xe8\xee\x14\x68\xef\x42\xe0\xf0\xea\x0d\xea\x20\x5b" +
+		"\x66\x36\x06\x4d\x63\xa0\xbd\x3f\x0f\xc3\xd1\x1c\xbd\x13\x9a\x4b\xc0\xf1\x61\x46\x53\x42\x21\xbb\x29\xa6\x0c\x17\x24" +
+		"\x52\x5d\x04\x9a\x40\x19\x0e\xf1\x25\xac\x4f\x0a\xb9\x18\x2c\x72\x08\x2c\x91\x04\x3b\x10\x2f\x32\xc0\x9a\xc3\x78\x86" +
+		"\x8d\x20\x1a\xe7\x99\x55\x49\xcd\x83\x02\x10\xd7\x32\x7a\x70\xe4\x48\x2a\xc9\xf3\x20\xd2\xce\xf5\x90\xcc\x0c\x5b\xce" +
+		"\x0c\x9e\x9e\x6b\x1c\x54\xce\xbd\x39\xde\xd9\x0c\xd6\x39\xd7\x3b\x67\xc1\x6b\x16\x41\xd6\x95\x1c\x2a\x9b\xbd\xb4\x68" +
+		"\x0b\x2f\xcd\xd5\x5c\x86\x9b\x7c\x6f\xdd\xa0\x4c\xce\x5a\x09\xa6\x8f\xab\x40\x23\xb9\x2b\x82\xc4\xd8\xad\xb1\x9d\xc9" +
+		"\x62\x20\x27\x56\x9c\x83\x4f\x93\xd5\x2e\xc1\x46\x74\xbd\x31\x5f\x94\x33\x87\x9c\x77\x0d\xd4\x97\xd4\x50\x6f\xa3\x35" +
+		"\x0d\xab\x71\x54\x26\x11\xd7\x8a\x6f\x82\xf9\x88\xbb\x8e\x9b\x82\x99\x43\x42\x09\x76\xf2\xe3\x28\x40\x0b\xc4\x9c\x48" +
+		"\x2c\xe2\x38\xd1\xe5\x08\x34\x85\xec\xd9\xe3\x68\xdf\xe8\x68\x1f\xee\x44\xa8\xe2\x7b\xa2\x8a\x54\x3b\x1c\x34\x2a\xf0" +
+		"\xd9\xec\x51\xd5\xc2\x7d\x87\xdc\x30\x58\xc4\x41\xd4\x07\x0d\xd4\x56\xa4\x14\x61\x3f\xdd\xd9\xec\xc0\x0d\x47\xef\x4f" +
+		"\x76\x38\x63\x7e\x86\xfb\x06\xf7\x63\x9e\xe7\x7d\xaa\x66\xd5\xa8\x2a\xae\xda\x63\x57\xda\x35\x15\xdf\x4b\xa7\x29\xca" +
+		"\xcd\x63\xb0\xaa\x43\xb5\x5d\xb5\xdb\x5e\xc0\xc6\x60\x38\x4d\xbf\x84\x1c\xe9\xaf\xae\x7f\x76\xfd\x8a\xf5\xf8\x7a\x3d" +
+		"\x99\xf4\xfa\x5b\x08\xfd\x6c\xf8\x67\x9d\xcf\x9d\x62\xb7\x83\xfc\x3f\x0d\x58\x09\xa1\xcf\x64\xdb\x22\x38\x2d\x1b\xe0" +
+		"\xac\x6c\x81\x93\x70\x35\x44\xc1\x3a\x38\x57\x9b\x80\xae\x96\x5a\xbe\x06\x76\x3b\x07\xf8\x15\x39\x45\x8b\xc1\xdf\x5c" +
+		"\xa0\x5f\x37\xf8\xad\x1f\x3c\x37\x04\xbe\x2b\x81\xbd\xcf\x87\x2a\x40\xab\xf5\xa0\xd7\x2a\x38\x71\x7b\x81\xe3\x30\x78" +
+		"\x0b\x39\x29\xef\x84\xfd\x66\x3f\xc4\x9b\xd6\xac\x75\x89\x87\x5c\x06\x2b\x8a\xdc\x35\xba\xe1\xae\xd1\x05\x56\x99\x00" +
+		"\xeb\x6f\x58\xf2\x0c\xac\xc8\x31\xb4\x03\x8d\x53\x2d\xc2\xca\xc1\x7f\x04\xbd\xf2\x40\x01\x05\xb4\xe5\xda\x8a\x72\x6d" +
+		"\xf9\x0c\xfe\x54\xea\x56\x1c\x4a\x7d\x97\xfb\xce\x7a\x38\xc0\xcd\x81\x9c\xc4\x6a\x52\xce\x0d\xdc\xa1\x40\x28\x18\x8e" +
+		"\x44\xaa\x20\x0f\x07\xfc\x26\x93\x51\xeb\xd4\x06\x2b\x9d\x0e\x89\xd1\x2c\x85\xcc\x38\xdc\x58\x87\x57\xc2\x73\x7d\x6d" +
+		"\x0b\x1d\xf1\xb1\xe6\xf6\xbe\x25\x59\x45\xe4\xc1\x8e\xa6\x02\xbe\xb6\xbc\x66\xa0\xa1\x6a\xbc\x3b\x75\x23\x5e\xe8\x6e" +
+		"\x0a\x54\x85\xbd\xbe\xd0\x83\xc4\xab\xa2\xe9\xb7\xe1\x84\xe3\x06\x5d\x20\xb3\xa3\xb2\x32\x74\x7e\xfc\x12\xc0\x6f\x08" +
+		"\xf8\x23\x66\x89\xe4\xac\x2d\x50\x16\xaa\xc3\xc7\xc2\xf3\x7d\xed\x84\x52\x4b\x7b\xff\x51\x59\x45\xa8\xd6\xa8\x51\xea" +
+		"\x5d\x0a\x9d\xbb\x26\xd1\xde\xb8\x15\x4d\xec\x0c\x1d\xa8\x95\x74\xca\x25\x84\xb2\x1e\x28\x2b\xb2\x94\xab\x22\x26\x53" +
+		"\xc0\x1f\x7a\x6f\xf1\x0c\x92\x29\x9d\x43\xab\x90\xea\x64\xa5\x6e\x20\x1f\x3a\xd8\xd7\xb6\xd8\x11\x1f\x6f\x26\xe4\x2b" +
+		"\x1b\xc2\x0a\xa9\xac\x9d\x13\x55\xef\x6d\x0f\x6f\x45\xdc\x1b\x4a\xb4\xd7\x52\x89\x23\xff\xdb\x12\xaf\x3f\xce\xf9\xb6" +
+		"\x90\x38\x9a\x1e\xfb\x5f\xa7\xfc\x73\xce\xb4\xa5\xae\x23\x7f\x03\x5d\x6f\x88\x9d\xd1\xb5\x1e\x24\xfe\x1b\xd0\xcd\x0a" +
+		"\xbd\x41\x57\x90\xb7\x11\xe8\x02\x21\xa0\x63\xde\x44\xc4\xe9\x38\x1f\x37\x6e\x8e\x8c\x33\x1e\xda\xb1\x2d\x1c\x2f\xc9" +
+		"\xd2\xad\xaa\xb0\x6f\xe2\x29\xa6\x69\xec\x71\xd4\x57\x3f\xa1\x6d\xee\xb5\x9b\xf0\x8f\xb3\x0c\xb4\xd5\xaa\x3a\x38\x51" +
+		"\xd5\x26\xcd\x68\xcb\x0d\x15\x66\x85\x60\x8b\xed\x7f\x2b\xde\xbe\x04\xbc\x95\x9b\xf0\x43\xef\xcd\xdb\x4f\x75\x76\x63" +
+		"\x85\x45\x91\xeb\x27\xad\x1b\xbc\x45\xfe\x72\xee\xaa\xa4\xce\x4a\x3a\xd4\x38\x1c\xf3\xb8\xfd\xca\xce\xee\xf7\x60\x71" +
+		"\xfb\x60\x9b\x59\xe9\x2c\x2e\x36\x3d\x61\x0d\x36\x3b\xac\x61\x3f\xfe\xf9\xfb\xa8\xb0\xb1\xb1\xb8\xb2\x42\x2f\xcf\xd5" +
+		"\xe1\xdf\x92\xcf\x2f\x59\x43\x8d\xc0\xa7\x0f\x7f\xfb\x7d\xd4\xd9\xd4\x54\x5c\xe9\xd2\x2b\x84\x15\x1f\xe1\xf7\x0a\xb6" +
+		"\x16\xe7\xb3\x27\x2c\x6e\xe7\x39\x61\xc0\x1c\xa0\xc5\xc6\xaa\xd0\xe2\x2f\x65\xd6\x7c\x8d\x89\xac\x79\x4d\x26\x20\x1c" +
+		"\xec\x1f\x98\x36\xf3\xfa\x7d\xdd\x33\xf3\xf1\xfe\x60\x70\xe8\x09\xae\x7a\xa0\x95\x70\xe6\x0e\x4d\xd1\x95\x9f\x17\x1c" +
+		"\xbc\xf5\xf5\xde\x03\x7b\x60\x89\x7a\x63\x91\xe6\xbe\xf5\x67\x04\x6b\xf3\x33\xc0\x5d\x93\xc0\xdd\x26\xed\x6d\xb9\x5c" +
+		"\xb7\x60\xef\xbe\xca\xce\xaa\x8c\xf6\x72\x57\x6e\xf8\x50\x5f\xff\xb4\x85\x37\xcc\x10\xfe\x06\x82\xc1\x41\xe0\xcf\xd7" +
+		"\x5c\x94\x5d\xa6\x9b\x17\xf2\x39\x0c\x0a\x11\x93\x0f\x01\x87\x03\xc0\x21\x30\x14\x22\x0c\x85\xc3\xa1\x90\x33\xab\x3a" +
+		"\xca\xb5\xf4\x7d\x94\x6a\x82\x3e\x32\x33\xa4\x0d\x68\x0d\x12\x6e\x57\xcd\x78\x45\xd9\x54\x75\x75\xbb\x4b\x64\x18\x69" +
+		"\xf4\x0e\xd7\xbb\x5a\xca\x43\xbd\xf8\x5c\x15\x87\x12\x5d\x91\xd9\xf6\x48\x43\xdb\x60\xfc\xd8\x17\xb5\xda\x5d\x46\xa3" +
+		"\xa5\xa3\xa9\xc8\x5e\xe3\xda\xde\x75\xbf\x37\x64\x72\xd7\x6f\xa5\xeb\xfa\xfe\x3a\xd7\x78\xef\x57\x5a\xc2\x53\x83\x38" +
+		"\x9c\x52\xad\xae\x0a\xbb\x2c\x5f\xcb\xfc\xc0\xf1\xff\xcb\xf6\xbe\xc5\x03\xae\xa6\xf2\x50\x37\xde\x88\xff\x19\x46\x83" +
+		"\xfb\xbb\x22\x89\xb6\xd6\x48\xb8\x35\x7e\x40\x71\xe0\xe0\xe7\xdf\x93\xb9\xb1\xd8\x57\xba\x9b\x9b\xbb\xf1\xa1\xd4\x27" +
+		"\x0f\x1e\x20\x5a\x0e\xa7\xdf\xc6\x4f\x71\xb5\x24\x4a\x0b\xdc\x85\x23\xa0\x64\x6a\x5f\x69\x95\x60\x6f\x83\x44\xd8\x93" +
+		"\xa8\x47\x50\x76\xb0\x6e\xe6\x90\x4d\xa3\xb5\x1a\x35\x85\xc5\x4a\x83\x2e\x64\x88\x78\xac\x5a\xad\xa9\xd4\x6f\x3b\x04" +
+		"\x3c\xec\xd9\xd3\xe6\x6b\x31\x9a\x9c\x16\xa3\x4e\x26\xe9\x2c\x54\x34\xd5\x57\x78\xcd\x56\x77\x45\x8b\xdb\xaf\xdf\x8f" +
+		"\xaf\x4c\x9d\xd8\x36\x49\x28\xd7\x03\xe5\x67\x38\x17\x9c\xb2\x32\x94\x09\xe9\x48\x15\xd5\x46\x28\x14\x00\xd5\x80\x3e" +
+		"\x02\x40\x5e\x22\x55\x71\x8c\x3e\xd3\x07\xd6\xcc\xcc\x19\xec\x5a\x8d\x19\xf3\x05\x25\x3a\x97\x7b\xc4\xae\xb0\x2a\xf4" +
+		"\x3e\x91\xce\xa8\xb1\x6a\x4a\xbd\xc5\xc1\xc6\xa9\x79\xc5\xf4\x9e\x8e\xaa\x70\x8d\x5e\x5b\xa2\xe6\x64\x05\xbe\x06\x63" +
+		"\x93\x4c\x56\xd3\x50\x52\x5f\x53\x6c\x28\xab\x75\x36\x7a\xfc\x3a\x8f\x6f\x83\x1b\xa2\x87\x17\x41\x0f\x2d\x7f\xa1\x1e" +
+		"\x48\x97\x33\x44\x3d\x32\x00\x1d\xc6\x52\xc2\xa1\x04\xeb\xf7\x6d\xad\x98\x92\x83\x8e\x4a\x91\xb8\xc6\x21\x8d\x17\xb9" +
+		"\x8b\x8b\x64\xfb\xdf\x5b\x49\x37\x54\x54\x35\x55\x86\x2d\xa1\x21\x8d\x48\x66\xaf\xd1\x7f\x14\x58\x14\xf4\xf5\x12\xe8" +
+		"\xab\x33\x97\xc3\xf7\x55\x57\x28\x98\xc7\x26\xf5\x2b\x09\x36\xcc\xcc\x55\x5b\xd5\x96\x73\xb5\x67\x56\x51\xed\x0d\x88" +
+		"\xc4\xd5\x4e\x69\xdc\x56\x5f\x61\x3d\x48\xf4\xd8\x1c\x2d\xd9\x42\x8d\xa5\x95\x65\x5d\x5e\x1f\xa8\xf1\x86\x61\xca\xee" +
+		"\x80\x46\x54\xd0\x5c\x77\x0d\xe5\x76\x07\x9c\x5b\x8d\xe0\xf5\xc5\xe4\x74\x1b\xcc\xc6\x0d\xa7\xc3\x68\x34\x83\x3a\x03" +
+		"\x46\x50\x2c\x28\x73\xc7\x91\x93\x27\x8f\xc4\xaf\xbb\x86\x37\x75\x36\x97\x97\xb7\x75\x98\xf1\xcc\xdc\xd2\xd2\x5c\xea" +
+		"\x4e\x3c\x73\xd5\x55\x49\x5c\x65\x1d\x70\x44\x1c\x87\x19\x3e\x9b\x70\x9a\x60\xf8\x36\x85\x23\x88\xeb\x34\xac\xe7\x61" +
+		"\xef\x6b\x73\x06\xe4\x22\x85\xdf\xd9\xde\x17\x2f\xb7\xfa\x25\x8d\x92\xa0\xd9\xce\x9b\xa3\x40\xab\x1d\x68\x7d\xb6\xaf" +
+		"\xc7\x62\xb3\x59\x7a\xfa\x52\x7b\xf1\x1d\x95\x25\xad\xad\x25\x95\x5f\xde\x44\xb3\x83\xeb\x87\x5b\x40\x96\x26\xd3\xa3" +
+		"\xe9\xbc\xd2\xd4\x75\x5b\xf4\xb6\x49\xb7\xd3\xa8\x8b\x5f\x7b\x2d\x6f\xee\xd8\x90\x4a\xad\x93\x96\x98\xab\x43\x4a\x63" +
+		"\xb4\x66\xb3\x78\xe4\x0d\x06\x9c\x88\xcd\x20\x5f\x19\xb5\x6e\x25\x8b\x02\xd9\x58\x2b\x61\xf6\x24\xc1\x02\x17\x6e\xdf" +
+		"\x53\xd1\xbe\x32\x78\x6a\x79\xaa\x7d\xd8\xd3\x5f\x63\x6e\x0e\xaa\x8b\x0a\xe5\x9a\x1a\xc5\xd0\xa5\x23\x17\x5c\xec\x5f" +
+		"\x88\xa7\x3e\x86\xe7\xdb\xa2\x8e\xe9\x78\xf3\xde\xb0\x48\xd4\x2b\xa5\xe7\xc0\x0e\xa0\x50\x07\x14\xea\xb2\x14\xb6\x88" +
+		"\xe9\x99\xdd\x31\x87\x9a\x7e\x62\xda\x35\x3e\xe2\xf4\x29\x44\x8a\xa0\xb3\xab\x2f\xee\x28\x2a\x29\xe6\x1b\x45\x0e\x63" +
+		"\x75\xb5\xb1\x39\x62\x00\xca\xea\x5a\xc5\xb6\x2b\x77\x7e\xf4\x52\xa2\xcb\x58\x2c\x35\x83\xef\xa8\xb2\xd9\xec\x4e\xeb" +
+		"\xcc\xe1\xde\xd9\xa0\x88\xef\x11\xe8\x4f\x80\x36\x35\x40\xdf\x40\xb4\x19\xc8\x25\x4c\xe5\xd3\x4e\xb4\x6b\x44\xda\xdd" +
+		"\x9d\xf1\x83\xf1\x93\x9e\xa8\x83\x73\x4d\x36\x04\xa7\xe7\x52\x1f\xc5\x8b\x17\xd6\x0f\x79\x52\x8f\x92\x15\x00\x18\xca" +
+		"\x39\x72\x4b\x13\xee\x42\xed\x5c\x8e\xfb\x9b\x98\xff\x6f\x16\x88\xac\x85\x03\x1a\xab\x51\x2f\xcd\x78\xbb\x5a\x27\xd7" +
+		"\xcb\xf4\x6e\x51\xd9\x40\xe5\x48\x57\xdc\x55\xee\x8c\x56\x9b\x8b\x14\x3f\x6a\x1f\x28\xb1\x58\x54\x0a\xc1\xc9\xed\x56" +
+		"\xa9\xbc\x66\xc0\xe7\x18\xeb\x4c\xcd\xe1\xeb\x1b\x6a\xdd\x7e\x9b\xbb\xa9\xe1\x29\x22\xc7\x0e\xd0\xa3\x12\xd6\xa1\x5e" +
+		"\x58\x87\x24\x1e\xb1\xb8\xe4\xac\x82\xbd\x09\xab\xa6\xe6\xe3\x27\x63\xdb\xe2\x83\x22\xb1\x2e\xae\x98\x9e\xc3\x2b\xa9" +
+		"\x4f\x5c\xb8\x7b\x18\xb7\xad\xbf\xb0\xa3\xc6\x4f\x30\xc4\x01\x83\x31\x8b\xa1\xca\x29\x75\x12\x24\x1b\xd1\x35\xde\x35" +
+		"\xad\x15\x89\x07\xe2\x23\x7d\xc7\xe3\xb3\x8a\x80\x7b\x92\x73\xa5\x1e\x1d\xde\x7d\x21\x5e\x4c\x7d\x64\x76\x9a\xdd\xdc" +
+		"\xf8\x26\xd0\x44\xcb\xd6\xb7\x89\xdc\xad\xc3\xc9\x28\x6c\xb5\xed\x9c\xcd\xee\x75\xdd\x8d\x73\xed\x43\x2d\x9e\x70\x7c" +
+		"\xdf\x70\x78\x4a\x27\x12\x0d\xba\x5a\x1d\x91\x5e\xbc\xe5\x6d\xa3\xae\xbf\xbe\x62\xbc\xe7\xc3\x83\xf1\x70\x2b\xbe\x20" +
+		"\x75\xed\xec\x04\x70\x88\x17\xbc\x61\x93\xa7\x6e\x63\xdb\xc9\x70\x19\xfa\x4b\xb8\xa4\x8a\x73\x9e\x6f\x73\x3c\x9b\xbf" +
+		"\x23\xbb\x5b\x07\xe3\x03\x62\xb1\x76\x32\x3c\xbc\x7f\x63\xf3\x3e\x87\x4b\x61\x2b\x7e\xa8\x35\x1c\x1f\xc4\x9d\xeb\x2f" +
+		"\x4c\xba\x03\x13\x07\xbe\xb9\x69\x6f\x14\x56\x05\x3f\x0e\x3c\xb6\xe7\xad\xbb\x8c\x77\xe6\x2a\x32\x47\x93\x34\xda\x9c" +
+		"\xb3\x2c\x55\x63\x71\x57\x45\x8f\xb7\xdc\x03\x2b\xc5\x57\xde\xd4\x3b\xd8\x56\x1f\x89\xd7\x0d\x79\x3a\x27\x88\x35\x4b" +
+		"\x4c\x5e\x49\x44\x54\xa2\xb7\x96\x6b\x8d\x8d\x21\x3d\x59\xb0\xd5\x8a\x81\x0f\x4e\x1e\x3a\x1d\x12\xd6\xcd\x83\x19\x85" +
+		"\x46\x77\xb8\xc2\x75\x93\x34\x20\x39\xad\x15\x93\xc3\x7d\x07\x02\xc2\x22\xa2\x7b\x00\x27\xce\xee\x99\x84\x9b\xf7\xdf" +
+		"\x06\xb2\x6e\xb5\xad\x87\xba\x55\x4d\x89\xc9\x90\x17\xf8\xfd\x12\x83\x49\x53\xa4\x19\x8d\x31\x6f\x7b\xb9\xb9\xa7\xcc" +
+		"\x6c\x51\xe5\x46\xfb\xda\x5a\x9b\xb1\xbc\xd6\x29\x1f\x98\xc8\x38\x21\xd5\x1c\xfe\x3d\x68\xae\x9e\x9d\xcf\x02\x46\x76" +
+		"\x42\x03\x1b\x56\xb9\x39\x16\x5f\x8c\x99\x78\x62\x2e\xe5\xc9\xce\xa3\xed\xd8\xe7\x18\x57\xb5\x39\x1d\x6d\x55\x95\x83" +
+		"\xe1\xf6\xd1\xca\xb9\x95\xde\x7d\xe6\xa2\xc3\x9d\xc1\xd6\xa1\x68\xf5\x40\x53\xf9\x21\x85\x49\xf5\x8b\x2f\x48\x0b\xd5" +
+		"\xf5\x35\x95\x55\x56\xad\xde\xe9\xab\xee\x8c\x99\x0a\x46\x55\x56\x4b\x69\x59\x99\xae\xa0\xa8\xba\xc3\x3b\x3a\x03\xf4" +
+		"\xab\x81\xfe\x23\x9b\xee\x97\x84\xf8\x16\xb4\x81\x38\x47\x34\xf2\x6a\xcb\xbc\xb3\xc8\x1e\xf7\x87\x62\x8d\xed\x9d\xbb" +
+		"\x1a\x0e\xae\x7a\x77\x5a\x8a\x26\x3d\x3d\x61\x6b\x5b\xd0\xbb\xdd\x53\x33\xa3\x30\x6b\xfa\x95\x96\xea\x4a\x47\xbd\xde" +
+		"\xd0\xd0\xee\x6d\x1f\xd3\xaa\x87\x0a\x4d\x35\x45\xa6\x52\x9b\x4e\x53\xdb\xe1\xab\x1d\x68\xa0\x9f\xf3\x20\xfc\x63\xb6" +
+		"\xb7\x85\x60\x77\x35\x0a\x84\x89\xe3\x86\x98\x6f\x13\x77\xf9\xd8\xf0\x1d\xca\xee\x9e\xe1\x69\xcf\x64\x75\xe9\x81\xa6" +
+		"\x3d\xcb\x5e\xcf\xe2\xd4\x9b\xb1\x37\xf6\x26\x93\x09\x6b\xd1\xb8\xce\xd2\x7d\xc9\xee\xc9\x33\x5d\x04\x1f\x48\xf2\x05" +
+		"\xc0\x57\xb4\x49\x92\x7c\x84\x0f\x55\x0d\xd4\xd8\x4c\x8d\x15\x83\xe3\x3b\xa7\xec\x51\xab\x2d\xe6\xdc\xbd\x1a\x3c\xa9" +
+		"\xd0\x98\x7a\x94\xfa\xa5\x85\xf9\x23\x2a\xd5\x90\xba\xb0\xef\xd2\x89\x2b\x3e\xc9\x3e\x3d\x87\xd3\x42\x0d\x7d\x6f\xc4" +
+		"\x07\xf4\xc4\x2b\xd8\x79\x46\x38\xce\x98\x4d\xec\x40\xe3\xfc\xfc\xe7\x77\x7f\xbc\xd0\xe2\xd6\x94\xda\x86\xa2\xc5\x7a" +
+		"\x93\xb1\x42\x7d\xa0\xc5\x59\xee\x75\x95\x4c\x74\x41\xdc\xa9\x31\xeb\xca\xe7\x7d\xe3\xfb\xa4\x7d\x12\x51\x93\xe3\x60" +
+		"\xfb\xc8\x69\x57\x09\xf9\x34\xd8\x91\x8e\xe0\x14\xec\x8d\x4e\xb2\xba\xb7\xd4\x42\xce\x5e\x49\xb4\x21\xcd\xde\xd5\x60" +
+		"\x68\xce\x35\xec\xc6\x3c\x2d\xcd\xf8\xcc\x95\x93\xcd\xb5\x65\x45\xa0\xab\xaf\x47\xeb\xaa\xbd\xca\x96\xd8\xf6\x89\x68" +
+		"\x93\xbe\xc0\x61\x2d\xd2\xe5\x6a\xaf\x52\x52\x56\xdc\xd0\xa8\x28\x9f\xeb\x02\x25\x3e\x61\x09\x35\x39\x2c\xe1\x50\xff" +
+		"\xcd\x99\x8b\x22\x7d\xeb\xcc\x38\xfc\x8b\xf8\x3b\x3f\x1f\xe7\xa5\x9a\xd9\x0d\x0a\xc0\x76\xe5\xec\x54\x16\x60\x21\x23" +
+		"\x13\xc9\x42\x99\xed\x88\xee\x0d\xf2\x9d\xb3\x53\xcd\xcd\x7d\x23\x55\x03\x7e\xef\x9e\x3a\xdb\x42\xdb\xa5\x17\x0c\x88" +
+		"\x45\xda\x69\x45\x7c\xdf\xf5\x57\x44\xda\xe2\x03\xbb\xbb\x60\x17\x2a\x1e\xd7\x5a\x4f\x1d\x4b\x7d\x93\x6c\x17\x18\xf9" +
+		"\xc0\x3b\x5e\x01\x0a\x2e\xea\x1d\x34\x86\xe6\xf9\x06\xd9\xea\xa4\x34\x12\x09\xe1\xf2\x57\x53\x7b\x4f\x5f\xb3\xbf\xa5" +
+		"\xd7\x3f\x5d\x6d\x9b\x6b\x5f\x3c\x34\x35\x71\x70\x5b\x53\xa0\xdc\xaa\xd2\x37\x28\x76\x0c\x8f\xef\x96\x49\xca\xba\x22" +
+		"\xc5\x96\x71\x75\xd1\x9e\x44\xea\xc3\x58\x24\x91\xfa\xbd\x0d\xb5\xf2\x6e\x19\x8d\xd9\x40\x8d\x33\x81\xd6\xdc\xe7\xa1" +
+		"\x96\x7f\x02\x3a\x3f\xdd\xea\x0e\xa3\xd6\x32\x59\x57\xae\x55\x9f\x9f\xbe\x4a\x2b\xb3\x19\x2b\x83\x2a\x5d\xa3\x6b\x13" +
+		"\x23\x19\xbd\xba\x90\xe6\x9c\x5d\x16\x82\x48\xc3\x89\x83\xf1\x0f\xf6\x74\xc5\x0f\x29\x96\xe6\xf0\x4c\xea\x8e\x2b\xa3" +
+		"\xdd\x78\x5f\xea\xce\xb9\xa5\x0d\x8b\xfc\xfa\x2f\x9e\xc9\xb9\xd6\x5f\xdc\x98\x19\x07\x9a\xae\x8d\x99\x82\x2d\x9d\x82" +
+		"\x26\xcc\x54\xd8\x00\x71\x25\xa3\x93\x04\xb3\xd0\x45\x0b\xf1\xe8\xc0\x40\x74\xa4\xd3\x23\x95\xf5\x4b\xd4\xfa\xc1\x78" +
+		"\x67\xe7\x82\x22\x79\x10\xc7\x53\x77\xc5\x7a\x7a\x62\x96\x70\xb9\xda\x5e\xa0\x2c\xd4\x2a\x4d\xa9\x54\x8f\xf9\x20\x79" +
+		"\xbb\xdf\x93\x7e\x87\x23\x9f\xf8\x7b\x05\x2a\xe4\xda\x13\xa2\x21\x8b\xaa\x57\xba\x71\xfe\xa2\xa7\xca\xfc\x6b\x8f\xeb" +
+		"\xc2\xd9\xe2\x1a\x93\xb1\xae\xc4\xde\xdf\x62\x09\xaa\x34\x05\x4e\x7d\x6d\xb5\x33\x52\xaa\xd7\xb7\x36\x99\x9b\x02\xc3" +
+		"\x8d\xf1\x23\xf2\xe9\x11\x77\xa4\x48\x24\x92\xd8\xfc\x95\x8e\x8e\x6a\xa9\xac\x57\x24\xad\xb2\x3b\x5d\xca\xae\x7a\x5f" +
+		"\x89\xd7\xd6\xde\x08\xe7\x91\x5b\x17\xe9\x29\x62\x17\x9c\xa7\xbc\xe0\x59\xd5\xc2\xf9\x36\x97\x3e\x89\x0d\xb0\x07\xc2" +
+		"\x4e\x02\x81\xc2\x1c\xa8\xa4\xda\x70\xf2\x4e\x7e\x97\x23\x5c\x56\xe0\x54\x16\x28\x0c\x12\xab\x1e\xf3\x22\xbd\x53\x1d" +
+		"\x6a\x0c\x99\xed\x2a\x11\x8f\x27\x47\x3f\x25\xba\x1d\x3f\x5a\xe7\xd6\x49\x24\xdd\x3c\x6f\x31\x7d\xaa\xac\xdb\x53\xd7" +
+		"\x5a\xb4\x7b\x77\x75\x73\x99\xa7\xbb\x0c\xcb\xd6\x5f\xc0\x92\xd4\x9f\x32\xb4\x7d\x60\xa5\xff\x29\xda\x53\xdb\xdf\x87" +
+		"\xf6\xf7\xd7\xcd\x19\xda\xb0\x63\x70\x37\x03\x6d\x07\x8d\x8d\xb0\x37\x98\x73\xc8\x41\x4d\x1f\x00\x62\xb0\x89\xc1\x8a" +
+		"\x95\xf2\xd7\xec\xdb\xe3\xc4\x52\xb9\x48\x2c\xb5\xa8\x7b\xfa\x7b\xd4\x66\x99\x48\x22\x17\x35\x4d\xad\x4c\xed\x91\x16" +
+		"\x4a\x45\xb2\x42\xd9\x1e\xce\xf5\x5b\x45\xeb\x88\xab\x66\x6f\xe8\xd5\x57\x43\x7b\x6b\xda\x66\x4a\xf0\xfd\x40\xad\xd6" +
+		"\xd8\x5c\x5f\x1f\xb1\xa4\x7e\x94\xa1\xf9\x61\xf0\xad\x72\x81\x26\xa3\x14\xc9\xa9\xe5\xd0\xbc\x61\x76\x4f\x95\x58\x21" +
+		"\xe5\x95\x56\x79\xf7\x40\x8f\xdc\xa2\x12\x49\x14\xe2\xea\x3d\xb3\x93\xd3\xb2\x42\x89\x48\xaa\x96\xee\x06\x92\x25\xd3" +
+		"\x1d\x9d\xd3\x65\xaf\xbe\x5a\x36\xdd\xd9\x31\x5d\xf2\x5b\x50\x6d\xad\x35\xcc\x08\xc2\x19\x13\xe8\x49\x84\x33\x26\x9c" +
+		"\xb6\x8d\x01\x38\x12\x90\x35\x6b\x74\x92\x1d\x52\x6a\x8c\xc7\xf9\x5f\x2c\xdc\xb1\x63\x67\xbc\x6b\x78\x85\x73\xfd\xe6" +
+		"\x37\xfc\x67\xa5\xb3\x3b\x1e\x7d\xa4\x31\xca\xaf\x3e\x99\xd5\xd0\x77\xc8\x77\x13\xc8\xee\x91\xcf\x2d\x89\x09\x61\x76" +
+		"\xfb\xa5\x01\x8f\x5e\x7f\xa5\xbc\xb3\x8a\xf1\x2f\xbd\x64\xe7\x6e\xbb\xa8\x00\x8b\x34\x46\x65\x57\x77\xb4\xd0\xac\x16" +
+		"\xcb\x65\x26\x65\xa4\xc7\xbd\x23\x16\xd8\xed\x6b\x2d\xb5\x49\x44\x83\x93\xc3\x70\x0e\x17\xc9\xb5\xca\x11\xd8\x65\xd6" +
+		"\x4b\x27\x5b\x5a\x7a\x9d\xf7\xdf\x5f\xde\xd7\x52\xb9\xb7\xe1\x64\x4f\x5b\x57\x93\x5f\x2c\x6e\xda\x51\xc8\x2b\x3d\x61" +
+		"\x4b\x6a\xe6\x85\xba\x76\xb7\x2f\x5a\xf1\x3c\xe3\xed\x14\x44\x28\xdf\xf9\x34\x99\xe1\x2d\x12\x30\xd1\x1b\x6f\x2e\x67" +
+		"\xc7\x06\x77\x97\x4a\xa5\xbc\x48\x66\x33\xbb\x7b\xfc\x05\x95\x5a\xb1\x44\x29\x95\x4b\xad\x9e\xc6\xe9\x4a\x3d\x1f\xc7" +
+		"\xd5\x3e\x97\x41\xd2\x31\x39\xac\x32\x4a\x45\x52\xa3\x6a\x98\x73\xfd\x2e\xb4\x2b\x52\x36\xd6\x76\xf1\xc5\x25\x89\xe6" +
+		"\xee\x78\x7d\x6b\x9d\xbf\xb3\xbc\xa4\xb2\x55\x0a\x57\x5d\x88\x5a\xff\x5e\xd1\x67\xb7\xf7\x55\xfc\xbb\x70\x83\xc3\x69" +
+		"\x58\x4d\xce\xcc\xba\x8e\xd0\x28\x91\x3d\x14\x6d\x3a\x1e\x60\xfd\xd2\x11\x9e\x7b\xea\x12\x5b\xa4\xa6\x21\xea\x1a\x9a" +
+		"\xf6\x92\x7d\xa7\x79\x6a\xd9\xef\x3e\xa4\x58\xbd\xd0\xe0\x31\xc7\x0c\x9d\x31\x9b\x63\xf7\xc4\xca\x82\xd5\x3c\xae\x31" +
+		"\xf7\x5d\x3e\x35\x75\x49\x34\x7b\x53\xec\x67\xf7\xb8\xad\xe8\x9c\x1b\xaa\xcf\xa1\x37\xbc\x67\xdf\x76\x20\x57\xdd\x5d" +
+		"\x64\x28\x9b\xf4\xb9\xca\x6d\xe7\x52\xf5\x02\x51\x8d\x5e\x5a\x6a\xa9\x09\x17\xda\x97\x7a\xa6\x2e\x8e\x22\xe1\xfb\x2e" +
+		"\xb8\x17\xa4\x54\xd3\x75\x9b\xb7\x0d\xbd\xd6\x10\x72\x75\x94\x18\x06\x03\x23\xd3\xf8\x4a\x9f\xdb\xa0\xd9\xae\x30\xc4" +
+		"\x76\xa5\x56\xd2\xe9\xd4\x9b\x64\x16\x6f\xe6\x2a\xc9\x69\x01\x49\xe8\x37\x4c\x00\x57\xba\x31\x83\xcb\x7c\x7e\x5c\xdf" +
+		"\xe6\xb6\x65\x91\xad\xdf\x45\x3e\x3b\xab\x42\x9d\xb8\x0e\xbf\x8c\x54\xe4\x7b\x86\x7a\xa2\x03\xb2\xff\xd0\xd8\x6c\xe4" +
+		"\xf3\x9b\x7f\xd7\x1e\xd4\x0c\x71\x62\x95\xfe\xc6\x8f\x66\x6b\x78\x2a\x54\xee\xb0\x14\x8a\x75\x72\xa5\x36\x96\x7a\x39" +
+		"\xa7\x01\x3c\xed\x07\x2b\x1a\x85\x7d\x87\xde\x59\x32\x9b\x00\x41\xf9\xfd\x62\xbf\x3d\xae\x37\xcb\x0d\xf2\x42\xe1\xaa" +
+		"\x81\xbf\x95\x8a\x99\x0d\x22\xbe\x0b\xae\x12\xe9\x34\x99\xcb\x55\xc2\xdc\x4a\xd4\x41\x3f\x35\xef\xc0\xf4\x5b\x5c\xe9" +
+		"\x3f\x00\x4e\x17\xdf\xcc\xce\x77\x80\x13\x6c\x56\xe5\x8c\x94\x1b\xc9\x3b\xd4\x2c\xf6\xe7\x01\x7b\x99\x11\x7f\xd1\x8c" +
+		"\x8b\x2a\x23\xb9\x54\x38\x43\x63\x58\x2d\x8a\xc5\xbc\x9d\xeb\xbf\xca\x50\x13\x3e\x43\xc3\x06\x3e\x9a\xfd\x44\x49\x2b" +
+		"\xbc\x40\x0b\x05\x73\xf4\x68\xa0\x97\x80\x0d\x1a\x8f\xd9\xfc\x76\x85\xd9\x68\xb5\xf4\xd9\xfd\x5a\x65\x73\x55\x74\x47" +
+		"\xa9\x41\x67\x2a\x30\x18\x0b\x0c\xb2\x8c\x4c\x57\xdb\x9c\x8e\xa2\xb2\x6a\x4b\xb3\x4e\x3e\x2c\xd5\xb4\xb4\x55\xb7\x5a" +
+		"\x4c\x25\x65\x96\xd4\x55\x39\xb4\xe5\x40\x7b\x06\x24\x2a\xce\xd2\xae\x74\x86\x40\x20\x27\x3d\x17\xe4\x89\xd4\x54\x3f" +
+		"\x88\x0b\xbe\x8b\x4d\x65\x79\x22\x55\xb4\x06\x2b\xed\x31\x91\xba\xcd\xb7\xfe\x4e\x16\xaf\x06\xf6\xc4\x5a\xce\xc1\xd9" +
+		"\x50\x01\x32\xc3\x3a\xf2\x80\xb7\xf4\xa1\x71\xb4\x0f\x1d\x41\x27\xd1\x07\xd1\x8d\xe8\x53\xe8\x6e\x74\x3f\x7a\x08\x58" +
+		"\x70\xb0\x17\x18\x44\xc4\x9c\x7a\x20\xa7\x6e\xce\xa9\x97\x9f\x67\x7c\x2e\x5c\xfc\x3f\x04\x3f\x1f\x3f\xb9\x70\x5c\x53" +
+		"\xa0\x56\x17\x40\xda\xc7\xca\xdb\x59\xd9\xc1\xca\xd4\xbf\xb2\x4a\xb3\x42\xa3\x51\x40\xc2\x37\xb1\x4a\x6a\x30\x03\x79" +
+		"\xe0\x1c\x48\x76\xcc\xf5\x6c\x36\xf6\xb1\xca\xfa\x26\x3a\x19\xba\xf8\x65\x3a\x01\x92\x2e\x53\xc9\x24\x0f\x1d\x90\x93" +
+		"\x06\x37\x03\x26\xde\x17\x70\xce\x14\xcf\x66\x2a\x59\xb2\x64\x65\x37\xc3\x0a\x42\xf8\x87\xd4\xfe\x28\x57\xbf\x55\x99" +
+		"\xb3\x3b\x2c\x6b\x5c\xb2\x72\xfc\xf8\x0a\x49\xd1\xbe\xbe\x68\x77\x2c\xa6\xb8\x34\xb9\x76\xfa\xf4\x5a\xf2\xd2\x81\x9d" +
+		"\xdb\x77\x8d\x8c\xec\xda\xbe\x93\xf8\x68\x08\x7c\xf4\x65\xc0\x55\x91\xfb\xf9\x41\x16\x89\xe0\xa1\xce\x1c\x12\x12\x6c" +
+		"\x34\xd7\x29\x15\x2e\x43\xac\x33\xda\xd3\xda\x55\x55\x26\xd6\x48\x55\x26\x57\x6f\x5f\x72\xee\xc4\xe1\xba\xbd\x0a\x89" +
+		"\xb8\x4f\x24\x89\x8f\xef\xea\x0f\x07\x6b\xdd\x1c\xd7\xad\xda\xdb\x17\xdf\x73\xfa\x48\x6c\x95\xde\x03\x5a\xe8\xdb\xb5" +
+		"\x7e\xf2\x4d\xbe\xec\xd9\x91\x7d\x60\x91\xf3\x62\x2d\x7b\x58\x76\x6e\x7e\x03\x47\xdf\xcb\x4a\x70\xd3\x95\xa7\x77\x95" +
+		"\x0c\x44\xa3\x51\xb3\x46\x6d\x50\x72\x61\x4e\x6d\xb0\x6b\x8a\x55\x1a\x8d\xca\xaa\xa9\x0b\x98\x02\x9e\xa2\xba\x02\xbe" +
+		"\xb0\xa6\xc8\xd2\x50\xe2\x39\xac\x38\x7d\x84\xbc\x5c\xaa\x1b\xa8\xaf\x1f\xa8\x3f\x5a\x6a\x2d\xb3\x16\x97\x74\x15\x57" +
+		"\xca\xda\x64\xd2\xca\x8e\x8a\xb6\x6e\xb3\xcd\x66\x36\xf8\x1b\x2e\xeb\x3f\x18\x06\x0e\x41\xb7\xf8\x77\xa0\x0f\x88\x17" +
+		"\xfa\x2c\x2f\xe1\x2d\x99\xd9\x78\x7b\x96\x16\x59\xb5\x1d\xd5\xf5\x32\x83\x42\xa1\xac\xd0\x47\xa2\xa5\xfd\xdd\x25\x6e" +
+		"\x85\x48\xe1\x2d\x0b\x47\x27\x4b\xb5\x86\x62\x11\x1e\x71\x58\xff\x6e\x6a\x0f\x2f\xea\x16\x4b\xba\x2e\x88\x1d\x5b\x25" +
+		"\x54\x5b\xfa\x52\xf7\xe1\xd1\x0a\xa3\xc5\x49\x74\x03\x3a\xc1\xbf\x06\xdd\xd4\x23\x24\xac\x93\x70\x40\xd0\x0c\x39\x3b" +
+		"\x38\x99\x3d\x02\x02\x0b\xd9\x03\x30\x04\x6f\xcc\xcf\xcf\x55\xec\x1a\xac\xae\xa9\x51\x4d\xf4\xef\xf2\xf5\x84\x1b\x3b" +
+		"\x22\xd6\xe6\x40\xa5\xc5\x22\x56\x8a\x4d\xa6\xde\x86\xc3\x6b\x9d\x4b\x51\x4d\xb5\xc3\x51\x3d\x32\x3a\xb5\x6d\x7b\xac" +
+		"\x34\xdc\xd9\xad\x6c\xe1\xb8\x8a\xb2\xb1\x5e\xfa\x0d\x21\x04\x37\xa7\x1d\x28\x4c\xbe\x45\x21\x50\x8e\x54\xe6\xac\xc7" +
+		"\xf3\xb0\x60\xca\xe5\x81\xcf\x75\x3c\xd1\xc2\x81\xbe\xae\x42\xb5\xaf\xae\xc1\xa3\x90\xd6\x98\x47\x07\x76\xb9\x63\x8d" +
+		"\x8d\x1d\x61\x73\x73\x40\x5b\x5e\x68\x11\xab\xc4\x46\x73\xac\xe1\xbf\xc8\x5b\x76\x83\xd1\xb8\x7c\x7c\xf6\x88\x4e\x5e" +
+		"\x66\x74\xb9\x3d\x4e\x6d\x91\x4c\x54\xe3\xb1\x33\x3e\xcb\x03\x9d\x51\xa9\xb8\x95\xe3\x5c\xf6\x1d\xbd\xf8\x19\x8b\x46" +
+		"\x63\x36\x6b\x34\x16\xa2\x2b\x35\x58\xe9\x49\xd0\x95\x6d\x8b\x77\x01\x4e\x38\xee\x64\xe2\xfa\x3b\x91\xdd\xf6\x88\x39" +
+		"\xd0\x10\x6b\x69\x6d\xad\x6f\xe6\x5b\x06\xc7\x3c\xc6\xf6\xca\x83\xfb\x96\x14\x8e\x9a\xb0\xd5\x3e\x31\x1e\x1f\xaa\xaa" +
+		"\xc2\xca\x7f\xb0\x98\x3e\xb8\xb4\x76\x0a\x09\xf6\xe7\xae\x07\xcc\x21\x66\x7f\xc9\x39\x0e\x90\xe7\x01\x1b\xaf\xbd\x32" +
+		"\xbe\xf0\xb6\xc8\xa0\x77\x98\x2b\x1b\x64\x46\x70\x06\xbb\xbe\xb9\xa7\xd4\xde\x5a\x53\x5a\x55\x20\x2e\xf0\x96\xfa\x5a" +
+		"\xeb\x3d\xbb\x9a\x76\x7b\xbb\xcd\x7a\x8b\x1e\x7c\xc2\x5e\x3c\xd8\xbf\x7f\x37\x75\x8a\xce\xa3\x43\xe3\x89\x5a\xb3\xad" +
+		"\xd4\xe8\x6f\x4f\xbd\x1c\x68\xc3\x37\xa6\x56\x62\xa3\xe5\x78\xa2\xd4\x5c\x6c\x67\x7c\x49\x80\xaf\x06\x61\x9d\x4a\x25" +
+		"\xe7\xf1\x10\x73\x84\xbe\xe4\x25\xe7\xd7\x08\x3d\xd2\xe0\x4b\x6b\x1b\x15\x91\x81\xb8\x6b\xd7\x90\xbf\xa6\x5a\x35\xd1" +
+		"\x37\xd9\x16\x0d\x57\x5b\x5b\xbc\x36\x5b\xab\x4d\x15\xac\x72\x0d\x2a\x9c\x95\xbd\x38\x79\x2c\xba\x1c\xd5\x54\x39\xa9" +
+		"\x93\x0c\x6f\x6b\x17\x77\xd5\x57\x0c\x57\xb8\x0a\xda\x0c\xfd\xfe\xd8\x18\xca\xac\x0c\x81\x03\xf3\xc6\x52\xa0\x2c\x6c" +
+		"\x70\x60\xd6\x93\xf3\x39\x7b\xe7\xc7\xf4\xf6\x4c\x63\x4f\xe9\xb6\x81\xb2\x0a\xb5\x29\x28\x35\x1a\xc6\x1a\xfc\xea\x92" +
+		"\x62\xf5\x87\xf6\x7c\x58\xa6\xd3\x6b\x6d\x9a\xaa\x06\x61\xd9\x28\x3a\x56\x07\xce\x2c\xe8\x8d\xd5\xe6\x92\xee\xbe\x50" +
+		"\xb3\xd3\x99\x7a\x00\xef\x35\x9a\x8b\xa2\x6d\x93\xd3\x54\x43\x84\x87\x6a\xf4\x4b\x5c\x84\x03\xe4\xbb\x55\x11\xa0\x59" +
+		"\xfd\xc6\x2f\x47\x46\xde\x0b\xae\x4b\xa7\xb9\xdb\xe1\xec\xe4\x26\x9f\xdf\x4a\x36\x02\xd9\xc6\x05\x92\x71\xcf\x3e\x06" +
+		"\x82\x1b\x14\x1d\x56\x65\xc4\xc3\xc6\xee\xaa\xf1\x85\xb1\x7e\x95\xc3\xa0\x54\x1a\xc4\x4e\x4f\xa4\xb1\x25\x12\x97\x19" +
+		"\xd5\x91\xd6\x78\xdc\xd0\x6c\x2f\x32\x63\xac\xb6\x9a\xe3\x53\x03\xbb\x44\xb2\x6e\x31\x57\xef\xf1\x05\xdb\xdb\x3f\xf0" +
+		"\x1a\x2f\xea\x68\x3b\xb9\xfe\x3c\xb6\x58\x2c\xb2\xdf\x93\x6f\x81\x91\x93\xa8\x85\x45\xeb\xf2\xf3\xc7\xeb\x08\x81\x07" +
+		"\xfc\xc4\x62\xb5\x27\xd9\xdf\xd0\xf0\x30\xfc\x1b\x1e\xf6\xb9\xf7\xcd\xb8\xb3\xf1\x3b\xb6\x6b\x6c\x72\xfb\xf6\xc9\xb1" +
+		"\x5d\x6d\xcd\xbe\xc3\x87\x7d\xe4\x77\x29\x3e\x14\xe3\x1b\xf0\xa7\xc9\xa7\xfd\x11\x47\xf6\x63\x11\x03\xfd\x50\x2e\x37" +
+		"\x98\x87\xab\x02\xe0\x20\xe4\x5f\x29\x47\xac\x63\xa4\xc2\x4a\xc9\x8b\xa6\x2a\x15\xf7\xda\xd4\x6c\x49\x34\xd4\xd1\xa7" +
+		"\x55\x74\x88\xe5\x4e\x8b\xc3\xd6\xee\x09\x74\x96\xe2\x96\x60\x99\xbf\xaa\xb4\x4e\xa2\x50\x17\x97\x14\x6a\x25\x32\x93" +
+		"\xba\xb7\xdc\xd4\x50\x6b\xf7\xd5\xca\x6b\x77\xc4\x0e\x4c\xd6\xf5\xd7\xb7\x84\x8b\x3c\xaa\x32\xa9\x55\x55\x62\xf5\x3b" +
+		"\xbb\xc2\x81\x9d\xc1\x13\x2b\xf3\xd3\x9a\xa2\x6a\x8b\x88\x6f\x15\x4b\x06\xc7\x97\x4e\x1c\x74\xf4\xfb\x80\xd3\x91\x74" +
+		"\x84\xe3\xc0\x22\xb5\x1b\x9f\xf7\xd3\xdb\x56\x86\x45\xb6\xd0\x36\x05\x5a\xfc\x79\x33\xfc\x0d\x59\x4c\x45\x96\xd2\x52" +
+		"\x9d\x49\xab\x2d\x37\xf4\x76\xf6\x34\x95\x07\x21\xcc\xfb\x9d\xde\xee\x27\xb8\x63\xf6\xe2\x62\x7b\x5d\x9d\xbd\xb8\x21" +
+		"\xdc\xde\x20\x8b\x4a\xe5\x7b\x77\xcd\x6c\xb7\x16\x17\x5b\x83\xb1\xf5\x0f\x0a\xdf\xe0\xc4\xff\x00\x74\x21\x62\x94\x6b" +
+		"\xcf\xef\x08\x5a\x3c\x5c\x3b\xb9\x63\x61\xac\x4f\xe5\x24\x66\x97\x96\x7b\x1a\x83\x6d\x8d\x71\xac\xa8\xcf\x37\x74\xdf" +
+		"\xe9\x75\xf2\x2d\xe8\x57\xd3\x67\x90\x91\x5b\x23\x7e\x27\x85\x38\x60\xac\xbd\x92\xfb\xe3\xba\x94\x9c\x66\x3f\x06\x3d" +
+		"\xcb\xdc\x97\xc9\x2f\x0e\xa4\x5a\x69\x55\xa4\x2a\x62\x8e\x98\xa5\x66\x69\x95\xd6\xd8\x5e\x34\x36\x56\x34\x3e\x4e\xf2" +
+		"\xf6\x2b\xb9\xdb\xda\x8b\xc7\x76\x14\x8f\x8f\x17\xef\x18\x2b\x6e\x5f\x9f\x23\xdf\xe6\x1c\x4d\x3f\xce\x59\xb8\x77\x91" +
+		"\x89\x7e\x83\xdd\x45\xee\x30\x11\x6d\x80\x7a\x29\xbb\x46\x92\xd5\x06\x6a\xe3\x03\x21\xa7\x38\x58\x65\x0c\x87\x31\xad" +
+		"\x55\x92\xea\xe8\xbd\x6d\xb3\x73\x9d\xad\x35\xd1\xaa\xd3\x73\x6d\xf1\x78\x1c\xe3\x23\x6d\xee\x54\x1a\x2a\x86\xc6\x58" +
+		"\xac\xd1\xc5\x5d\x3e\x3e\x21\x1d\xdc\xd6\x34\xa4\x10\x49\x1d\x1d\xfe\xc9\x7d\x33\x33\xf8\xf8\x43\xd3\xa3\x05\xdf\x10" +
+		"\xfb\x53\x7f\xff\xc2\xe1\xba\x26\xf1\x73\xa2\x0e\xe1\xfb\xfa\x7f\x42\x4f\x62\x37\x5d\x55\x70\x57\x7c\xf2\xec\xd9\x3f" +
+		"\x05\xc9\xeb\xc2\x7a\x6e\x26\x7d\x0d\xf7\x53\x64\x04\xf7\x16\xc2\x22\x8d\xbb\xc2\x0e\x4d\xdc\xea\x94\x24\x5c\x5f\xe4" +
+		"\x77\x1b\x4d\x66\x89\x5f\x12\x6e\x28\x0e\x34\x18\x8c\x66\x29\xf7\xd3\x96\x5e\x5b\x49\x49\x49\x4b\x6f\x09\x14\x80\x67" +
+		"\x25\xbd\x9c\xbe\x26\xfd\x00\xd5\x9e\xb1\x3c\x74\x2a\xf5\xab\xe1\xbe\x3e\x84\xd7\xb7\x6d\x82\xaf\x7f\x8b\xc2\x51\x01" +
+		"\xfe\x0f\x74\x1b\xdc\xb7\xe5\x10\x9b\xc1\x4d\xa4\xce\x76\x2e\x72\xb3\x54\xd9\x60\xee\x94\xeb\x34\x22\xee\x3b\x05\xa1" +
+		"\xcb\x42\x15\x53\x3b\xc9\x6e\x9a\xfa\x0a\x77\x06\xd5\xf2\x05\x44\xff\x7c\xde\x7b\x29\xe3\xb3\x1d\x41\xb9\x74\x48\xa2" +
+		"\xd1\xaf\x73\x67\x02\x76\xb5\xb3\x40\x59\xa8\x57\xea\x7b\x60\x8e\x9a\x3b\x93\x2e\x85\x39\xea\xcc\xc9\x79\xe3\x86\x94" +
+		"\xba\xbc\x3d\x28\x93\x0e\x71\xbc\x46\xff\x89\xcc\x34\x91\x5e\x46\xe7\xa5\x47\x60\xde\x5d\xfc\x08\xe1\x97\x07\x2b\x7d" +
+		"\x79\x8a\x3b\xf3\xf4\xd3\x00\x1f\xe7\x47\xd2\xf7\xf0\xaf\x67\xe0\x5f\xd8\xc9\x8f\x7c\xf6\xb3\x40\xe7\x1e\x18\x8f\x84" +
+		"\xf1\x18\xe0\xa9\x7b\x84\x09\xd0\x73\x13\xf4\x3c\xce\xf7\x93\x73\x1d\x0e\xb5\x73\xe7\x7c\xb2\x0e\x83\x6f\x32\xd4\xda" +
+		"\xd4\x1a\xb8\x89\x18\x0c\x3e\x75\x75\x43\xa1\xfc\x4e\xee\x4c\xbd\x48\x6d\xd1\x17\x6a\x65\x7c\x44\xa3\xa8\x77\x68\x4b" +
+		"\x0a\xe0\x8e\x96\x7a\x0b\xa8\xff\x09\xa8\x1b\x08\x2e\xe1\xc6\x93\x8f\x29\x65\x68\x30\x6b\xb4\x3a\x5d\x83\xb2\xa8\x56" +
+		"\x24\xbe\x84\x1f\x71\xc2\x71\xc1\xac\x70\x17\xca\x8a\xed\x32\x83\xbc\x1b\x70\x28\x81\x1f\x1b\xf0\x63\xca\xf0\x73\x0e" +
+		"\x3b\x3a\x5d\x75\x71\x49\x81\x51\x66\x34\x7a\xb5\x55\x6e\x45\xe1\x85\x02\x37\xba\x52\x81\x19\x97\x9a\x30\x03\x98\xf6" +
+		"\x70\x27\xd2\xcf\xf3\x31\x72\xce\xcc\xe8\x96\xee\x4f\x80\xc3\x49\xb9\xab\x24\x31\x28\x67\xdb\x48\x9d\xf5\x87\x42\x9e" +
+		"\xc6\x48\xa3\x5b\xbd\xeb\x01\xa5\xc5\xa2\xf0\x4a\xbc\x0a\xa3\x59\x2f\x2e\x94\x28\xb5\xdc\x89\x88\xc7\x1b\x12\xbb\x5c" +
+		"\xe2\x80\xb3\xd8\xd0\x55\x2b\xb1\x9a\x2c\x06\xae\xa4\x84\xd3\x59\x2d\x56\x8e\xf7\x28\x80\xe2\x4f\xf8\x41\x54\x0f\xf2" +
+		"\xbb\x32\x1e\x00\x71\xcf\x9f\x4b\x91\x12\xdc\xd8\xa6\x7e\x58\x5d\xe3\x6e\x08\xb8\x1d\x4d\x85\xdd\xb7\x6a\x8c\xf6\x3a" +
+		"\x71\x43\xb9\x46\x2b\x2a\x14\xc9\xa4\x46\x7e\xd0\xeb\x74\xfa\x1c\x0e\xbb\xa3\x48\xdd\x58\xc5\x19\x34\xa5\x62\x9b\x8d" +
+		"\x2f\x53\xe9\x31\x57\x27\x12\x83\xc7\x3e\x0d\xd2\xf9\xfe\x2a\xe9\x9a\x7c\xa1\x80\xc7\xed\x0e\x79\x0a\xfb\xef\xd6\x9a" +
+		"\x2d\xaa\x4a\x49\xa5\xca\x62\x32\xc3\x69\xba\x30\x4f\xba\x22\x43\x94\x4a\x67\xe1\x4a\x4b\x39\xab\x49\x5f\xca\xa4\x7b" +
+		"\x0c\x2c\x73\x0f\xf8\x50\x01\xf1\x2d\x58\x9c\xec\x75\xeb\xef\xc9\x76\x31\xcc\x9d\xe9\xef\x1c\x1a\xea\xec\x27\xbf\x04" +
+		"\x01\x2f\x78\x11\xb4\xb0\x69\xdc\x3d\xcd\xcd\x43\xcd\xcd\xfc\x48\xa0\x2d\x00\xff\x00\xdf\x1f\x60\x4d\xff\x92\xfb\x1d" +
+		"\xb9\xd1\xf3\xec\x98\x93\x79\xb3\x6a\x5e\x97\xca\x75\x0d\xb5\xd7\x1e\x35\x58\xc4\x22\x6e\x46\xe1\xd2\x1a\x2b\x2c\xbd" +
+		"\x6a\x9d\x54\x23\x23\x91\x4e\xcb\xfb\xd2\x7f\xe4\x1f\xa5\x2b\x2d\x67\xa6\xd1\x60\xfe\x29\xcc\xf3\xd4\x5e\x7c\xa8\x41" +
+		"\xc6\xfb\x0a\x5c\x1a\x53\x85\xa5\xa7\x5c\xa3\x21\xde\xe0\x06\x6a\xa5\x40\x4d\x09\x0d\xe1\x28\xc1\xa6\xa4\xda\xa5\xf2" +
+		"\xfa\xb6\xab\x57\xeb\x65\x94\x50\x59\xa0\xb7\x5c\x4d\x67\x74\x71\x4b\xe9\xdb\x41\xde\x32\x62\xcd\x2a\x76\x49\xcc\x70" +
+		"\x18\x88\x04\x32\x1b\x38\xb8\xe5\x23\x4d\x26\x7f\x63\x6f\x6f\xc3\xaf\x5f\x30\x97\x9b\x8a\x1a\xf5\x22\x50\x9b\xbe\x2b" +
+		"\xc0\x2d\xb9\xeb\x9a\xc2\x4d\x91\x56\x9b\xcd\x58\xec\x37\x95\x99\x74\x4a\x95\xdf\x0b\xb8\xd7\x7f\x0c\xb8\x4b\x00\x77" +
+		"\x29\xb1\xde\x56\xb8\xb3\xa8\x53\x8f\x86\x2d\x8e\xe6\x68\x77\xfd\x8f\x9e\x30\x97\x99\x8a\xaa\xad\x1c\xc4\xf3\x4e\x5f" +
+		"\x16\x75\x51\x89\xd1\xe6\x0f\x99\x75\x85\x02\xe6\xd4\x1b\x20\xe7\x0b\x7c\x39\x79\xdb\xc5\xb3\x23\x93\xb9\x72\xd3\xe7" +
+		"\x6e\x1b\x5b\x90\x39\x2d\x91\xd7\x97\x69\x0a\x39\x6f\x59\x41\xa9\x52\xa1\x97\x98\x4d\x52\x9b\xc2\x20\xd6\x6b\xc5\x3c" +
+		"\x37\x23\x2f\x55\x39\x1c\x62\xb9\xbc\xac\x54\x2c\x0e\xf2\x9c\x5e\xa3\x54\x19\x64\x22\x7d\xa1\x54\x29\x05\x19\x52\xe0" +
+		"\x0f\x77\x73\x67\xc9\x37\xe1\x31\x79\xc7\xb0\x9e\x1a\xc0\xfb\xb9\x33\x55\xf6\x3e\x22\xe1\x59\xb2\x8e\x73\x7b\xcf\xf6" +
+		"\x63\x5d\xb6\x37\x45\x7d\x49\x4f\x7e\x7b\x45\x7a\xc9\x27\x4f\x74\xbe\x72\x02\x93\x31\x3b\x2e\xa8\xca\xe2\xe0\x35\xe4" +
+		"\x37\x1f\x30\x8a\x38\x12\x60\x79\x68\x1c\x46\x94\x8d\xde\x8c\x38\x8a\xe5\x01\xde\x0e\x7e\x50\x24\x8c\xd8\x38\xe7\x38" +
+		"\x73\x0f\x3a\x80\xf9\x7e\xec\xab\xab\x0f\xb8\xeb\x79\x90\xd9\x60\x30\x19\x09\x15\xb1\xa4\xc2\xed\xa9\x70\x78\xea\x60" +
+		"\x77\x37\x99\xe8\x39\x9f\xa3\x34\x9b\x78\xd7\xfb\xe3\x3c\xdb\x7f\x0c\xfb\xeb\xeb\x03\x9e\x3a\x11\xc1\x69\xa4\x38\xcb" +
+		"\x20\x56\x79\xbc\x95\x2e\x4f\x9d\x59\xa7\x37\x99\xf4\x5a\x4b\x46\xda\xbb\x41\x5a\x45\x56\x0e\x2a\x4b\x6a\xe0\xca\xc1" +
+		"\xfe\x01\x2a\xef\x8e\x1d\xb7\x32\x79\x4b\x41\xde\xc2\xbc\x71\x54\xea\x58\x7f\x5b\x3f\xa6\x82\xc3\x5f\x0c\x71\xa9\x37" +
+		"\xb9\xd9\xf4\x93\xbc\x59\xb8\x91\xf3\xe7\x39\xe1\x7d\xc6\xdf\xdc\xec\xf7\xb7\xb6\xfa\x2d\x56\xab\xc5\x6c\xb5\x72\xb3" +
+		"\x9e\xba\x3a\x0f\x4d\x16\xbd\xde\x42\x12\x22\x6f\x01\x29\xae\xd7\xb9\x4a\xfa\xfe\x4f\x82\x66\x5f\x22\xd0\x67\x01\xfa" +
+		"\x62\x0e\xf4\x20\x85\x3e\xc2\xcd\x22\x75\x0e\x74\xf1\xa5\xad\x30\x2c\xbd\x44\xd6\xa9\x3f\xdd\x9a\xfe\x7a\xfa\x41\xb2" +
+		"\x7b\x4a\x1d\xe4\xa8\x49\xf8\xbb\xae\xa7\xc7\xee\x72\x3d\xd6\xd5\xe5\x28\x2b\x73\xc0\xdc\x74\x5b\xea\xd6\xf4\xb7\xd2" +
+		"\xe4\xb7\x1f\x97\xa1\x82\xf4\x34\xcc\xab\x48\xfd\x39\x7d\x57\xfa\x61\xba\xbe\xf3\x36\x00\xe3\x3f\x9a\xc4\x05\x62\x29" +
+		"\x6c\x85\x0e\x47\xea\xcf\x87\xad\x1c\xd7\x2a\x16\x3b\x6c\x57\x03\x16\x77\x6a\x22\x7d\x6f\xda\x0a\x58\x3e\x80\xe4\xe9" +
+		"\x38\xac\x84\x8b\x52\xef\xa4\xef\x06\x2c\x56\x41\x3f\xa0\x94\x2c\x9a\xec\x21\xdc\xf0\x7c\x57\x54\xab\x96\xa8\x8a\xa5" +
+		"\xae\x32\x4b\x49\xa9\x79\x24\xf5\x4e\xc0\xa3\xd5\xc8\x44\xad\x22\xce\x5e\xe2\xd0\x17\x15\xe9\xa7\x13\xf3\x44\xc2\x2b" +
+		"\x52\xa3\x80\xdf\x02\xf8\xaf\x40\xb2\xf4\x14\xe0\x0f\x81\xb5\xac\x42\x3c\xc4\x39\x71\x2e\x75\x39\x1c\xa1\xb7\x6d\xcb" +
+		"\x06\x44\x98\xdb\x0d\xda\x29\x01\x5b\x49\xd1\x99\xf4\x28\xf9\x55\x58\x6a\x02\x20\x6e\x88\x91\x52\x74\x51\x7a\x3f\x85" +
+		"\x34\x01\xa4\x9d\x42\x2e\x4e\x27\x28\xa4\x1c\x20\x49\x0a\xb9\x84\x8d\xd9\x09\x10\x0f\x85\x5c\x4a\xc6\xb0\xdf\xe2\x70" +
+		"\x08\xa9\x54\x0d\xd7\xed\x53\xb7\xbe\x85\x78\xfe\x65\x02\x7e\xfa\x13\xc1\xfb\x48\xf9\x1f\xff\xd5\x53\xbc\xbe\x2d\x35" +
+		"\xca\xcf\xc0\x2c\x62\x17\x8e\xfd\x06\x03\x93\x5f\x92\x90\xdf\x93\xf0\xf7\xc2\xd9\xe7\x63\xfc\xcc\x39\xbf\xdc\x48\x70" +
+		"\x53\xf4\xd7\x92\x08\x8b\x84\x04\xed\x28\xb7\x1b\xd2\x1e\xa4\xa7\x65\xa6\xbe\x45\xe2\xbf\x88\xa2\xfc\xbd\x50\x7e\x08" +
+		"\xca\x33\x30\xce\x89\xc2\x5c\x05\xaa\x07\xff\x08\x73\xf5\x50\x1e\x44\x3b\xb8\x65\x48\x6b\x90\x16\x50\x2b\x77\x11\xea" +
+		"\xe0\x12\x68\x82\x3b\x0a\x7d\xf3\x00\x3b\x88\xe2\xfc\x75\x30\x97\xcc\xbf\x1a\xfa\x76\x01\xdc\x8e\x3a\xf0\xcb\xa8\x9a" +
+		"\x33\xa0\x4a\xfc\x1a\xaa\xe4\xc0\xae\x80\xd7\x41\xd3\x34\xcc\x69\x41\x3e\xae\x15\x12\xa9\xef\x85\x74\x19\xa4\x31\xd4" +
+		"\xc3\x5d\x83\x76\x71\x37\x40\xfa\x27\x54\xc3\x7d\x0d\xd2\x10\x8a\x73\xb7\x41\x79\x2d\xa4\x76\xa0\xed\x45\xad\xf8\x16" +
+		"\xa4\xc0\xb7\xa4\xde\xa4\xe5\x18\xaa\xc2\x55\x68\x3f\xd7\x88\xf6\x63\x6f\xfa\x0f\xb8\x1c\xe9\x71\x10\xc9\x01\x97\x97" +
+		"\x6b\x46\xcd\x5c\x39\x0a\x01\x8f\x2d\x9c\x07\xea\xc5\x90\x6c\x90\x64\x48\xcd\xfd\x00\xca\x10\x6a\xc6\xef\x42\x8a\xa2" +
+		"\x6a\x92\xb8\x34\xd2\x71\xe3\xa8\x95\x5f\x00\xbe\xc6\xd1\x08\x27\x45\x3a\x2c\xca\xfe\xea\xfc\x55\x48\x1f\x83\xfb\xed" +
+		"\x28\x7a\x8e\xac\xf9\x54\x3d\xa4\x15\x84\xd6\xb7\xa1\x7b\x89\x57\xa5\xbe\x92\x52\xa7\x47\xd2\xe3\xa9\x7b\x52\x37\xa5" +
+		"\xde\x4a\x29\x53\x7b\x52\x3f\x59\x7f\x3a\xf5\x58\xba\x34\xf5\x87\xb4\x36\xe5\x4e\x75\xad\xff\x38\xf5\xc6\x7a\x6a\xfd" +
+		"\x6c\x6e\x4a\xbd\x09\xcf\xb3\xa9\x47\x52\x6f\xc2\xfa\x6b\x4b\x57\xc0\xca\xb8\x08\xbc\x37\x94\xea\x4e\x4d\xa4\x9a\x52" +
+		"\xe5\xa9\x9d\x94\xb8\x81\x3d\x51\x74\x29\xfa\x37\xf4\x2e\x0e\xe1\x0b\xf0\x17\xf0\x9f\xb9\x01\xb8\xbd\xbe\xce\x77\xf2" +
+		"\x1f\xe1\xdf\x12\xf5\x88\x2e\x17\x7d\x5b\x6c\x15\xaf\x8a\x6f\x15\x3f\x25\x91\x49\x9a\x24\xa7\x25\x9f\x94\xbc\x28\x49" +
+		"\x49\xcb\xa4\x47\xa5\x8f\xc9\x8a\x65\x09\xd9\x1d\xb2\x9f\xc8\x25\x72\xaf\x7c\xbf\xfc\x3e\xf9\x2f\x14\x46\xc5\xb0\xe2" +
+		"\x26\xc5\x17\x14\xdf\x51\xac\x17\x44\x0b\x2e\x2b\x78\xb0\xe0\xdd\x42\x6f\xe1\x68\xe1\xed\x85\xbf\x56\x96\x28\xa7\x95" +
+		"\x27\x95\x1f\x50\x7e\x5a\xf9\x3d\xe5\x2b\xca\xb7\x55\xe5\xaa\x7d\xaa\xeb\xd5\xd7\xab\xef\x53\xbf\xac\x69\xd2\x9c\xd0" +
+		"\x7c\x59\x5b\xa8\x6d\xd2\x5e\xa9\x7d\x5c\x27\xd3\x19\x74\x76\xdd\x01\xdd\xc7\x75\xbf\xd6\x57\xe8\xb7\xd3\x67\xaf\xfe" +
+		"\x8c\xfe\x21\xfd\x77\xf5\xbf\xd3\xff\xd9\x20\x35\x94\x18\x22\x86\x71\xc3\x3e\xc3\x11\xc3\x29\xc3\xa7\x0d\x0f\x1b\x7e" +
+		"\x69\x2c\x35\x4e\x18\x3f\x64\xbc\xc3\xf8\x15\xe3\x53\xc6\xd7\x4c\x62\x53\xc0\xb4\xdf\x74\xb3\xe9\x73\xa6\xfb\x4d\x8f" +
+		"\x9b\x7e\x64\x46\xe6\x5a\x73\xbb\x79\xc2\x7c\xa1\xf9\x4a\xf3\x4d\xe6\x4f\x99\xef\x32\xff\xab\xf9\xac\xf9\x05\xf3\x2f" +
+		"\x2d\x85\x16\xa3\xa5\xd6\x12\xb0\xb4\x5a\x7a\x2d\x23\x96\x49\xb6\xa6\x6e\x40\x0f\xc3\xb9\xa4\x8d\xfd\x96\x0a\xe5\xfd" +
+		"\xef\x00\x08\x4e\xeb\x5f\x27\x3d\x22\xf2\x7b\xf7\x19\xe1\x77\x79\x22\x39\xfd\x75\xdc\x0c\xab\x93\xff\xd1\xe1\x42\x56" +
+		"\xe7\x51\x3b\xba\x86\xd5\x45\xa8\x1e\x3d\xce\xea\x62\xb4\x80\xde\x60\x75\x09\xf8\xcb\x6e\x56\x97\xa2\x1e\xfc\x01\x56" +
+		"\x97\xa1\x5a\x9c\x19\x2f\x87\x31\xbf\x67\x75\x05\xac\x29\x0b\xab\x17\xa0\x76\x2e\x33\xb7\x10\xfc\xf9\x56\x56\x57\x72" +
+		"\xb7\x70\xcf\xb0\xba\x0a\x05\xc5\x87\x51\x37\x4a\xa2\x55\x74\x1a\x1d\x45\x8b\xe8\x10\x50\x5f\x83\xbb\x9e\x1f\x79\xe1" +
+		"\x2e\x1f\x86\x5a\x1f\xf4\x26\x01\xbe\x84\xe6\xa1\x35\x80\x56\xd0\x2c\x72\x43\xad\x0b\x20\x4b\x50\x8e\x65\x67\x1d\xa3" +
+		"\xad\x79\x28\xe7\x01\xd7\x09\xc8\xe7\x60\xe4\x08\xcc\x5e\x83\x64\x47\xe3\x28\x01\xb3\xc9\xa8\x09\x18\x9f\x80\x79\x64" +
+		"\xf4\x21\x74\x1c\xf0\x24\x60\x86\x9f\xfe\x3f\x07\xe4\x21\x9f\x23\x8c\xc2\xd3\x07\xb5\xcc\xfc\xcc\xec\xcc\xdc\x86\x4d" +
+		"\xb3\xdf\x8b\x8e\x7d\xd3\xd8\x5d\x94\xc3\x63\xd0\x93\x84\x91\xf6\xf3\x50\x5e\x61\x18\x1b\x60\x64\x12\xe6\x1e\x05\xc9" +
+		"\xe7\x51\x33\xd3\x4d\x04\xf2\x26\x28\x61\x6d\x43\xe9\x85\x9e\x83\x50\x36\xa1\x00\x3c\x8d\xd0\x3b\x0b\xb0\xbf\x9e\xf7" +
+		"\x45\xca\x77\x02\xd2\x1a\xc0\xc9\xaf\x16\xe7\xd1\x32\x1d\x73\x04\x60\x49\xa0\x71\x3e\x8b\x6c\xa3\xb2\x10\x3c\xa7\xc1" +
+		"\x9a\x42\x0f\x99\x79\x08\x70\xae\x40\xfe\x5e\x23\x7b\xa8\xd5\x88\x1d\x57\xa8\xd6\xe6\x61\xde\x72\x1e\x94\xd8\xd2\x8e" +
+		"\x0e\xc0\x0c\xfb\x16\xf3\xe7\xf2\xe6\xaf\xb1\xf9\x6e\xea\x15\x6b\x30\xa6\x19\x79\xe0\x39\x49\x1f\x37\x8c\xda\xe0\xdf" +
+		"\x0d\x1c\x25\x61\xac\x07\xda\xf3\x30\xd6\x93\xd5\xba\xe7\x3c\xb3\x97\x37\x51\xdf\xc0\x20\xfc\xfe\x76\x8e\x5a\x95\x68" +
+		"\x5a\xd0\x65\x8c\x8e\x5f\xa3\x7e\x41\xf4\xb7\x06\x58\x88\x3e\xe7\xb3\xda\x5e\x82\x92\x58\x76\x85\x7a\x2e\x91\xf3\x38" +
+		"\xd4\xe7\xa8\x8f\xd8\xe9\xef\xd2\xe7\xe9\xec\x01\x34\x0c\xe5\x28\xa5\xba\x92\x87\x79\x38\x0f\x43\x3d\x40\x36\x7b\x98" +
+		"\x0f\xf8\xf4\xd1\x75\xf3\xd7\x70\x36\x47\xcb\x35\xba\x32\x0f\x00\x57\x6b\x8c\x3f\x01\x67\x82\xe6\x0e\x58\x89\xe3\xd4" +
+		"\xba\xe3\xf4\x57\x9d\x51\xda\x26\x2d\xc2\xc7\x24\xd4\x26\x10\xd9\x4b\x76\x42\x49\xda\x5d\xf4\x7f\x5a\xe9\x02\x9f\x9b" +
+		"\x80\xbe\x5e\x3a\x77\x14\x20\x76\x88\x03\xa3\x00\xed\xa1\x33\x06\x68\x5d\xe8\x8b\xd1\xd5\x3e\x82\xe2\x50\x0e\x41\x0f" +
+		"\x19\x43\x70\xcf\x03\x57\x82\x76\x8e\xd2\xd6\x29\xfa\x2b\x66\xe2\x09\xc7\x28\x8f\x47\xa9\x1c\xe4\x37\xb5\x44\xc3\x42" +
+		"\x34\x20\xb2\xce\x53\x09\xff\x7a\xbd\xda\x41\x47\xc9\x3c\x9b\x1c\xa3\x73\x66\x61\xd4\x41\x3a\xd2\x4e\xd7\xd3\x0a\x5d" +
+		"\x59\x09\xea\x51\x84\xcf\x55\xca\xe1\x32\xd5\x65\xc6\x22\xc7\x98\xfe\xe6\x98\xfd\x97\xa9\x2c\x09\x48\x1b\xfd\xc4\x4f" +
+		"\x4f\xd0\xb9\x2b\xd9\x35\x74\x9a\x45\x02\xe2\x23\x02\x4f\xc2\x9a\x5c\xfb\x0b\xac\xba\x79\x3d\x1c\x03\x8e\x89\x65\x57" +
+		"\x69\xec\x74\x53\xde\x96\xa0\x24\x32\x1e\x82\x7e\xa2\xf9\xe1\xcc\xd9\x2c\x7d\x1b\xf2\xa3\xad\xfe\x6e\x20\xff\xd9\x08" +
+		"\xe6\x11\x8f\xaf\xc7\x37\xe0\x0f\xe1\x0f\xe3\x1b\xf1\x4d\xf8\x23\xf8\xa3\xb8\x10\x7f\x0c\xab\xb0\x1a\x6b\xf0\xc7\xb1" +
+		"\x0e\xdf\x8c\x6f\xc1\x9f\xc0\xb7\xe2\xbf\xc3\x9f\xc4\x9f\xc2\xb7\xe1\xdb\xf1\xa7\xf1\xdf\xe3\xcf\xe0\x3b\xf0\x9d\xf8" +
+		"\xb3\xf8\x73\xf8\x1f\xf0\x5d\xf8\x6e\x7c\x0f\xfe\x47\xfc\x4f\xf8\xf3\xb8\x16\xff\x33\xec\xfb\x5f\xc4\xf7\xe2\x2f\xe1" +
+		"\xfb\xf0\x97\xf1\xfd\xf8\x01\xfc\x15\xfc\x55\xfc\x35\xfc\x2f\xb8\x11\xff\x2b\xfe\x3a\xfe\x37\xfc\x0d\xfc\x4d\xfc\x20" +
+		"\x7e\x08\x3f\x8c\x1f\xc1\x67\xf1\xa3\xb8\x07\x3f\x86\xbf\x85\x1f\xc7\xff\x8e\xbf\x8d\x9f\xc0\xdf\xc1\xdf\xc5\xdf\xc3" +
+		"\xdf\x47\x1a\xfc\x24\xfe\x3f\xf8\x07\xf8\x87\xf8\x29\xfc\x34\x7e\x06\xef\xc2\x93\x78\x0a\xff\x07\xde\x8d\xf7\xe0\x1f" +
+		"\xe1\xbd\x78\x06\xff\x18\x3f\x8b\xff\x2f\x7e\x0e\x3f\x8f\x5f\xc0\x2f\xe2\x9f\xe0\x9f\xe2\x9f\xe1\x9f\xe3\xff\xc4\x2f" +
+		"\xe1\x5f\xe0\x5f\xe2\x5f\xe1\xff\xc2\x2f\xe3\x5f\xe3\x57\xf0\xab\xf8\x35\xfc\x1b\xfc\x5b\xfc\x3b\xfc\x7b\xfc\x3a\x7e" +
+		"\x03\x9f\xc1\x17\xe1\x8b\xf1\x25\xf8\x0f\xf8\x4d\xfc\x16\x7e\x1b\xbf\x83\xdf\xc5\x57\xe2\xab\xf0\xd5\xf8\x1a\x7c\x2d" +
+		"\xbe\x4e\x34\xb2\x73\x78\x98\xeb\x1e\x53\x1c\x49\x0e\x25\x16\x1b\xc8\x7f\xc7\xa0\x5c\x5b\x48\x4e\x2c\x1c\x5f\x39\x44" +
+		"\x5b\xea\xd5\x85\xe4\x78\x62\x79\x75\x21\x91\xa4\x6d\xe9\xd1\xe3\xc2\x28\x56\xba\x8f\x2d\x24\x8f\xae\x49\x97\x18\x74" +
+		"\x29\x17\x5a\x38\x97\xec\x5e\x48\xcc\x25\x28\x48\x97\xdb\x60\xfd\x6b\xc9\xed\x89\xb5\xc4\x11\xa1\x3f\xb7\x21\xf4\xeb" +
+		"\x8f\x1e\xdf\xb7\x94\x38\x72\x64\x21\xb1\x72\xe8\x34\xa3\xaf\x5f\x3a\x17\x56\x70\x3a\x19\x5f\x64\xfc\x6a\x72\xea\xee" +
+		"\xa5\xf9\x63\xc7\x0a\x8f\x2c\x24\x87\x16\x32\xb2\xd1\xc6\xf1\xc4\x9a\xd0\x9a\x5d\x20\x3c\xb1\xd1\xf2\x63\xc9\xf1\x64" +
+		"\xce\xa8\x93\x6c\x8e\x80\x20\xb9\x22\x90\x82\x41\x89\x25\x41\x08\xc5\x5c\xb2\x67\x5e\xe0\x57\xb1\x96\x9c\x60\xcc\xa8" +
+		"\xa8\xfa\x12\x80\x96\x89\xb5\x90\x1c\x01\x12\xcb\xc9\x15\xa8\x09\x84\x56\x92\x23\x82\x9a\xe4\xcb\xc9\x6d\x02\xae\x42" +
+		"\xca\x4a\x72\x5e\xc0\x06\x03\xe6\x85\xe9\x6a\x98\xb4\x7d\xe1\xf8\x5a\x46\xfd\x6a\x60\x66\x2c\x71\x24\xcb\xb4\x60\xac" +
+		"\x24\x6b\x29\x8e\x26\xc7\x8e\xe7\x22\x64\x4c\x14\x9c\x4c\x4e\x26\x18\xc6\x82\x95\x43\xc9\x91\x43\x02\xfd\x42\x81\x57" +
+		"\xa1\x43\x9b\xdb\xa0\xaa\x13\xf4\x93\xd1\x66\xc1\x52\x72\x38\x53\x57\x80\x1a\x18\x21\xe5\x81\x64\x34\xb1\xb8\xcc\x94" +
+		"\xa5\x58\x4d\x6e\x67\xea\x01\x55\x8d\x1d\x3f\xc6\xc0\x60\x15\x66\x5a\xe5\x2a\x95\x28\xa3\xf4\x83\xc9\x18\xd3\x26\xa9" +
+		"\x09\xc4\x0b\xe9\x10\xd6\x50\x2c\x24\xfb\x17\x57\x05\xf8\x12\xb0\x73\x9c\xa1\xd7\xe5\x36\x04\x7f\x91\x27\xbb\x32\x6a" +
+		"\x01\xa5\x27\x8f\x2c\x1c\x3f\xc2\x38\x49\x1c\x4d\x74\x25\x72\xea\xcb\x82\xaf\x6c\x76\x24\x05\xed\xdc\xa8\xf6\xe6\x4c" +
+		"\x11\x4c\xa3\xa7\xf5\xc5\x6d\x44\xe2\x25\x26\xb3\x6e\x03\x76\x3c\xc3\x34\x01\x8d\x0a\x0b\xe6\xf8\xca\xa2\x3f\xd4\xdd" +
+		"\x2d\xbf\x70\xfe\xa8\x40\x45\x96\x5c\x11\x90\xc9\xd6\x4e\x32\xba\x6b\x0b\x47\xe7\xe7\x33\x0a\x39\x7e\x94\xd5\x16\x4f" +
+		"\xb0\x71\xc7\x16\x4f\x31\xb4\xf3\x27\x98\x19\x15\xf3\x8b\x87\x16\xd6\x98\x3f\x2d\x32\x84\xf2\x85\xd3\xab\x0b\xf3\x2b" +
+		"\x80\x56\x0d\x52\x11\xd1\x40\x07\x82\xea\x0e\x82\x8f\x2c\x67\xdc\x01\x9c\x68\xf9\x38\x5b\x03\x60\xb9\xd3\x89\xe5\xa4" +
+		"\xa0\x29\xd5\x2a\x69\xad\xac\x24\x05\xc9\xa4\x2b\x07\x8e\xad\x26\x66\xe7\xa9\x0c\x5e\x6f\x94\x95\xdd\xac\xec\x11\x1d" +
+		"\x48\x2c\xac\x91\x86\xd7\xdf\x13\x91\xaf\x2d\x2e\xcd\xcd\xcf\x26\x97\x0f\xa8\x97\x13\xb3\x47\x93\x2b\x07\xe6\x97\x92" +
+		"\x27\x49\x5b\xbf\xa9\xed\x4e\x2c\xb1\x59\xd1\x6e\x18\xbb\xd8\x9f\x58\x49\x0c\xb1\x35\x69\xda\xd4\x76\xaf\x24\x8e\x1e" +
+		"\x4d\x9e\x54\x00\xb8\x57\xe0\x51\xb3\x51\x75\x1f\x03\x2b\x2c\x69\x73\x00\xc2\xe8\x02\x80\x4c\xb0\xd5\xa6\xcd\xa9\x0b" +
+		"\xe3\x75\xb9\x90\x9c\x09\x47\x17\x37\x26\xb0\x7a\xce\x84\x0c\x44\x98\x40\x98\x80\xe5\xb5\xb6\x96\x38\x99\xc8\xf2\x9d" +
+		"\x07\x10\xa6\x6a\xa0\x0a\x0b\x9f\xac\x58\x26\xdf\x66\x80\x30\xce\x7c\x0e\x58\xa0\xa3\x02\xb4\x6b\x89\xc5\x8c\x19\x8d" +
+		"\xf9\x4d\x36\x48\x09\x06\x04\x4f\xce\xf5\xbd\x01\x41\x94\x8d\x6a\x46\x50\x0a\xc9\xf1\xdb\x81\xc5\x73\xba\x77\xce\x6f" +
+		"\x74\xb3\x3a\xeb\x2e\x14\x40\x39\x4b\x21\xd3\xc8\x70\xb2\x02\xbc\x25\x16\x16\x05\x19\xf4\x99\xd6\xbe\x0d\x0b\x19\x72" +
+		"\x61\x19\x2b\xe4\x03\x99\xa6\xad\xb9\xc0\x3c\xd5\x42\x7c\x58\x04\x65\x09\x3a\xd0\xe5\x36\x04\x65\x52\x0d\xec\x14\x9c" +
+		"\x65\xa3\x2a\xf4\x09\x12\x1e\xdf\x50\xcf\xce\xdc\x99\xe6\x73\xac\x28\xc8\x65\xc8\x93\x8b\x01\x8b\xb6\x10\x8f\x75\x15" +
+		"\x6f\x25\xe5\x96\x7d\xf9\x6e\x65\x3f\xaf\xcc\x6c\x00\x5d\x36\xbd\x5e\x9f\x50\xfa\xc2\x42\xe9\xf7\xb2\x32\xa8\x64\x25" +
+		"\xdb\x96\x85\x56\x98\x41\xc3\xb9\x50\x6f\x2f\x2b\x63\xac\xec\x61\xa5\x9f\x95\x01\x56\x76\xb1\x32\xca\xca\x20\x2b\x43" +
+		"\x0c\x7b\x23\xe3\x85\xc1\x7d\x0c\xee\x8b\xb0\x32\xc3\x6b\x13\x1b\xcf\xda\x42\x14\x01\x38\xa3\xe3\xcb\xd0\x65\x32\xf9" +
+		"\x18\x5e\x7f\x86\x8f\x4c\x9b\xe1\xf5\x66\xf0\x33\xd9\xbd\x19\xfc\x19\xbe\x18\xdf\xbe\x4c\xc9\xf8\xf7\x67\xc6\x31\x7a" +
+		"\xbe\x0c\x1f\x4c\x7e\x1f\xd3\x87\x8f\xe9\xc7\x9f\x99\xc7\xc6\xf9\xd9\x38\x3f\xeb\x0f\x30\x3c\x01\xc6\x67\x90\xd1\x0f" +
+		"\x30\xbe\x82\x99\x92\xc9\x1d\x64\x7a\x0a\x66\xc6\xb3\xf9\x21\x36\x2e\xc4\xc6\x85\x32\x70\x36\x2e\xc4\xe6\x85\x18\xfe" +
+		"\x10\xd3\x53\x88\xe9\x21\xc4\xf4\x13\x6a\x12\xa2\x73\x46\x2f\x21\x26\x7f\x90\xc9\x15\x62\xf2\x04\x33\xbe\x23\xc0\xbd" +
+		"\x5d\x6c\x7c\x40\x68\x07\x02\x8c\x8f\x80\x4f\xc5\xca\x3c\x17\x0c\x36\x2a\x59\x29\xac\x1c\x55\xa6\x95\x37\xa8\x89\x0d" +
+		"\x6a\xca\x1b\xd4\x94\x3f\xa8\x8b\x0d\xea\xca\x1b\xd4\x95\x3f\x28\xca\x06\x45\x85\x41\x0c\xda\xcd\xa0\xdd\x79\x53\xbb" +
+		"\xf3\xa7\x46\x18\x38\x92\x0f\xce\xd8\x2f\xc8\xe4\x0b\xe6\x75\x07\x42\x0c\x1c\xca\x07\x87\x19\x38\x9c\x0f\x66\x34\x02" +
+		"\x9b\x68\x30\x5f\x09\x30\x11\x03\x5d\xb9\xdc\x07\x98\x0a\x03\x8d\x79\x50\xa6\xb3\x00\xd3\xd9\xff\x03\x5c\xe2\x50\x0c" +
+		"\xc0\x55\x00\x00")
+
+func gzipBindataDataFontsNotosansthairegularttf() (*gzipAsset, error) {
+	bytes := _gzipBindataDataFontsNotosansthairegularttf
+	info := gzipBindataFileInfo{
+		name:        "data/fonts/NotoSansThai-Regular.ttf",
+		size:        21952,
+		md5checksum: "",
+		mode:        os.FileMode(420),
+		modTime:     time.Unix(1792430466, 0),
+	}
+
+	a := &gzipAsset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _gzipBindataDataFontsOfltxt = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa5\x57\xef\x8f\x9b\x48\x12\xfd\x8e\xc4\xff\x50\x9a\x0f\xab\x1d\x89\x99\x6c" +
+		"\x72\x77\x7b\x52\xbe\x11\x1b\xcf\xa0\xf5\x60\x2f\x66\x92\xcb\x47\x0c\x6d\xbb\x37\x40\x73\x4d\xe3\x89\xff\xfb\x7b\xd5" +
+		"\x0d\xfe\x35\x93\xd3\xdd\x6e\x12\x29\xb8\xe9\xae\x7a\xf5\xaa\xea\x55\x33\x51\xed\x41\xcb\xed\xce\xd0\x87\x5f\xde\xff" +
+		"\x4a\x0f\x4a\x6d\x2b\x41\x71\x53\xdc\x53\x58\x55\x94\xf2\xab\x8e\x52\xd1\x09\xbd\x17\xe5\xbd\x97\xed\x64\x47\x33\xd5" +
+		"\x18\x5a\xa9\x8d\x79\xc9\xb5\x20\x2c\x54\xb2\x10\x4d\x27\x4a\xea\x9b\x52\x68\x32\x3b\x41\xab\x78\x4e\x8b\x56\x34\x6e" +
+		"\xf3\xdc\x6d\x08\xe8\xb3\xd0\x9d\x54\x0d\xbd\xbf\x7f\x7f\xef\x3b\x6b\xc3\x61\xb6\x53\xa8\x56\xc2\xca\x5a\x54\xea\x25" +
+		"\xa0\xbc\x29\x79\x31\xaf\x3a\x45\xf9\x3e\x97\x55\xbe\x06\xb6\x17\x69\x76\x94\xd3\x2c\xfc\x9d\x72\xf3\xd1\xf7\x76\xc6" +
+		"\xb4\x1f\xdf\xbd\xeb\x0a\x2d\x5b\xd3\xdd\x77\xb2\xba\x57\x7a\xfb\x6e\x31\x9b\xfb\x1e\xff\xbd\xfb\xf3\x7f\x7c\xcf\x46" +
+		"\xb1\x8c\x12\x9a\x2d\x92\x8c\xe6\xf1\x24\x4a\x56\xd1\x79\x10\x74\x47\x1f\x7e\xa5\x99\x58\xeb\x3e\xd7\x07\x90\xf8\xcb" +
+		"\x3f\xff\xa2\x4b\xdf\x5b\xa6\x51\xf8\xf4\x69\x1e\x31\x3f\x82\xb6\x0a\x04\x90\xda\x58\x56\x5f\x31\x4a\x3f\x23\xd0\x5b" +
+		"\xe2\x3c\x18\x45\x9d\x91\x75\x5f\xe5\x06\x2c\x29\x5d\x95\x2f\xb2\x14\xbe\x57\x8a\x3d\xf8\x6c\x6b\x81\x53\x30\x53\xa8" +
+		"\x0a\x44\x2a\x9d\x1b\xb9\x17\xb4\x61\x5b\xad\x56\x7f\x88\xc2\x74\x81\xb5\xd1\xb7\xad\xd2\xc6\xba\xb3\x6f\x0b\x2d\xb0" +
+		"\x57\x35\xbe\x27\x36\x1b\xbc\xb1\x60\xf2\x22\x2f\x45\x2d\x0b\x9b\xa4\x4a\x36\xdb\x5e\xc2\x79\x01\xeb\x75\xdd\x37\xd2" +
+		"\x48\xd1\xb9\x04\xc2\x22\xcc\xef\x81\x04\x49\xdb\x68\x21\x78\xd5\xf7\x14\x07\xb2\xd1\x79\x2d\x80\xf4\x1b\xc9\x86\x5e" +
+		"\x76\xb2\xd8\x59\x8f\x1d\xd5\xf9\x01\x45\x40\xdd\x0e\x71\x95\xae\x0e\x6a\xb6\x82\x1f\xd8\xd9\xe6\xda\x34\x48\xc1\x4e" +
+		"\xb6\xbe\x67\xcb\x41\x01\xac\xee\xee\x99\x3c\xa6\x0c\x94\xa0\x6a\x50\x43\x9d\x8d\xe2\x58\x9d\xce\x38\x10\xc1\x76\x8f" +
+		"\x85\x00\x84\xf5\xa5\xe4\x87\x5a\x95\x72\x23\x9d\x33\xdf\x83\x57\x84\xa3\xe5\xba\x37\x7c\x0c\xa8\xab\x03\xe5\x28\x55" +
+		"\xd5\x6c\xf9\x7f\x58\x3d\x58\xce\x1b\x65\xa8\x53\x15\x4a\xf6\xc0\x8b\x75\x27\xaa\xbd\xe8\xee\x09\x28\x7c\xcf\xba\x0b" +
+		"\x80\xb8\xa8\xe0\x85\x4f\x36\x07\x42\x7f\xc8\xbd\xe3\x9e\x23\xc7\xfb\x22\x6f\x18\xd0\x1a\xbd\x53\x31\x14\x51\xaf\x45" +
+		"\x59\xf2\xd3\x35\x10\x60\x7b\xa7\xb4\x73\xe8\xda\x00\x06\xbb\xb1\x0f\x07\x9a\x41\xf9\x2e\x37\xf6\x95\x1e\xda\xd6\xf7" +
+		"\x1a\x10\xdd\x1d\x11\x73\xec\x8c\xf8\x1a\x8b\xc5\x3d\xb0\xc4\xa4\x9f\xde\x77\x01\x1a\x4d\xbd\xa0\x92\xb4\x05\xcc\x56" +
+		"\x80\x59\x8b\x4a\xe4\xa7\xbe\x67\x9f\x36\x15\x64\x0e\xad\xe0\x32\x19\xa8\x1f\x08\xd1\xe2\xdf\xbd\xd4\xc2\x56\x22\x2a" +
+		"\xe9\x94\x0f\xac\xe5\x48\xec\xa8\x1e\x67\xa2\x50\x2a\xe0\x66\x6f\x79\xdb\x56\x07\xdf\xc3\x66\xcb\xa2\x2a\x7a\x6b\xc6" +
+		"\x16\x27\x03\xe8\x98\x60\x73\x84\xaf\xac\x0a\x49\x7d\x1e\x83\xad\x8f\x69\x34\x8b\x93\x38\x8b\x17\xc9\xca\xf7\x6e\x2e" +
+		"\x94\xec\x06\x38\x36\xa8\x23\x46\xc4\x96\x3a\x61\x1b\x66\x23\x2b\x60\x38\x86\xea\x32\x4d\x93\x51\x38\x7d\xef\x11\xf9" +
+		"\x10\xfa\xe7\xee\xf6\xad\x00\x98\xc7\x02\x47\x35\x2a\xa8\xce\xf5\x37\x4e\x63\x87\x26\x2b\x76\x4c\x8a\xb4\xb5\xee\x7b" +
+		"\xae\x46\xe0\x52\xf5\xba\x10\xce\x65\x80\x92\x90\xc8\xf4\xa0\x6c\x2e\x23\x43\xdc\xb6\x21\x6d\x3c\x37\xa3\x36\x3b\x59" +
+		"\x48\x90\xe8\xf3\x38\x98\x2c\x97\xfc\xae\x15\xc5\x50\xe2\xce\x3f\xe5\x1b\xe3\xc4\xda\xf7\x8a\xe3\x18\xe8\x60\xdb\x66" +
+		"\x08\xf1\x38\x07\x0b\xbc\x90\x4d\x5e\x8d\xb2\x77\x4d\x13\x0b\x0a\xd4\x83\x05\x11\x6c\x5d\xce\x06\xc8\x41\xab\x1a\x61" +
+		"\x0b\xaa\x83\x14\x9d\x15\xf3\x35\x8f\x74\xa4\xd1\xb9\x7d\x1a\x3b\xf2\x0d\xb7\x57\x8d\x54\x43\x8b\xd8\x5e\x5e\xda\x36" +
+		"\x33\x2a\xc0\xdb\x4a\x18\xfc\x40\xdd\x72\xc7\xf4\x6b\xa8\x93\xe9\x79\x85\xee\xee\x46\x11\xe1\x2a\xb1\xca\xa3\x30\x57" +
+		"\xb0\x6c\xeb\x77\x33\x04\x75\x04\xee\x56\x7c\xef\x9a\x87\x80\x5d\x16\xbb\xbc\xd9\xb2\x55\xd4\x73\x9d\xbb\xc2\xc3\x32" +
+		"\x2b\xe8\x58\x90\x97\x8c\x30\x7a\xf4\xa3\x78\x21\xd1\xec\xa5\x56\x0d\x73\xed\x22\x0e\x7b\xb3\x53\xfa\x75\x9c\x9d\xdc" +
+		"\x36\xdc\x77\x82\x3d\x09\x7e\x42\xab\x6f\xa1\x9d\x35\x3f\x1b\x51\xec\x1a\x59\xe4\x15\xb4\x50\x4b\xce\x28\x20\xb8\x2e" +
+		"\x6c\x61\x46\xd9\xf8\x10\x4f\x73\x64\x7e\xc8\xdb\x05\x2e\x8b\x60\x19\xa5\x4f\xf1\x6a\x85\xde\xa0\x9f\x68\xb2\x48\xa6" +
+		"\x63\x9f\x2c\x85\xae\x65\x67\x67\x1e\x2a\x16\xa6\x05\x62\x04\x82\xc6\xb0\x4c\x59\x55\xe7\xc9\x02\xb9\xde\x8a\x60\x04" +
+		"\x3e\x78\x57\x6b\x83\xde\x06\x19\xbe\x97\xf3\x80\x3f\x52\x7c\xe1\xdf\x9e\xea\xf9\x86\xc0\x92\x7c\x08\xec\x4e\xc8\xb2" +
+		"\xb0\x16\xad\x24\x0e\x22\x7d\x08\x2e\x65\xd1\x8d\x19\x08\x6f\x75\x21\xe2\xe8\xc5\xe3\x4f\x7b\xad\xe8\xce\xfd\x62\xb2" +
+		"\x1f\x1d\xa3\x38\x78\xfa\x8d\xb4\x6c\x14\x4f\x0e\x4e\x1e\x38\x2b\x25\xd7\x75\xf7\x91\xd9\x79\x7f\x4b\x89\x90\x4e\xde" +
+		"\x5e\xa5\xb5\x51\x7a\x2c\x1f\x89\x2a\x90\x38\x09\x31\xee\x51\x2d\xa7\x4a\x0a\xb8\xcf\xe9\x58\x46\x38\x71\x5d\xe3\xe8" +
+		"\xf9\x71\xf4\x0d\x43\x05\xc6\x44\xb5\xb1\xd9\xf9\x70\xfb\xdf\xcf\xbe\xc9\xeb\x68\x6f\x9c\x2e\xff\xd7\x48\x09\xae\x66" +
+		"\x8a\xc8\xa1\x1a\x9c\x18\x16\x8c\x86\xd3\xea\x06\x2c\xae\x13\x7b\x41\x27\x0d\x81\x5e\x43\xfd\xdc\xf8\x3f\xd3\x42\x3b" +
+		"\x05\x20\x89\x6e\xe0\x1d\x45\xaf\xa4\x81\x55\xd6\x25\x83\x43\x77\x39\xe6\x2c\x3a\x45\x7c\x37\xa3\x12\xee\xfa\x3a\x6f" +
+		"\xee\xa0\xf5\xa5\xbd\x03\xee\xf0\xc0\x2d\xa2\xb4\xa5\xd4\x62\x68\x81\xb5\xd5\x92\x2f\x3f\x35\x70\xa2\x53\x4e\xfb\x6b" +
+		"\x61\xf0\x64\x70\x01\x91\xa2\x2a\x3b\x1b\x29\x9f\x63\x0f\x6c\x63\x0d\x52\x71\x7b\x73\x4a\x7f\x31\xe7\x55\x27\xc6\x43" +
+		"\xc3\x9c\xc6\x14\x90\xd0\xf2\xbd\x14\x2f\x27\x15\x43\xe5\x6a\x9b\xa4\xbf\xa1\x48\xd4\xab\xdc\xfc\x38\x35\x38\x68\xdf" +
+		"\x5c\xa8\xb8\xef\xb1\x8c\xbb\x81\x02\x44\x1d\x89\xef\x2d\x38\x94\x86\xb8\xc3\x0d\x6e\x4e\xed\x45\x43\x0e\x9d\x38\x82" +
+		"\x29\x94\xc6\xe4\x6f\xb9\x7a\xb9\xeb\xae\x15\x76\x98\x3b\xd8\x82\x2a\x18\x74\xbb\xe1\xfb\x0d\x26\x2c\xb7\xc9\xd0\x07" +
+		"\xe0\xb2\xb6\xa4\x30\x66\x1e\x21\x56\xc1\x5b\xbe\x53\x34\x67\x2a\xc2\x91\xbb\xc9\xfa\xf7\x5b\x7b\x7d\x68\x06\xe8\x43" +
+		"\xc8\x6f\xe8\xfb\x30\x9c\xc9\xe9\xdd\xd9\xde\xcb\xce\xe4\xeb\x1f\x9a\x7a\xb8\x6a\xd8\x3b\x8b\xbb\x4c\xd6\x8a\xbb\x5e" +
+		"\x34\xa5\xd2\x60\x8f\x1b\xaf\xc4\xbd\xc4\x48\x3b\x6d\x51\x9b\xd7\xec\x63\xef\xf7\x42\xb4\xb6\xc3\xf3\xe2\x5b\xa3\x5e" +
+		"\xd0\x09\x5b\x31\x70\x35\xe8\x22\xf6\x9d\x90\xbc\x26\x8d\xdf\xb9\x7a\xbe\xc0\xad\x5d\xd3\xb8\xab\xc6\x75\x96\xc0\xd6" +
+		"\x31\x4d\x96\xa1\x7f\x38\x86\xae\x84\xef\x28\x53\xb0\x76\x12\xad\xe0\xad\x21\x85\xf6\xad\xfb\xce\xf2\x71\xde\xc4\x48" +
+		"\x08\xae\x55\x48\xe1\xeb\xfb\x87\x53\x47\x7b\xc8\x11\x79\x39\x84\xaf\xef\x6d\xe7\x9d\x4a\x3f\xba\xae\xb1\x86\xfc\x6f" +
+		"\xf7\x35\xfa\xc1\x75\xcd\xf7\x4e\xf7\xb5\xd7\x63\x28\xe3\x31\x94\x84\x3c\x7b\xae\x3e\x11\xd7\x02\x6a\xca\x0e\x7a\x14" +
+		"\x06\x07\xb6\x57\x12\x9f\x04\x9b\xf3\xc9\x3d\x8a\xd1\xa8\xdd\x7c\xeb\xc5\xc0\x05\x24\xa8\x80\xbb\x02\xc6\xab\xc9\x3c" +
+		"\x8c\x9f\xa2\x14\xe6\x1f\x23\xf7\x6d\xb7\x5a\xcc\xb2\x2f\x61\x1a\x51\xbc\xa2\x65\xba\xf8\x1c\x4f\xa3\x29\xdd\x84\x2b" +
+		"\xfc\xbe\x09\xe8\x4b\x9c\x3d\x2e\x9e\x33\xc2\x8e\x34\x4c\xb2\xaf\xf8\xc4\xa0\x30\xf9\x4a\xbf\xc5\xc9\x14\x49\x89\xfe" +
+		"\x85\x6f\xb6\xd5\x8a\x16\x29\xc5\x4f\xcb\x79\x1c\x4d\x03\x8a\x93\xc9\xfc\x79\x1a\x27\x0f\xf4\x09\x07\x93\x05\x7f\x3e" +
+		"\x3e\xc5\x19\xac\x66\x0b\x7b\x76\xb0\x15\x47\x38\x37\x43\xd5\x46\xe9\xe4\x11\xbf\xc3\x4f\xf1\x3c\xce\xbe\x06\x34\x8b" +
+		"\xb3\x84\x8d\xce\x60\x35\xa4\x65\x98\x66\xf1\xe4\x79\x1e\xa6\xb4\x7c\x4e\x97\x0b\x7c\x86\x86\xc9\x14\x76\x93\x38\x99" +
+		"\xa5\x70\x13\x3d\x45\x49\x86\x5b\xca\x0c\x93\x7b\xf9\x35\x8d\x1f\x1e\xb3\x00\xa7\x32\xac\x06\x94\xa5\xe1\x34\x7a\x0a" +
+		"\xd3\xdf\x02\xc6\xb8\x40\xd4\x29\xd9\x2d\xf7\xc0\x09\x23\x14\x7d\x8e\x98\x84\xc7\x70\x3e\x27\xbc\x45\x03\x8c\x46\xe8" +
+		"\x71\x31\x9f\x62\xfb\xa7\x08\x01\x84\xf8\x2c\x75\x80\x10\x80\x25\x31\xa0\x69\xf8\x14\x3e\x44\xab\x93\x61\xde\xe6\x62" +
+		"\xf0\xbd\x13\x0b\x7c\xe2\x21\x4a\xa2\x34\x9c\x07\xb4\x5a\x46\x93\x98\x1f\xc0\x5f\x9c\x46\x93\xcc\xf2\x05\xce\x11\xff" +
+		"\xdc\x62\xc4\xf5\x63\x15\xfd\xfe\x8c\x05\xec\x43\xce\x9c\x13\x64\xe2\x31\xb2\x4e\x00\x3b\xc4\xbf\x09\x57\x09\xd9\xa0" +
+		"\x13\x04\xc9\x86\xb2\x45\x9a\x1d\xc1\x7c\x89\x57\x51\x40\x61\x1a\xaf\x80\xc1\xf7\x66\xe9\x02\x88\x39\x93\x38\xc2\xb9" +
+		"\x7f\x06\x8f\x9c\xb5\x64\x80\xcc\xc9\xe1\xb5\xd7\x75\x81\x5d\x7c\x1a\x0c\x5b\xff\xd3\x28\x9c\xc3\xe4\x8a\x81\xbc\xda" +
+		"\x8c\x32\xfb\x0f\x5c\x1a\x12\x1b\x59\x11\x00\x00")
+
+func gzipBindataDataFontsOfltxt() (*gzipAsset, error) {
+	bytes := _gzipBindataDataFontsOfltxt
+	info := gzipBindataFileInfo{
+		name:        "data/fonts/OFL.txt",
+		size:        4441,
+		md5checksum: "",
+		mode:        os.FileMode(420),
+		modTime:     time.Unix(1792430466, 0),
+	}
+
+	a := &gzipAsset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
 var _gzipBindataDataGraphqlplaygroundhtml = []byte(
 	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5c\x69\x6f\xdb\xb8\xd6\xfe\x9e\x5f\xc1\x57\x45\xd1\x14\x88\x68\x92" +
 		"\xda\x3d\x76\xf0\xb6\x9d\xdc\xb4\x98\xcc\x9d\xce\x82\xf6\xce\xfd\xa6\x48\x94\xcd\x44\xa6\x5c\x89\xde\x3a\x98\xff" +
@@ -173,27 +681,116 @@ func gzipBindataDataGraphqlplaygroundhtml() (*gzipAsset, error) {
 }
 
 var _gzipBindataDataOpengraphtemplatehtml = []byte(
-	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\x51\x6b\xc2\x30\x10\xc7\xdf\xfb\x29\xb2\x3c\xaf\x6a\xbb\xa9\x43" +
-		"\x12\x61\xe8\x84\xc1\x60\x63\x28\x6c\x4f\x23\x36\x67\x7b\x90\xa6\x59\x3c\x15\x29\xfd\xee\xa3\x55\xb0\x8a\xee\x29" +
-		"\xe4\xfe\xff\xdf\x8f\x7b\x38\x71\x37\x7d\x9f\xcc\xbf\x3f\x5e\x58\x46\xb9\x19\x07\xa2\x7e\x98\x51\x36\x95\x1c\x2c" +
-		"\xaf\x07\xa0\xf4\x38\x60\x4c\xe4\x40\x8a\x25\x99\xf2\x6b\x20\xc9\x17\xf3\x59\xf8\xc4\x4f\x81\x55\x39\x48\xbe\x45" +
-		"\xd8\xb9\xc2\x13\x67\x49\x61\x09\x2c\x49\xbe\x43\x4d\x99\xd4\xb0\xc5\x04\xc2\xe6\x73\xcf\xd0\x22\xa1\x32\xe1\x3a" +
-		"\x51\x06\x64\xd4\xe9\xb5\x44\x19\x91\x0b\xe1\x77\x83\x5b\xc9\xbf\xc2\xc5\x73\x38\x29\x72\xa7\x08\x97\x06\x5a\x56" +
-		"\x04\x09\x3a\x85\x16\xe7\x7c\xe1\xc0\xd3\x5e\xf2\xd5\x72\xa4\x9c\xfb\x41\xdd\xea\x47\x0f\xc3\x7e\x14\x0d\x7b\x8f" +
-		"\xf1\xa0\x3f\x88\xe3\xf8\x2a\x58\xa4\xa3\x8d\x37\x2d\xaa\x2c\x3b\x8b\xcf\xb7\xaa\xba\xd5\xa6\xbd\x83\xf3\xfa\x7c" +
-		"\xef\xe0\x9f\x3e\x92\xb9\x04\xea\xd1\x6d\x42\xc3\x3a\xf1\xe8\x08\x0b\x7b\xce\x4d\x4f\xc1\x91\x2e\x4b\x5c\xb1\xce" +
-		"\x0c\x14\x6d\x3c\xe8\xd7\x5c\xa5\x50\x55\x01\x63\x57\xbd\x58\xc7\xe7\xc6\x0b\xf2\xe8\x04\xab\x1b\x8b\x68\x76\x1f" +
-		"\x9f\x36\x16\xdd\xc3\x24\x10\xdd\xc3\x89\x88\x6e\x73\x42\x7f\x01\x00\x00\xff\xff\xb1\x8c\x16\x0a\x52\x02\x00\x00" +
-		"")
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x94\x51\x6f\xd3\x30\x10\xc7\xdf\xf7\x29\x8c\xc5\x1b\xa4\x5d\x0b\xdb\x50" +
+		"\x95\x54\x9a\x56\x26\x81\xc6\x36\xb1\x54\x82\xa7\xca\x8d\xaf\xc9\x81\xe3\x04\xe7\xda\xaa\x8a\xf2\xdd\x71\x92\x96\x26" +
+		"\x69\x0b\x03\xf1\xe4\xe4\x7c\xff\xdf\xd9\x77\xe7\x73\x5f\x4c\x1e\x6e\xfc\xaf\x8f\xef\x59\x44\xb1\x1a\x9f\xb9\xe5\xc2" +
+		"\x94\xd0\xa1\xc7\x41\xf3\xd2\x00\x42\x8e\xcf\x18\x73\x63\x20\xc1\x82\x48\x98\x0c\xc8\xe3\x53\xff\xd6\x79\xc7\xf7\x1b" +
+		"\x5a\xc4\xe0\xf1\x15\xc2\x3a\x4d\x0c\x71\x16\x24\x9a\x40\x5b\xc7\x35\x4a\x8a\x3c\x09\x2b\x0c\xc0\xa9\x7e\x5e\x33\xd4" +
+		"\x48\x28\x94\x93\x05\x42\x81\x37\xe8\x9d\x37\x40\x11\x51\xea\xc0\x8f\x25\xae\x3c\xfe\xc5\x99\x5e\x3b\x37\x49\x9c\x0a" +
+		"\xc2\xb9\x82\x06\x15\xc1\x03\x19\x82\xd5\xe5\x39\x41\x9c\x2a\x41\xc0\x78\x09\x90\x82\x04\x67\xbd\xa2\x28\x89\x84\xa4" +
+		"\x60\x9c\xe7\x3d\xbf\xfc\x28\x0a\xb7\x5f\x5b\xce\xdc\x7e\x7d\x2f\xbb\x56\xf7\xb6\x18\x09\x0b\xd4\x4d\x48\x8d\x50\xa8" +
+		"\xbf\x33\x03\xca\xe3\x81\xd0\x89\x46\x7b\x64\xce\x22\x03\x0b\x8f\x5b\xee\xf4\xf3\x5d\x51\x1c\xa4\x41\x42\x16\x18\x4c" +
+		"\x09\x13\xdd\x38\xb3\x75\x9f\xec\x37\x5a\xb2\xd4\x24\x29\x18\xda\x78\x7c\x31\x1f\x89\x34\x9d\xa1\x6c\x08\x07\x6f\xae" +
+		"\x2e\x06\x83\xab\xf3\xb7\xc3\xcb\x8b\xcb\xe1\x70\x78\x54\x98\x84\xa3\x0c\x09\x66\xe5\x09\xda\x41\x9f\xac\xf9\xde\x5a" +
+		"\x4f\x44\xb4\xc2\xa5\x51\x6d\x49\xf7\x5a\x2d\x6f\xda\xa4\x9d\x08\xbe\xb5\xfc\xc6\xbf\x4c\x79\x47\x50\x97\xe3\x94\xe2" +
+		"\x2f\xf2\x97\xe7\xb8\x60\xbd\x5b\x10\xb4\x34\x20\x3f\xc4\x22\x84\xaa\x6e\x47\xb9\x58\x6e\xb7\x89\x1d\xe5\x96\x09\x5a" +
+		"\x56\x94\x9a\xfe\xb8\x9c\x2b\xcc\x22\x90\x3e\xc6\xa7\xe8\xc2\x10\x06\x0a\x46\xe9\xce\x77\x46\xd8\x2d\x44\x87\x73\x34" +
+		"\xd6\xa7\x44\xe2\x02\x9f\x15\x2a\xde\xba\x1e\x89\xd4\xa6\x1c\x04\x32\xf6\x7d\x03\xeb\xf9\x22\xcc\xfe\x10\x83\x44\xd8" +
+		"\x26\x1f\xd0\x9a\x7d\x4f\x6b\x24\x02\x33\x0a\x84\x69\xf6\x6f\xb6\x8c\x63\x61\x36\x33\x25\x4c\x08\xb3\xba\x08\xe3\x13" +
+		"\xd2\x67\x35\x4b\x5b\xf2\x1f\xbb\xa5\x0d\xfe\x87\x76\xd9\x66\xf6\x5a\x59\xbd\xb6\x33\xe9\x57\x7e\xf7\x63\x44\xec\xf6" +
+		"\x38\x2b\x5f\x52\xf3\x01\x75\xe7\x0a\xab\xb2\x51\x1a\x5e\xb6\xb2\x70\x24\xe2\xc7\xa7\x87\xfb\xbb\xc9\x2e\x5a\x7d\xef" +
+		"\x2d\xdf\xce\x13\x65\xc7\x56\x99\x86\xbe\x92\xaf\xbe\x65\x36\x4f\xe3\xaa\x94\x6e\xbf\x76\x6c\x32\x77\xeb\x4f\xaa\xb6" +
+		"\x11\x0f\x1a\x06\x00\x00")
 
 func gzipBindataDataOpengraphtemplatehtml() (*gzipAsset, error) {
 	bytes := _gzipBindataDataOpengraphtemplatehtml
 	info := gzipBindataFileInfo{
 		name:        "data/opengraph-template.html",
-		size:        594,
+		size:        1562,
+		md5checksum: "",
+		mode:        os.FileMode(420),
+		modTime:     time.Unix(1792429964, 0),
+	}
+
+	a := &gzipAsset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _gzipBindataDataPrerendertemplatehtml = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x85\x54\x4d\x6f\xdb\x30\x0c\xbd\xf7\x57\x68\xda\x75\x89\xd7\x9e\x76\xb0\x0d" +
+		"\x14\xd9\x8a\x0e\xd8\xda\x02\x4d\x81\xed\xc8\xd8\x8c\x2d\x4c\x92\x3d\x59\x49\x5b\x78\xf9\xef\xa3\x24\x7f\xb6\x69\xea" +
+		"\x8b\x45\x52\x7c\x7c\x24\x45\xb6\x6d\x8e\x5b\xa1\x91\xf1\x12\x21\x47\xc3\x0f\x87\xf8\xc3\xd7\xdb\xd5\xfa\xf7\xdd\x37" +
+		"\x56\x5a\x25\xd3\xb3\xd8\xfd\x98\x04\x5d\x24\x1c\x35\x77\x0a\xba\x9a\x9e\x31\x16\x2b\xb4\xc0\xb2\x12\x4c\x83\x36\xe1" +
+		"\x0f\xeb\xab\xc5\x17\x3e\x1a\x34\x28\x4c\xf8\x5e\xe0\x63\x5d\x19\xcb\x59\x56\x69\x8b\x9a\x2e\x3e\x8a\xdc\x96\x49\x8e" +
+		"\x7b\x91\xe1\xc2\x0b\x9f\x98\xd0\xc2\x0a\x90\x8b\x26\x03\x89\xc9\xf9\xf2\xf3\x04\xa8\xb4\xb6\x5e\xe0\xdf\x9d\xd8\x27" +
+		"\xfc\xd7\xe2\xe1\x72\xb1\xaa\x54\x0d\x56\x6c\x24\x4e\x50\x05\x26\x98\x17\x48\x7e\x6d\x6b\x51\xd5\x12\x2c\xa5\xe5\x00" +
+		"\x72\xb0\xc0\xd9\xf2\x0e\x0a\x3c\x1c\x08\xb5\x6d\xc5\x96\x44\x83\x7b\xca\x56\x0a\xfd\x87\x19\x94\x09\xaf\x49\xc1\x59" +
+		"\x69\x70\x9b\xf0\xb6\xed\xec\x3c\x6d\x5b\xd4\xf9\xc4\xef\x06\x9f\xec\xcc\x4f\x93\x62\xe2\x17\xec\x53\xbf\xd8\x0a\x2b" +
+		"\x31\x75\x98\x44\x61\xb9\x76\x12\x21\x44\x41\x7d\x16\x47\xa1\xa0\xf1\xa6\xca\x9f\x7d\xd6\xa1\x17\xee\x48\x02\x74\xd0" +
+		"\x11\x1f\x10\xee\x85\xc5\x1b\xaa\xae\x03\x01\xef\x11\x8d\x2e\xb1\x02\xa1\x5d\x11\x42\x78\x3a\xf4\x2d\xde\x56\x95\xf5" +
+		"\x2d\xf6\x1e\xe1\x1a\x9d\x82\xfa\x65\xb4\x73\x9e\x5e\x9a\xac\x14\x7b\xec\x62\x4c\x8d\x0d\x11\x50\x50\x2f\x9f\x94\xe4" +
+		"\xe9\x7d\x10\x06\x2a\x3d\x5e\x1c\x85\x84\x88\x9c\x7f\x48\x47\x18\x35\x3b\xa5\xc0\x3c\x07\x4a\x2e\x80\xb1\x22\x73\x35" +
+		"\x61\xfe\x8b\xcb\x8b\x74\x08\xea\x92\x47\xa3\xc0\x15\x3e\xd4\x77\xac\x24\xa4\x14\xe4\x62\x70\xb3\x42\x21\xa3\xae\xa3" +
+		"\x3b\x04\xcf\xdd\x46\x8a\xa6\xc4\xfc\xd2\xb2\x7f\x83\xa9\x83\x79\x6d\x0c\xdd\x51\x1d\x11\xc2\xef\x79\x1d\xc9\xa1\xae" +
+		"\x1a\x4b\x09\x4c\x1f\x5d\x37\x4b\x6c\xf9\x66\x5e\xe7\x3e\x2e\x79\x8e\x39\x90\xee\x04\x7f\x77\xf5\x74\x12\xc7\x6f\xcc" +
+		"\x33\x21\x6c\xa1\x0a\xd6\x98\x2c\xa0\xba\xc7\x74\x85\x60\x77\x06\xf3\xef\xca\xcf\x07\x67\x20\xed\x18\xb3\xa3\xc7\x07" +
+		"\xff\x5c\xec\x5d\xb8\xeb\xf5\xcf\x1f\x0e\xdb\x89\xbd\x69\x27\xfb\xa3\x9b\x15\x43\x1b\x03\xd9\x72\x45\x24\x8a\xca\x08" +
+		"\x6c\xfc\xd0\xcc\xfb\x09\xb6\xec\xe8\x8f\xcf\x39\x8e\xe8\xd6\x38\x3c\x2f\xe0\xd6\x50\x9c\x00\xfa\xf8\x1e\x52\x1c\xf5" +
+		"\x24\x67\x4d\x1d\x5b\xd7\xcd\x88\x6b\xdd\x91\x5e\x53\x71\xdf\xeb\x75\x58\x12\xd7\xa4\x15\xba\x20\x1e\xa1\xd5\xa3\x1c" +
+		"\x79\xc5\xc8\x69\xc8\xcc\x95\xbb\x99\x63\xf7\xe3\x31\x65\xe3\xb9\x6b\x18\xaa\x3e\x5b\x66\xf0\x6a\x7d\x4d\x56\x5b\x7a" +
+		"\x83\x8f\x68\x98\x7b\xaf\x8d\x2b\xcf\xbc\x32\xb3\xe5\x06\xaf\xd6\xd9\x64\xd5\xa5\xb7\x32\x7f\x0b\x27\x8e\x3c\xb5\x77" +
+		"\x2a\xfa\x1f\xf1\x38\xc5\x4a\x76\x06\x00\x00")
+
+func gzipBindataDataPrerendertemplatehtml() (*gzipAsset, error) {
+	bytes := _gzipBindataDataPrerendertemplatehtml
+	info := gzipBindataFileInfo{
+		name:        "data/prerender-template.html",
+		size:        1654,
+		md5checksum: "",
+		mode:        os.FileMode(420),
+		modTime:     time.Unix(1792429793, 0),
+	}
+
+	a := &gzipAsset{bytes: bytes, info: info}
+
+	return a, nil
+}
+
+var _gzipBindataDataStaticsitetemplatehtml = []byte(
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x85\x54\xcb\x6e\xdb\x30\x10\xbc\xfb\x2b\x58\xf6\x5a\x4b\x75\x4e\x3d\x48\x02" +
+		"\x82\xb4\x41\x0a\xa4\x49\x50\x3b\x40\x7a\x64\xa4\xb5\x44\x94\xa4\x54\x8a\x56\x12\xa8\xfe\xf7\x2e\x1f\xb2\x2c\xd7\xb1" +
+		"\x7d\x31\x77\x39\x3b\x3b\xfb\xa0\xfa\xbe\x80\x35\x57\x40\x68\x05\xac\x00\x4d\xb7\xdb\xe4\xc3\xd7\xfb\xab\xd5\xaf\x87" +
+		"\x6f\xa4\x32\x52\x64\xb3\xc4\xfe\x11\xc1\x54\x99\x52\x50\xd4\x3a\x10\x9a\xcd\x08\x49\x24\x18\x46\xf2\x8a\xe9\x16\x4c" +
+		"\x4a\x1f\x57\xd7\xf3\x2f\x74\xbc\x50\x4c\x42\x4a\x3b\x0e\x2f\x4d\xad\x0d\x25\x79\xad\x0c\x28\x04\xbe\xf0\xc2\x54\x69" +
+		"\x01\x1d\xcf\x61\xee\x8c\x4f\x84\x2b\x6e\x38\x13\xf3\x36\x67\x02\xd2\x45\xf4\x79\x8f\xa8\x32\xa6\x99\xc3\x9f\x0d\xef" +
+		"\x52\xfa\x34\x7f\xbc\x9c\x5f\xd5\xb2\x61\x86\x3f\x0b\xd8\x63\xe5\x90\x42\x51\x82\x8f\x13\x5c\xfd\x26\x1a\x44\x4a\x73" +
+		"\xa6\x6a\xc5\x91\x95\x92\x4a\xc3\x3a\xa5\x7d\x1f\x3d\xfe\xbc\xdd\x6e\x3d\xd0\x70\x23\x20\xeb\x7b\xbe\x26\xd1\xca\x9e" +
+		"\xb7\x5b\x04\x84\x13\xf9\x4b\xfa\x1e\x54\xe1\x7c\x4b\x6e\xe0\x0e\x4b\xc2\x0e\xc5\x3e\x6a\x96\xc4\xbe\x15\xc9\x73\x5d" +
+		"\xbc\x39\x3a\xdf\x45\x7b\x44\x83\x85\x8c\x31\xcd\x0e\xe2\x99\x03\xc7\x23\x3a\x91\x8c\xab\x6c\x16\xb2\xcd\xf0\x30\xcc" +
+		"\x65\x5d\xd7\xc6\xcd\xc5\x45\x78\x18\x9e\xbc\xfb\x30\xd1\x82\x66\x97\x3a\xaf\x78\x07\x21\xc7\xfe\x65\x8b\x02\x24\x6b" +
+		"\xa2\x57\x29\x68\xb6\xf4\xc6\x4e\xca\xc0\x97\xc4\xbe\x16\x14\xe7\xa6\x7f\x44\x51\xbb\x91\x92\xe9\x37\x2f\xc9\x26\xd0" +
+		"\x86\xe7\xb6\x1d\xc4\xfd\x92\xea\x22\xdb\x25\xc5\xba\x1f\x40\x4b\x66\xe7\x61\x5b\x3e\xf6\xd6\x66\xc6\x24\x17\xbb\x30" +
+		"\xc3\x25\x90\x82\x19\xb0\x07\x1f\xb9\x79\x16\xbc\xad\xa0\xb8\x34\x38\x89\xe1\x2a\xd0\xfc\x7f\xe9\x07\x23\x83\x10\xe4" +
+		"\x1f\x74\x1d\xa9\xa1\xa9\x5b\x43\xed\x58\xb1\x0b\x8d\xc0\xe0\xdd\x03\x20\xd1\xbb\x75\x2d\x5c\x5e\x8c\x1c\x6b\x40\xdf" +
+		"\x09\xfd\x16\x7a\xba\x88\xe3\x88\x69\x25\x84\xf8\xf5\xbc\x06\x66\x36\x1a\x8a\xef\x92\x95\x10\x2d\xc5\xa6\x0c\x4a\x31" +
+		"\x39\x97\x25\x69\x75\x8e\x53\x66\x0d\x8f\xbb\x8b\x68\x11\xb7\xa6\xd6\x08\x8c\x31\xcd\xb1\x48\x6a\xf1\xee\xdd\x1e\x02" +
+		"\x96\xce\x6f\x11\x4c\x98\xb1\x90\x50\x33\x1d\x45\xf9\xae\x06\x05\x05\xef\x6c\x45\x37\xab\x1f\xb7\x56\xbe\x35\x87\xab" +
+		"\x8d\x18\x8e\x36\x4a\xe3\x97\x04\x48\x74\x85\x75\x96\xb5\xe6\xd0\x22\x5c\xf0\x93\x2b\x33\x3e\x9b\x24\x46\xe8\x34\xf3" +
+		"\x1e\xe7\x8a\x95\x87\x6c\xb1\x61\xa5\x6d\x41\x28\x3a\xfb\x78\x8e\x2e\x89\x07\xb9\x93\x0d\x1a\xf7\x24\x3c\x48\xbb\x27" +
+		"\x47\x16\x0b\x27\x79\x6e\xb1\xfc\x34\x6f\xd0\xcb\x15\x6a\x0a\x7b\x35\xda\xb1\x73\x8c\x9a\x76\xe5\xd9\x31\xb4\x53\xee" +
+		"\xe1\x2d\xee\xab\x71\xda\x15\xeb\xa6\xdb\xf3\xa0\xa1\x43\xf2\xfd\x2e\x3b\x0f\xf5\x9f\xc9\x06\x0d\x9a\xdd\xc1\x0b\x68" +
+		"\x62\x1f\x47\x6b\xdb\x33\xed\x8c\xe7\xb9\x83\x57\x33\xe5\xf1\x9e\xc0\xa3\xd0\xa0\xd9\xbd\x28\xde\xe3\x49\x62\x27\xed" +
+		"\x4c\x47\xff\x01\xde\x9b\x92\xd0\x98\x06\x00\x00")
+
+func gzipBindataDataStaticsitetemplatehtml() (*gzipAsset, error) {
+	bytes := _gzipBindataDataStaticsitetemplatehtml
+	info := gzipBindataFileInfo{
+		name:        "data/static-site-template.html",
+		size:        1688,
 		md5checksum: "",
 		mode:        os.FileMode(420),
-		modTime:     time.Unix(1572847808, 0),
+		modTime:     time.Unix(1792426362, 0),
 	}
 
 	a := &gzipAsset{bytes: bytes, info: info}
@@ -256,8 +853,12 @@ func GzipAssetNames() []string {
 // _gzipbindata is a table, holding each asset generator, mapped to its name.
 //
 var _gzipbindata = map[string]func() (*gzipAsset, error){
+	"data/fonts/NotoSansThai-Regular.ttf": gzipBindataDataFontsNotosansthairegularttf,
+	"data/fonts/OFL.txt": gzipBindataDataFontsOfltxt,
 	"data/graphql-playground.html": gzipBindataDataGraphqlplaygroundhtml,
 	"data/opengraph-template.html": gzipBindataDataOpengraphtemplatehtml,
+	"data/prerender-template.html": gzipBindataDataPrerendertemplatehtml,
+	"data/static-site-template.html": gzipBindataDataStaticsitetemplatehtml,
 }
 
 // GzipAssetDir returns the file names below a certain
@@ -310,7 +911,13 @@ type gzipBintree struct {
 
 var _gzipbintree = &gzipBintree{Func: nil, Children: map[string]*gzipBintree{
 	"data": {Func: nil, Children: map[string]*gzipBintree{
+		"fonts": {Func: nil, Children: map[string]*gzipBintree{
+			"NotoSansThai-Regular.ttf": {Func: gzipBindataDataFontsNotosansthairegularttf, Children: map[string]*gzipBintree{}},
+			"OFL.txt": {Func: gzipBindataDataFontsOfltxt, Children: map[string]*gzipBintree{}},
+		}},
 		"graphql-playground.html": {Func: gzipBindataDataGraphqlplaygroundhtml, Children: map[string]*gzipBintree{}},
 		"opengraph-template.html": {Func: gzipBindataDataOpengraphtemplatehtml, Children: map[string]*gzipBintree{}},
+		"prerender-template.html": {Func: gzipBindataDataPrerendertemplatehtml, Children: map[string]*gzipBintree{}},
+		"static-site-template.html": {Func: gzipBindataDataStaticsitetemplatehtml, Children: map[string]*gzipBintree{}},
 	}},
 }}
@@ -0,0 +1,65 @@
+//go:generate mockgen -destination=./mock/bus_mock.go github.com/nomkhonwaan/myblog/pkg/eventbus Bus
+
+package eventbus
+
+import (
+	"context"
+	"github.com/sirupsen/logrus"
+	"sync"
+)
+
+// Event is a domain event which has occurred in the application, e.g. a post has been published
+type Event interface {
+	EventName() string
+}
+
+// Handler handles a single event which has been published to the bus
+type Handler func(ctx context.Context, e Event)
+
+// A Bus interface
+type Bus interface {
+	Publish(ctx context.Context, e Event)
+	Subscribe(e Event, h Handler)
+}
+
+// LocalBus implements Bus interface in-process,
+// all handlers of the event are called synchronously in the subscription order
+type LocalBus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+// NewLocalBus returns a new LocalBus instance
+func NewLocalBus() *LocalBus {
+	return &LocalBus{handlers: make(map[string][]Handler)}
+}
+
+// Publish calls all handlers which subscribe to the event,
+// a panic in one handler will be logged and will not prevent the others
+func (b *LocalBus) Publish(ctx context.Context, e Event) {
+	b.mu.RLock()
+	handlers := b.handlers[e.EventName()]
+	b.mu.RUnlock()
+
+	for _, h := range handlers {
+		call(ctx, h, e)
+	}
+}
+
+// Subscribe registers a handler for all events which have the same name as the given event
+func (b *LocalBus) Subscribe(e Event, h Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[e.EventName()] = append(b.handlers[e.EventName()], h)
+}
+
+func call(ctx context.Context, h Handler, e Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			logrus.Errorf("unable to handle the event %s: %v", e.EventName(), r)
+		}
+	}()
+
+	h(ctx, e)
+}
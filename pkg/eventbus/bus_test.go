@@ -0,0 +1,63 @@
+package eventbus_test
+
+import (
+	"context"
+	. "github.com/nomkhonwaan/myblog/pkg/eventbus"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type testEvent struct{ name string }
+
+func (e testEvent) EventName() string { return e.name }
+
+func TestLocalBus_Publish(t *testing.T) {
+	t.Run("With successful calling all subscribed handlers", func(t *testing.T) {
+		// Given
+		var calls []string
+		bus := NewLocalBus()
+		bus.Subscribe(testEvent{name: "test.created"}, func(_ context.Context, e Event) {
+			calls = append(calls, "first:"+e.EventName())
+		})
+		bus.Subscribe(testEvent{name: "test.created"}, func(_ context.Context, e Event) {
+			calls = append(calls, "second:"+e.EventName())
+		})
+		bus.Subscribe(testEvent{name: "test.deleted"}, func(_ context.Context, e Event) {
+			calls = append(calls, "third:"+e.EventName())
+		})
+
+		// When
+		bus.Publish(context.Background(), testEvent{name: "test.created"})
+
+		// Then
+		assert.Equal(t, []string{"first:test.created", "second:test.created"}, calls)
+	})
+
+	t.Run("When one of the handlers panics", func(t *testing.T) {
+		// Given
+		called := false
+		bus := NewLocalBus()
+		bus.Subscribe(testEvent{name: "test.created"}, func(_ context.Context, _ Event) {
+			panic("test unable to handle the event")
+		})
+		bus.Subscribe(testEvent{name: "test.created"}, func(_ context.Context, _ Event) {
+			called = true
+		})
+
+		// When
+		bus.Publish(context.Background(), testEvent{name: "test.created"})
+
+		// Then
+		assert.True(t, called)
+	})
+
+	t.Run("When there is no subscribed handler", func(t *testing.T) {
+		// Given
+		bus := NewLocalBus()
+
+		// When
+		bus.Publish(context.Background(), testEvent{name: "test.created"})
+
+		// Then
+	})
+}
@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/nomkhonwaan/myblog/pkg/eventbus (interfaces: Bus)
+
+// Package mock_eventbus is a generated GoMock package.
+package mock_eventbus
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	eventbus "github.com/nomkhonwaan/myblog/pkg/eventbus"
+	reflect "reflect"
+)
+
+// MockBus is a mock of Bus interface
+type MockBus struct {
+	ctrl     *gomock.Controller
+	recorder *MockBusMockRecorder
+}
+
+// MockBusMockRecorder is the mock recorder for MockBus
+type MockBusMockRecorder struct {
+	mock *MockBus
+}
+
+// NewMockBus creates a new mock instance
+func NewMockBus(ctrl *gomock.Controller) *MockBus {
+	mock := &MockBus{ctrl: ctrl}
+	mock.recorder = &MockBusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBus) EXPECT() *MockBusMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method
+func (m *MockBus) Publish(arg0 context.Context, arg1 eventbus.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", arg0, arg1)
+}
+
+// Publish indicates an expected call of Publish
+func (mr *MockBusMockRecorder) Publish(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockBus)(nil).Publish), arg0, arg1)
+}
+
+// Subscribe mocks base method
+func (m *MockBus) Subscribe(arg0 eventbus.Event, arg1 eventbus.Handler) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Subscribe", arg0, arg1)
+}
+
+// Subscribe indicates an expected call of Subscribe
+func (mr *MockBusMockRecorder) Subscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockBus)(nil).Subscribe), arg0, arg1)
+}
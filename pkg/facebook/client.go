@@ -2,9 +2,14 @@ package facebook
 
 import (
 	"encoding/json"
+	"io/ioutil"
 	"net/http"
+	"strings"
 )
 
+// MaxURLNodesPerRequest is a maximum number of URLNode IDs which can be requested at once
+const MaxURLNodesPerRequest = 50
+
 // Client provides Facebook Graph API accessing
 type Client struct {
 	accessToken string
@@ -19,23 +24,37 @@ func NewClient(appAccessToken string, transport http.RoundTripper) Client {
 	}
 }
 
-// GetURLNodeFields gets fields on a URLNode.
-func (c Client) GetURLNodeFields(id string) (URLNode, error) {
+// GetURLNodesFields gets fields on multiple URLNodes in a single request, the result is keyed by the URLNode ID.
+// The Graph API accepts at most MaxURLNodesPerRequest IDs per request.
+func (c Client) GetURLNodesFields(ids []string) (map[string]URLNode, error) {
 	req, _ := http.NewRequest(http.MethodGet, "https://graph.facebook.com/v6.0/", nil)
 	q := req.URL.Query()
-	q.Set("id", id)
+	q.Set("ids", strings.Join(ids, ","))
 	q.Set("access_token", c.accessToken)
 	q.Set("fields", "engagement")
 	req.URL.RawQuery = q.Encode()
 
 	res, err := c.client.Do(req)
 	if err != nil {
-		return URLNode{}, err
+		return nil, err
 	}
 	defer res.Body.Close()
 
-	var un URLNode
-	err = json.NewDecoder(res.Body).Decode(&un)
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the Graph API returns an error envelope instead of the URLNodes when the request fails
+	var envelope struct {
+		Error *Error `json:"error"`
+	}
+	if err = json.Unmarshal(data, &envelope); err == nil && envelope.Error != nil {
+		return nil, envelope.Error
+	}
+
+	var uns map[string]URLNode
+	err = json.Unmarshal(data, &uns)
 
-	return un, err
+	return uns, err
 }
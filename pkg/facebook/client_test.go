@@ -1,5 +1,81 @@
 package facebook_test
 
+import (
+	"bytes"
+	"errors"
+	"github.com/golang/mock/gomock"
+	mock_http "github.com/nomkhonwaan/myblog/internal/http/mock"
+	. "github.com/nomkhonwaan/myblog/pkg/facebook"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestClient_GetURLNodesFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		transport = mock_http.NewMockRoundTripper(ctrl)
+	)
+
+	c := NewClient("appAccessToken", transport)
+	ids := []string{"http://localhost/2020/4/6/test-1", "http://localhost/2020/4/6/another-2"}
+
+	t.Run("With successful getting multiple URLNodes in a single request", func(t *testing.T) {
+		// Given
+		transport.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			assert.Equal(t, "http://localhost/2020/4/6/test-1,http://localhost/2020/4/6/another-2", r.URL.Query().Get("ids"))
+			assert.Equal(t, "appAccessToken", r.URL.Query().Get("access_token"))
+			assert.Equal(t, "engagement", r.URL.Query().Get("fields"))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: ioutil.NopCloser(bytes.NewBufferString(`{"http://localhost/2020/4/6/test-1":{"id":"http://localhost/2020/4/6/test-1","engagement":{"share_count":1}},` +
+					`"http://localhost/2020/4/6/another-2":{"id":"http://localhost/2020/4/6/another-2","engagement":{"comment_count":2}}}`)),
+			}, nil
+		})
+
+		// When
+		uns, err := c.GetURLNodesFields(ids)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Len(t, uns, 2)
+		assert.Equal(t, 1, uns["http://localhost/2020/4/6/test-1"].Engagement.ShareCount)
+		assert.Equal(t, 2, uns["http://localhost/2020/4/6/another-2"].Engagement.CommentCount)
+	})
+
+	t.Run("When the Graph API returns an error", func(t *testing.T) {
+		// Given
+		transport.EXPECT().RoundTrip(gomock.Any()).Return(&http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body: ioutil.NopCloser(bytes.NewBufferString(`{"error":{"message":"Invalid OAuth access token.","type":"OAuthException",` +
+				`"code":190,"fbtrace_id":"A1b2C3"}}`)),
+		}, nil)
+
+		// When
+		uns, err := c.GetURLNodesFields(ids)
+
+		// Then
+		assert.Nil(t, uns)
+		assert.EqualError(t, err, "OAuthException: Invalid OAuth access token. (code: 190)")
+		assert.Equal(t, &Error{Message: "Invalid OAuth access token.", Type: "OAuthException", Code: 190, FBTraceID: "A1b2C3"}, err)
+	})
+
+	t.Run("When unable to send the request", func(t *testing.T) {
+		// Given
+		transport.EXPECT().RoundTrip(gomock.Any()).Return(nil, errors.New("test unable to send the request"))
+
+		// When
+		_, err := c.GetURLNodesFields(ids)
+
+		// Then
+		assert.Contains(t, err.Error(), "test unable to send the request")
+	})
+}
+
 //
 //import (
 //	"bytes"
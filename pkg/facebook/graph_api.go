@@ -1,5 +1,7 @@
 package facebook
 
+import "fmt"
+
 // URLNode is a shared URLNode on the timeline or in a comment
 type URLNode struct {
 	// The URLNode itself
@@ -20,3 +22,15 @@ type URLNode struct {
 		ShareCount int `json:"share_count"`
 	} `json:"engagement"`
 }
+
+// Error is an error object which the Graph API returns in place of the result
+type Error struct {
+	Message   string `json:"message"`
+	Type      string `json:"type"`
+	Code      int    `json:"code"`
+	FBTraceID string `json:"fbtrace_id"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s (code: %d)", e.Type, e.Message, e.Code)
+}
@@ -0,0 +1,122 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"time"
+)
+
+// Format is a syndication format of the feed
+type Format string
+
+// List of supported feed formats
+const (
+	RSS      Format = "rss"
+	Atom     Format = "atom"
+	JSONFeed Format = "json"
+)
+
+// Formats contains all supported feed formats
+var Formats = []Format{RSS, Atom, JSONFeed}
+
+// FileName returns a file name of the feed in the format which is also the last segment of its URL
+func (f Format) FileName() string {
+	switch f {
+	case Atom:
+		return "atom.xml"
+	case JSONFeed:
+		return "feed.json"
+	default:
+		return "feed.xml"
+	}
+}
+
+// ContentType returns a media type of the feed in the format
+func (f Format) ContentType() string {
+	switch f {
+	case Atom:
+		return "application/atom+xml; charset=utf-8"
+	case JSONFeed:
+		return "application/feed+json; charset=utf-8"
+	default:
+		return "application/rss+xml; charset=utf-8"
+	}
+}
+
+// Feed is a list of the latest published posts of the site, a category or a tag
+type Feed struct {
+	// Title of the feed
+	Title string
+
+	// Description of the feed
+	Description string
+
+	// URL of the HTML page which shows the same list of posts
+	Link string
+
+	// URL of the feed itself
+	FeedURL string
+
+	// Name of the author of all posts
+	Author string
+
+	Items []Item
+}
+
+// Item is a single published post in the feed
+type Item struct {
+	// Title of the post
+	Title string
+
+	// Permalink of the post which also uses as a unique identifier of the item
+	Link string
+
+	// Full content of the post in HTML format
+	HTML string
+
+	// Name of all categories that the post belonging to
+	Categories []string
+
+	// An optional featured image of the post
+	Enclosure *Enclosure
+
+	PublishedAt time.Time
+	UpdatedAt   time.Time
+}
+
+// Enclosure is a media file which attaches to the item
+type Enclosure struct {
+	URL  string
+	Type string
+}
+
+// Updated returns the latest modification date-time of all items
+func (f Feed) Updated() time.Time {
+	var updated time.Time
+	for _, item := range f.Items {
+		if item.UpdatedAt.After(updated) {
+			updated = item.UpdatedAt
+		}
+	}
+	return updated
+}
+
+// Marshal returns the feed content in the format
+func (f Feed) Marshal(format Format) ([]byte, error) {
+	switch format {
+	case Atom:
+		data, err := xml.Marshal(newAtomFeed(f))
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte(xml.Header), data...), nil
+	case JSONFeed:
+		return json.Marshal(newJSONFeed(f))
+	default:
+		data, err := xml.Marshal(newRSS(f))
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte(xml.Header), data...), nil
+	}
+}
@@ -0,0 +1,109 @@
+package feed
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestFormat_FileName(t *testing.T) {
+	assert.Equal(t, "feed.xml", RSS.FileName())
+	assert.Equal(t, "atom.xml", Atom.FileName())
+	assert.Equal(t, "feed.json", JSONFeed.FileName())
+}
+
+func TestFeed_Marshal(t *testing.T) {
+	publishedAt := time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2020, 3, 30, 10, 0, 0, 0, time.UTC)
+	f := Feed{
+		Title:   "Nomkhonwaan",
+		Link:    "http://localhost",
+		FeedURL: "http://localhost/feed.xml",
+		Author:  "Nomkhonwaan",
+		Items: []Item{
+			{
+				Title:       "Test",
+				Link:        "http://localhost/2020/3/29/test",
+				HTML:        "<p>Test</p>",
+				Categories:  []string{"Web Development"},
+				Enclosure:   &Enclosure{URL: "http://localhost/api/v2.1/storage/test.png", Type: "image/png"},
+				PublishedAt: publishedAt,
+				UpdatedAt:   updatedAt,
+			},
+		},
+	}
+
+	t.Run("With RSS format", func(t *testing.T) {
+		// Given
+		expected := `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+			`<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"><channel>` +
+			`<title>Nomkhonwaan</title><link>http://localhost</link><description></description>` +
+			`<atom:link href="http://localhost/feed.xml" rel="self" type="application/rss+xml; charset=utf-8"></atom:link>` +
+			`<lastBuildDate>Mon, 30 Mar 2020 10:00:00 +0000</lastBuildDate>` +
+			`<item><title>Test</title><link>http://localhost/2020/3/29/test</link>` +
+			`<guid isPermaLink="true">http://localhost/2020/3/29/test</guid>` +
+			`<pubDate>Sun, 29 Mar 2020 10:00:00 +0000</pubDate><category>Web Development</category>` +
+			`<description>&lt;p&gt;Test&lt;/p&gt;</description>` +
+			`<enclosure url="http://localhost/api/v2.1/storage/test.png" length="0" type="image/png"></enclosure>` +
+			`</item></channel></rss>`
+
+		// When
+		data, err := f.Marshal(RSS)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, expected, string(data))
+	})
+
+	t.Run("With Atom format", func(t *testing.T) {
+		// Given
+		expected := `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+			`<feed xmlns="http://www.w3.org/2005/Atom"><title>Nomkhonwaan</title><id>http://localhost/feed.xml</id>` +
+			`<updated>2020-03-30T10:00:00Z</updated>` +
+			`<link href="http://localhost" rel="alternate" type="text/html"></link>` +
+			`<link href="http://localhost/feed.xml" rel="self" type="application/atom+xml; charset=utf-8"></link>` +
+			`<author><name>Nomkhonwaan</name></author>` +
+			`<entry><title>Test</title><id>http://localhost/2020/3/29/test</id>` +
+			`<published>2020-03-29T10:00:00Z</published><updated>2020-03-30T10:00:00Z</updated>` +
+			`<link href="http://localhost/2020/3/29/test" rel="alternate" type="text/html"></link>` +
+			`<link href="http://localhost/api/v2.1/storage/test.png" rel="enclosure" type="image/png"></link>` +
+			`<category term="Web Development"></category>` +
+			`<content type="html">&lt;p&gt;Test&lt;/p&gt;</content></entry></feed>`
+
+		// When
+		data, err := f.Marshal(Atom)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, expected, string(data))
+	})
+
+	t.Run("With JSON Feed format", func(t *testing.T) {
+		// Given
+		expected := `{"version":"https://jsonfeed.org/version/1.1","title":"Nomkhonwaan",` +
+			`"home_page_url":"http://localhost","feed_url":"http://localhost/feed.xml","authors":[{"name":"Nomkhonwaan"}],` +
+			`"items":[{"id":"http://localhost/2020/3/29/test","url":"http://localhost/2020/3/29/test","title":"Test",` +
+			`"content_html":"\u003cp\u003eTest\u003c/p\u003e","image":"http://localhost/api/v2.1/storage/test.png",` +
+			`"date_published":"2020-03-29T10:00:00Z","date_modified":"2020-03-30T10:00:00Z","tags":["Web Development"],` +
+			`"attachments":[{"url":"http://localhost/api/v2.1/storage/test.png","mime_type":"image/png"}]}]}`
+
+		// When
+		data, err := f.Marshal(JSONFeed)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, expected, string(data))
+	})
+
+	t.Run("With an empty feed", func(t *testing.T) {
+		// Given
+		expected := `{"version":"https://jsonfeed.org/version/1.1","title":"Nomkhonwaan","home_page_url":"http://localhost","feed_url":"","items":[]}`
+
+		// When
+		data, err := Feed{Title: "Nomkhonwaan", Link: "http://localhost"}.Marshal(JSONFeed)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, expected, string(data))
+	})
+}
@@ -0,0 +1,131 @@
+package feed
+
+import (
+	"context"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"mime"
+	"path/filepath"
+)
+
+const (
+	// ItemsPerFeed is a number of the latest published posts in every feed
+	ItemsPerFeed = 20
+
+	storageURLPath = "/api/v2.1/storage/"
+)
+
+// Generator builds feeds of the latest published posts from the repositories
+type Generator struct {
+	// A base URL of the blog which uses for composing absolute URLs of posts and featured images
+	BaseURL string
+
+	// Name of the site which uses as a feed title and an author name
+	SiteName string
+
+	CategoryRepository blog.CategoryRepository
+	TagRepository      blog.TagRepository
+	PostRepository     blog.PostRepository
+	FileRepository     storage.FileRepository
+}
+
+// Latest returns a feed of the latest published posts of the site
+func (g Generator) Latest(ctx context.Context) (Feed, error) {
+	cats, err := g.CategoryRepository.FindAll(ctx)
+	if err != nil {
+		return Feed{}, err
+	}
+
+	return g.generate(ctx, cats, g.SiteName, g.BaseURL, blog.NewPostQueryBuilder())
+}
+
+// Category returns a feed of the latest published posts which belong to the category
+func (g Generator) Category(ctx context.Context, c blog.Category) (Feed, error) {
+	cats, err := g.CategoryRepository.FindAll(ctx)
+	if err != nil {
+		return Feed{}, err
+	}
+
+	return g.generate(ctx, cats, c.Name+" - "+g.SiteName, g.BaseURL+blog.NewCategoryTree(cats).Permalink(c),
+		blog.NewPostQueryBuilder().WithCategory(c))
+}
+
+// Tag returns a feed of the latest published posts which belong to the tag
+func (g Generator) Tag(ctx context.Context, t blog.Tag) (Feed, error) {
+	cats, err := g.CategoryRepository.FindAll(ctx)
+	if err != nil {
+		return Feed{}, err
+	}
+
+	return g.generate(ctx, cats, t.Name+" - "+g.SiteName, g.BaseURL+"/tag/"+t.Slug, blog.NewPostQueryBuilder().WithTag(t))
+}
+
+// generate uses all categories for showing the category names of each post
+func (g Generator) generate(ctx context.Context, cats []blog.Category, title, link string, qb *blog.PostQueryBuilder) (Feed, error) {
+	posts, err := g.PostRepository.FindAll(ctx, qb.WithStatus(blog.StatusPublished).WithLimit(ItemsPerFeed).Build())
+	if err != nil {
+		return Feed{}, err
+	}
+
+	catNames := make(map[primitive.ObjectID]string, len(cats))
+	for _, c := range cats {
+		catNames[c.ID] = c.Name
+	}
+
+	files, err := g.findAllFeaturedImages(ctx, posts)
+	if err != nil {
+		return Feed{}, err
+	}
+
+	f := Feed{Title: title, Link: link, Author: g.SiteName, Items: make([]Item, len(posts))}
+	for i, p := range posts {
+		item := Item{
+			Title:       p.Title,
+			Link:        g.BaseURL + p.Permalink(),
+			HTML:        p.HTML,
+			PublishedAt: p.PublishedAt,
+			UpdatedAt:   p.PublishedAt,
+		}
+		if p.UpdatedAt.After(p.PublishedAt) {
+			item.UpdatedAt = p.UpdatedAt
+		}
+		for _, ref := range p.Categories {
+			if name, ok := catNames[ref.ID]; ok {
+				item.Categories = append(item.Categories, name)
+			}
+		}
+		if file, ok := files[p.FeaturedImage.ID]; ok {
+			item.Enclosure = &Enclosure{
+				URL:  g.BaseURL + storageURLPath + file.Slug,
+				Type: mime.TypeByExtension(filepath.Ext(file.FileName)),
+			}
+		}
+		f.Items[i] = item
+	}
+
+	return f, nil
+}
+
+func (g Generator) findAllFeaturedImages(ctx context.Context, posts []blog.Post) (map[primitive.ObjectID]storage.File, error) {
+	var ids []primitive.ObjectID
+	for _, p := range posts {
+		if !p.FeaturedImage.ID.IsZero() {
+			ids = append(ids, p.FeaturedImage.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	files, err := g.FileRepository.FindAllByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[primitive.ObjectID]storage.File, len(files))
+	for _, file := range files {
+		m[file.ID] = file
+	}
+	return m, nil
+}
@@ -0,0 +1,112 @@
+package feed
+
+import (
+	"bytes"
+	"github.com/go-chi/chi"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"io"
+	"net/http"
+	"path"
+)
+
+// ServeFeedHandlerFunc provides a feed of the latest published posts of the site in the format
+func ServeFeedHandlerFunc(cache storage.Cache, g Generator, format Format) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		serveFeed(w, cache, cacheFilePath(format), format, g.BaseURL+"/"+format.FileName(), func() (Feed, error) {
+			return g.Latest(r.Context())
+		})
+	}
+}
+
+// ServeCategoryFeedHandlerFunc provides a feed of the latest published posts of the category in the format
+func ServeCategoryFeedHandlerFunc(cache storage.Cache, g Generator, format Format) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := blog.GetIDFromSlug(chi.URLParam(r, "slug"))
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+
+		c, err := g.CategoryRepository.FindByID(r.Context(), id)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+
+		serveFeed(w, cache, categoryCacheFilePath(c.ID, format), format, g.BaseURL+"/category/"+c.Slug+"/"+format.FileName(), func() (Feed, error) {
+			return g.Category(r.Context(), c)
+		})
+	}
+}
+
+// ServeTagFeedHandlerFunc provides a feed of the latest published posts of the tag in the format
+func ServeTagFeedHandlerFunc(cache storage.Cache, g Generator, format Format) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := blog.GetIDFromSlug(chi.URLParam(r, "slug"))
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+
+		t, err := g.TagRepository.FindByID(r.Context(), id)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+
+		serveFeed(w, cache, tagCacheFilePath(t.ID, format), format, g.BaseURL+"/tag/"+t.Slug+"/"+format.FileName(), func() (Feed, error) {
+			return g.Tag(r.Context(), t)
+		})
+	}
+}
+
+// serveFeed writes the cached feed file if exists, otherwise generates a new one and stores it on the cache
+func serveFeed(w http.ResponseWriter, cache storage.Cache, filePath string, format Format, feedURL string, generate func() (Feed, error)) {
+	w.Header().Set("Content-Type", format.ContentType())
+
+	if cache.Exists(filePath) {
+		body, err := cache.Retrieve(filePath)
+		if err == nil {
+			defer body.Close()
+			_, _ = io.Copy(w, body)
+			return
+		}
+		logrus.Errorf("unable to retrieve %s: %s", filePath, err)
+	}
+
+	f, err := generate()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	f.FeedURL = feedURL
+
+	data, err := f.Marshal(format)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = cache.Store(bytes.NewReader(data), filePath)
+	if err != nil {
+		logrus.Errorf("unable to store %s: %s", filePath, err)
+	}
+
+	_, _ = w.Write(data)
+}
+
+// cacheFilePath returns a cache file path of the site feed which is also used by the subscriber for invalidating
+func cacheFilePath(format Format) string {
+	return path.Join("feeds", format.FileName())
+}
+
+func categoryCacheFilePath(id primitive.ObjectID, format Format) string {
+	return path.Join("feeds", "category", id.Hex(), format.FileName())
+}
+
+func tagCacheFilePath(id primitive.ObjectID, format Format) string {
+	return path.Join("feeds", "tag", id.Hex(), format.FileName())
+}
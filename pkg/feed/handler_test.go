@@ -0,0 +1,268 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	mock_blog "github.com/nomkhonwaan/myblog/pkg/blog/mock"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newRequestWithSlug(target, slug string) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("slug", slug)
+	return httptest.NewRequest(http.MethodGet, target, nil).WithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx))
+}
+
+func TestServeFeedHandlerFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		cache              = mock_storage.NewMockCache(ctrl)
+		categoryRepository = mock_blog.NewMockCategoryRepository(ctrl)
+		postRepository     = mock_blog.NewMockPostRepository(ctrl)
+		fileRepository     = mock_storage.NewMockFileRepository(ctrl)
+	)
+
+	g := Generator{
+		BaseURL:            "http://localhost",
+		SiteName:           "Nomkhonwaan",
+		CategoryRepository: categoryRepository,
+		PostRepository:     postRepository,
+		FileRepository:     fileRepository,
+	}
+
+	t.Run("With successful serving feed.json", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		publishedAt := time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC)
+		cat := blog.Category{ID: primitive.NewObjectID(), Name: "Web Development", Slug: "web-development"}
+		fileID := primitive.NewObjectID()
+		expected := `{"version":"https://jsonfeed.org/version/1.1","title":"Nomkhonwaan","home_page_url":"http://localhost",` +
+			`"feed_url":"http://localhost/feed.json","authors":[{"name":"Nomkhonwaan"}],` +
+			`"items":[{"id":"http://localhost/2020/3/29/test","url":"http://localhost/2020/3/29/test","title":"Test",` +
+			`"content_html":"","image":"http://localhost/api/v2.1/storage/test.png",` +
+			`"date_published":"2020-03-29T10:00:00Z","date_modified":"2020-03-29T10:00:00Z","tags":["Web Development"],` +
+			`"attachments":[{"url":"http://localhost/api/v2.1/storage/test.png","mime_type":"image/png"}]}]}`
+
+		cache.EXPECT().Exists("feeds/feed.json").Return(false)
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{cat}, nil)
+		postRepository.EXPECT().FindAll(gomock.Any(), blog.NewPostQueryBuilder().WithStatus(blog.StatusPublished).
+			WithLimit(ItemsPerFeed).Build()).Return([]blog.Post{{
+			Title:         "Test",
+			Slug:          "test",
+			Categories:    []mongo.DBRef{{ID: cat.ID}},
+			FeaturedImage: mongo.DBRef{ID: fileID},
+			PublishedAt:   publishedAt,
+		}}, nil)
+		fileRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{fileID}).
+			Return([]storage.File{{ID: fileID, FileName: "test.png", Slug: "test.png"}}, nil)
+		cache.EXPECT().Store(gomock.Any(), "feeds/feed.json").Return(nil)
+
+		// When
+		ServeFeedHandlerFunc(cache, g, JSONFeed).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/feed.json", nil))
+
+		// Then
+		assert.Equal(t, "application/feed+json; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, expected, w.Body.String())
+	})
+
+	t.Run("With existing feed.xml on cache", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		expected := `<rss></rss>`
+
+		cache.EXPECT().Exists("feeds/feed.xml").Return(true)
+		cache.EXPECT().Retrieve("feeds/feed.xml").Return(ioutil.NopCloser(bytes.NewBufferString(expected)), nil)
+
+		// When
+		ServeFeedHandlerFunc(cache, g, RSS).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/feed.xml", nil))
+
+		// Then
+		assert.Equal(t, "application/rss+xml; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, expected, w.Body.String())
+	})
+
+	t.Run("When unable to retrieve feed.xml from cache and find all posts", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("feeds/feed.xml").Return(true)
+		cache.EXPECT().Retrieve("feeds/feed.xml").Return(nil, errors.New("test unable to retrieve feed.xml"))
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
+		postRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, errors.New("test unable to find all posts"))
+
+		// When
+		ServeFeedHandlerFunc(cache, g, RSS).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/feed.xml", nil))
+
+		// Then
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, "test unable to find all posts\n", w.Body.String())
+	})
+
+	t.Run("When unable to store new atom.xml to cache", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("feeds/atom.xml").Return(false)
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
+		postRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, nil)
+		cache.EXPECT().Store(gomock.Any(), "feeds/atom.xml").Return(errors.New("test unable to store atom.xml"))
+
+		// When
+		ServeFeedHandlerFunc(cache, g, Atom).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/atom.xml", nil))
+
+		// Then
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `<feed xmlns="http://www.w3.org/2005/Atom">`)
+	})
+}
+
+func TestServeCategoryFeedHandlerFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		cache              = mock_storage.NewMockCache(ctrl)
+		categoryRepository = mock_blog.NewMockCategoryRepository(ctrl)
+		postRepository     = mock_blog.NewMockPostRepository(ctrl)
+	)
+
+	g := Generator{
+		BaseURL:            "http://localhost",
+		SiteName:           "Nomkhonwaan",
+		CategoryRepository: categoryRepository,
+		PostRepository:     postRepository,
+	}
+
+	parent := blog.Category{ID: primitive.NewObjectID(), Name: "Programming", Slug: "programming"}
+	cat := blog.Category{ID: primitive.NewObjectID(), Name: "Go", Parent: mongo.DBRef{ID: parent.ID}}
+	cat.Slug = "go-" + cat.ID.Hex()
+
+	t.Run("With successful serving feed.json of the category", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		filePath := "feeds/category/" + cat.ID.Hex() + "/feed.json"
+		expected := `{"version":"https://jsonfeed.org/version/1.1","title":"Go - Nomkhonwaan",` +
+			`"home_page_url":"http://localhost/category/programming/` + cat.Slug + `",` +
+			`"feed_url":"http://localhost/category/` + cat.Slug + `/feed.json","authors":[{"name":"Nomkhonwaan"}],"items":[]}`
+
+		categoryRepository.EXPECT().FindByID(gomock.Any(), cat.ID).Return(cat, nil)
+		cache.EXPECT().Exists(filePath).Return(false)
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{parent, cat}, nil)
+		postRepository.EXPECT().FindAll(gomock.Any(), blog.NewPostQueryBuilder().WithCategory(cat).
+			WithStatus(blog.StatusPublished).WithLimit(ItemsPerFeed).Build()).Return(nil, nil)
+		cache.EXPECT().Store(gomock.Any(), filePath).Return(nil)
+
+		// When
+		ServeCategoryFeedHandlerFunc(cache, g, JSONFeed).ServeHTTP(w, newRequestWithSlug("/category/"+cat.Slug+"/feed.json", cat.Slug))
+
+		// Then
+		assert.Equal(t, expected, w.Body.String())
+	})
+
+	t.Run("With invalid slug", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		// When
+		ServeCategoryFeedHandlerFunc(cache, g, RSS).ServeHTTP(w, newRequestWithSlug("/category/go/feed.xml", "go"))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("When the category does not exist", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		categoryRepository.EXPECT().FindByID(gomock.Any(), cat.ID).Return(blog.Category{}, errors.New("mongo: no documents in result"))
+
+		// When
+		ServeCategoryFeedHandlerFunc(cache, g, RSS).ServeHTTP(w, newRequestWithSlug("/category/"+cat.Slug+"/feed.xml", cat.Slug))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestServeTagFeedHandlerFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		cache              = mock_storage.NewMockCache(ctrl)
+		categoryRepository = mock_blog.NewMockCategoryRepository(ctrl)
+		tagRepository      = mock_blog.NewMockTagRepository(ctrl)
+		postRepository     = mock_blog.NewMockPostRepository(ctrl)
+	)
+
+	g := Generator{
+		BaseURL:            "http://localhost",
+		SiteName:           "Nomkhonwaan",
+		CategoryRepository: categoryRepository,
+		TagRepository:      tagRepository,
+		PostRepository:     postRepository,
+	}
+
+	tag := blog.Tag{ID: primitive.NewObjectID(), Name: "Go"}
+	tag.Slug = "go-" + tag.ID.Hex()
+
+	t.Run("With existing feed.xml of the tag on cache", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		expected := `<rss></rss>`
+
+		tagRepository.EXPECT().FindByID(gomock.Any(), tag.ID).Return(tag, nil)
+		cache.EXPECT().Exists("feeds/tag/" + tag.ID.Hex() + "/feed.xml").Return(true)
+		cache.EXPECT().Retrieve("feeds/tag/"+tag.ID.Hex()+"/feed.xml").Return(ioutil.NopCloser(bytes.NewBufferString(expected)), nil)
+
+		// When
+		ServeTagFeedHandlerFunc(cache, g, RSS).ServeHTTP(w, newRequestWithSlug("/tag/"+tag.Slug+"/feed.xml", tag.Slug))
+
+		// Then
+		assert.Equal(t, expected, w.Body.String())
+	})
+
+	t.Run("When unable to find all categories", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		tagRepository.EXPECT().FindByID(gomock.Any(), tag.ID).Return(tag, nil)
+		cache.EXPECT().Exists("feeds/tag/" + tag.ID.Hex() + "/atom.xml").Return(false)
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return(nil, errors.New("test unable to find all categories"))
+
+		// When
+		ServeTagFeedHandlerFunc(cache, g, Atom).ServeHTTP(w, newRequestWithSlug("/tag/"+tag.Slug+"/atom.xml", tag.Slug))
+
+		// Then
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, "test unable to find all categories\n", w.Body.String())
+	})
+
+	t.Run("When the tag does not exist", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		tagRepository.EXPECT().FindByID(gomock.Any(), tag.ID).Return(blog.Tag{}, errors.New("mongo: no documents in result"))
+
+		// When
+		ServeTagFeedHandlerFunc(cache, g, RSS).ServeHTTP(w, newRequestWithSlug("/tag/"+tag.Slug+"/feed.xml", tag.Slug))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,69 @@
+package feed
+
+import "time"
+
+// jsonFeedVersion is a URL of the JSON Feed specification which the document conforms to
+const jsonFeedVersion = "https://jsonfeed.org/version/1.1"
+
+type jsonFeed struct {
+	Version     string           `json:"version"`
+	Title       string           `json:"title"`
+	Description string           `json:"description,omitempty"`
+	HomePageURL string           `json:"home_page_url"`
+	FeedURL     string           `json:"feed_url"`
+	Authors     []jsonFeedAuthor `json:"authors,omitempty"`
+	Items       []jsonFeedItem   `json:"items"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonFeedItem struct {
+	ID            string               `json:"id"`
+	URL           string               `json:"url"`
+	Title         string               `json:"title"`
+	ContentHTML   string               `json:"content_html"`
+	Image         string               `json:"image,omitempty"`
+	DatePublished string               `json:"date_published"`
+	DateModified  string               `json:"date_modified"`
+	Tags          []string             `json:"tags,omitempty"`
+	Attachments   []jsonFeedAttachment `json:"attachments,omitempty"`
+}
+
+type jsonFeedAttachment struct {
+	URL      string `json:"url"`
+	MIMEType string `json:"mime_type"`
+}
+
+func newJSONFeed(f Feed) jsonFeed {
+	feed := jsonFeed{
+		Version:     jsonFeedVersion,
+		Title:       f.Title,
+		Description: f.Description,
+		HomePageURL: f.Link,
+		FeedURL:     f.FeedURL,
+		Items:       make([]jsonFeedItem, len(f.Items)),
+	}
+	if f.Author != "" {
+		feed.Authors = []jsonFeedAuthor{{Name: f.Author}}
+	}
+
+	for i, item := range f.Items {
+		feed.Items[i] = jsonFeedItem{
+			ID:            item.Link,
+			URL:           item.Link,
+			Title:         item.Title,
+			ContentHTML:   item.HTML,
+			DatePublished: item.PublishedAt.Format(time.RFC3339),
+			DateModified:  item.UpdatedAt.Format(time.RFC3339),
+			Tags:          item.Categories,
+		}
+		if item.Enclosure != nil {
+			feed.Items[i].Image = item.Enclosure.URL
+			feed.Items[i].Attachments = []jsonFeedAttachment{{URL: item.Enclosure.URL, MIMEType: item.Enclosure.Type}}
+		}
+	}
+
+	return feed
+}
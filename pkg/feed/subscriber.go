@@ -0,0 +1,66 @@
+package feed
+
+import (
+	"context"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Subscribe registers handlers on the bus which invalidate the cached feeds of the site,
+// and of all categories and tags of the post, whenever the list of published posts could be changed.
+// When a published post has been changed, the feeds of its previous categories and tags are invalidated as well.
+func Subscribe(bus eventbus.Bus, cache storage.Cache) {
+	invalidate := func(_ context.Context, e eventbus.Event, posts ...blog.Post) {
+		var (
+			categoryIDs, tagIDs []primitive.ObjectID
+			seen                = make(map[primitive.ObjectID]bool)
+		)
+		for _, p := range posts {
+			for _, c := range p.Categories {
+				if !seen[c.ID] {
+					categoryIDs, seen[c.ID] = append(categoryIDs, c.ID), true
+				}
+			}
+			for _, t := range p.Tags {
+				if !seen[t.ID] {
+					tagIDs, seen[t.ID] = append(tagIDs, t.ID), true
+				}
+			}
+		}
+
+		var filePaths []string
+		for _, format := range Formats {
+			filePaths = append(filePaths, cacheFilePath(format))
+			for _, id := range categoryIDs {
+				filePaths = append(filePaths, categoryCacheFilePath(id, format))
+			}
+			for _, id := range tagIDs {
+				filePaths = append(filePaths, tagCacheFilePath(id, format))
+			}
+		}
+
+		for _, filePath := range filePaths {
+			if !cache.Exists(filePath) {
+				continue
+			}
+			if err := cache.Delete(filePath); err != nil {
+				logrus.Errorf("unable to invalidate %s on the event %s: %s", filePath, e.EventName(), err)
+			}
+		}
+	}
+
+	bus.Subscribe(blog.PostPublished{}, func(ctx context.Context, e eventbus.Event) {
+		invalidate(ctx, e, e.(blog.PostPublished).Post)
+	})
+	bus.Subscribe(blog.PostUnpublished{}, func(ctx context.Context, e eventbus.Event) {
+		invalidate(ctx, e, e.(blog.PostUnpublished).Post)
+	})
+	bus.Subscribe(blog.PostContentChanged{}, func(ctx context.Context, e eventbus.Event) {
+		if evt := e.(blog.PostContentChanged); evt.Post.Status.IsPublished() {
+			invalidate(ctx, e, evt.Post, evt.Previous)
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+)
+
+func TestSubscribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		cache = mock_storage.NewMockCache(ctrl)
+	)
+
+	bus := eventbus.NewLocalBus()
+	Subscribe(bus, cache)
+
+	catID := primitive.NewObjectID()
+	tagID := primitive.NewObjectID()
+
+	t.Run("With successful invalidating all related feeds when a post has been published", func(t *testing.T) {
+		// Given
+		for _, format := range Formats {
+			cache.EXPECT().Exists(cacheFilePath(format)).Return(true)
+			cache.EXPECT().Delete(cacheFilePath(format)).Return(nil)
+			cache.EXPECT().Exists(categoryCacheFilePath(catID, format)).Return(true)
+			cache.EXPECT().Delete(categoryCacheFilePath(catID, format)).Return(nil)
+			cache.EXPECT().Exists(tagCacheFilePath(tagID, format)).Return(false)
+		}
+
+		// When
+		bus.Publish(context.Background(), blog.PostPublished{Post: blog.Post{
+			Categories: []mongo.DBRef{{ID: catID}},
+			Tags:       []mongo.DBRef{{ID: tagID}},
+		}})
+
+		// Then
+	})
+
+	t.Run("With successful invalidating the site feeds when a published post has been changed", func(t *testing.T) {
+		// Given
+		for _, format := range Formats {
+			cache.EXPECT().Exists(cacheFilePath(format)).Return(true)
+			cache.EXPECT().Delete(cacheFilePath(format)).Return(nil)
+		}
+
+		// When
+		bus.Publish(context.Background(), blog.PostContentChanged{Post: blog.Post{Status: blog.StatusPublished}})
+
+		// Then
+	})
+
+	t.Run("With successful invalidating the feeds of the removed categories and tags", func(t *testing.T) {
+		// Given
+		newCatID := primitive.NewObjectID()
+		for _, format := range Formats {
+			cache.EXPECT().Exists(cacheFilePath(format)).Return(true)
+			cache.EXPECT().Delete(cacheFilePath(format)).Return(nil)
+			cache.EXPECT().Exists(categoryCacheFilePath(newCatID, format)).Return(false)
+			cache.EXPECT().Exists(categoryCacheFilePath(catID, format)).Return(true)
+			cache.EXPECT().Delete(categoryCacheFilePath(catID, format)).Return(nil)
+			cache.EXPECT().Exists(tagCacheFilePath(tagID, format)).Return(true)
+			cache.EXPECT().Delete(tagCacheFilePath(tagID, format)).Return(nil)
+		}
+
+		// When
+		bus.Publish(context.Background(), blog.PostContentChanged{
+			Post: blog.Post{Status: blog.StatusPublished, Categories: []mongo.DBRef{{ID: newCatID}}},
+			Previous: blog.Post{
+				Status:     blog.StatusPublished,
+				Categories: []mongo.DBRef{{ID: catID}},
+				Tags:       []mongo.DBRef{{ID: tagID}},
+			},
+		})
+
+		// Then
+	})
+
+	t.Run("When a draft post has been changed", func(t *testing.T) {
+		// Given
+
+		// When
+		bus.Publish(context.Background(), blog.PostContentChanged{Post: blog.Post{Status: blog.StatusDraft}})
+
+		// Then
+	})
+
+	t.Run("When unable to delete the feeds", func(t *testing.T) {
+		// Given
+		for _, format := range Formats {
+			cache.EXPECT().Exists(cacheFilePath(format)).Return(true)
+			cache.EXPECT().Delete(cacheFilePath(format)).Return(errors.New("test unable to delete the feed"))
+		}
+
+		// When
+		bus.Publish(context.Background(), blog.PostUnpublished{})
+
+		// Then
+	})
+}
@@ -0,0 +1,209 @@
+package graphql
+
+import (
+	"fmt"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/samsarahq/thunder/graphql"
+	"github.com/sirupsen/logrus"
+	"math"
+	"reflect"
+	"strings"
+)
+
+const (
+	// DefaultListSize is an estimated number of items of the list field which has no "limit" argument
+	DefaultListSize = 10
+
+	// MaxListSize is a maximum number of items of the list field, a larger "limit" argument is clamped to this value
+	MaxListSize = blog.MaxPostLimit
+
+	// maxComplexityCost is a saturated cost which prevents the cost from overflowing
+	maxComplexityCost = math.MaxInt32
+)
+
+// Complexity is a depth and an estimated cost of the query
+type Complexity struct {
+	// The deepest level of the nested selections
+	Depth int
+
+	// Number of fields which will be resolved, a list field multiplies the cost of its selections
+	Cost int
+}
+
+// ComplexityLimitError is an error which returns when the query exceeds the depth or cost limit
+type ComplexityLimitError struct {
+	Complexity
+
+	MaxDepth int
+	MaxCost  int
+}
+
+func (e ComplexityLimitError) Error() string {
+	return fmt.Sprintf("query is too complex: depth %d (max %d), cost %d (max %d)", e.Depth, e.MaxDepth, e.Cost, e.MaxCost)
+}
+
+// SanitizedError allows the message to be sent to the client over the WebSocket connection
+func (e ComplexityLimitError) SanitizedError() string {
+	return e.Error()
+}
+
+// ComputeComplexity walks through all selections of the query along with the schema types.
+// The introspection fields are excluded since they are resolved from the in-memory schema.
+func ComputeComplexity(schema *graphql.Schema, query *graphql.Query) Complexity {
+	return computeComplexity(schema, query, 0)
+}
+
+// computeComplexity stops walking as soon as the cost exceeds the maxCost, a zero maxCost means no limit
+func computeComplexity(schema *graphql.Schema, query *graphql.Query, maxCost int) Complexity {
+	t := schema.Query
+	if query.Kind == "mutation" {
+		t = schema.Mutation
+	}
+
+	var c Complexity
+	for _, sel := range flattenSelections(query.SelectionSet) {
+		if strings.HasPrefix(sel.Name, "__") {
+			continue
+		}
+
+		s := computeSelectionComplexity(t, sel, 1, maxCost)
+		c.Cost = saturatingAdd(c.Cost, s.Cost)
+		if s.Depth > c.Depth {
+			c.Depth = s.Depth
+		}
+		if maxCost > 0 && c.Cost > maxCost {
+			break
+		}
+	}
+	return c
+}
+
+// ComplexityLimitMiddleware rejects the query which is deeper than the maxDepth or costs more than the maxCost,
+// a zero limit means no limit
+func ComplexityLimitMiddleware(schema *graphql.Schema, maxDepth, maxCost int) graphql.MiddlewareFunc {
+	return func(input *graphql.ComputationInput, next graphql.MiddlewareNextFunc) *graphql.ComputationOutput {
+		c := computeComplexity(schema, input.ParsedQuery, maxCost)
+		logrus.Infof("computed complexity of the %s %q: depth %d, cost %d", input.ParsedQuery.Kind, input.ParsedQuery.Name, c.Depth, c.Cost)
+
+		if (maxDepth > 0 && c.Depth > maxDepth) || (maxCost > 0 && c.Cost > maxCost) {
+			return &graphql.ComputationOutput{
+				Error: ComplexityLimitError{Complexity: c, MaxDepth: maxDepth, MaxCost: maxCost},
+			}
+		}
+
+		return next(input)
+	}
+}
+
+func computeSelectionComplexity(parent graphql.Type, sel *graphql.Selection, depth, maxCost int) Complexity {
+	c := Complexity{Depth: depth, Cost: 1}
+
+	obj, ok := unwrapType(parent).(*graphql.Object)
+	if !ok || sel.SelectionSet == nil {
+		return c
+	}
+	field, ok := obj.Fields[sel.Name]
+	if !ok {
+		return c
+	}
+
+	multiplier := 1
+	if isListType(field.Type) {
+		multiplier = getListSize(sel.Args)
+	}
+
+	for _, child := range flattenSelections(sel.SelectionSet) {
+		s := computeSelectionComplexity(field.Type, child, depth+1, maxCost)
+		c.Cost = saturatingAdd(c.Cost, saturatingMul(s.Cost, multiplier))
+		if s.Depth > c.Depth {
+			c.Depth = s.Depth
+		}
+		if maxCost > 0 && c.Cost > maxCost {
+			break
+		}
+	}
+	return c
+}
+
+// flattenSelections returns all selections of the selection set including selections of its fragments
+func flattenSelections(ss *graphql.SelectionSet) []*graphql.Selection {
+	if ss == nil {
+		return nil
+	}
+
+	sels := append([]*graphql.Selection{}, ss.Selections...)
+	for _, f := range ss.Fragments {
+		sels = append(sels, flattenSelections(f.SelectionSet)...)
+	}
+	return sels
+}
+
+// unwrapType returns the underlying object type of the list or non-null type
+func unwrapType(t graphql.Type) graphql.Type {
+	switch t := t.(type) {
+	case *graphql.List:
+		return unwrapType(t.Type)
+	case *graphql.NonNull:
+		return unwrapType(t.Type)
+	}
+	return t
+}
+
+func isListType(t graphql.Type) bool {
+	switch t := t.(type) {
+	case *graphql.List:
+		return true
+	case *graphql.NonNull:
+		return isListType(t.Type)
+	}
+	return false
+}
+
+// getListSize returns the "limit" argument of the field which is clamped to the MaxListSize,
+// the argument can be either the parsed struct or the raw map
+func getListSize(args interface{}) int {
+	var limit reflect.Value
+
+	switch v := reflect.ValueOf(args); v.Kind() {
+	case reflect.Map:
+		if m, ok := args.(map[string]interface{}); ok {
+			limit = reflect.ValueOf(m["limit"])
+		}
+	case reflect.Struct:
+		limit = v.FieldByName("Limit")
+	}
+
+	switch limit.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if limit.Int() > MaxListSize {
+			return MaxListSize
+		}
+		if limit.Int() > 0 {
+			return int(limit.Int())
+		}
+	case reflect.Float32, reflect.Float64:
+		if limit.Float() > MaxListSize {
+			return MaxListSize
+		}
+		if limit.Float() > 0 {
+			return int(limit.Float())
+		}
+	}
+	return DefaultListSize
+}
+
+// saturatingAdd returns a sum of the non-negative costs which is capped at the maxComplexityCost
+func saturatingAdd(a, b int) int {
+	if a > maxComplexityCost-b {
+		return maxComplexityCost
+	}
+	return a + b
+}
+
+// saturatingMul returns a product of the non-negative costs which is capped at the maxComplexityCost
+func saturatingMul(a, b int) int {
+	if a != 0 && b > maxComplexityCost/a {
+		return maxComplexityCost
+	}
+	return a * b
+}
@@ -0,0 +1,144 @@
+package graphql
+
+import (
+	"context"
+	"github.com/samsarahq/thunder/graphql"
+	"github.com/samsarahq/thunder/graphql/introspection"
+	"github.com/samsarahq/thunder/graphql/schemabuilder"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+type testPost struct{ Title string }
+
+func buildTestComplexitySchema() *graphql.Schema {
+	s := schemabuilder.NewSchema()
+
+	q := s.Query()
+	q.FieldFunc("posts", func(args struct{ Limit int64 }) []testPost { return nil })
+	q.FieldFunc("post", func() testPost { return testPost{} })
+
+	p := s.Object("Post", testPost{})
+	p.FieldFunc("related", func(p testPost) []testPost { return nil })
+	p.FieldFunc("children", func(p testPost, args struct{ Limit int64 }) []testPost { return nil })
+
+	schema := s.MustBuild()
+	introspection.AddIntrospectionToSchema(schema)
+	return schema
+}
+
+func parseTestQuery(t *testing.T, schema *graphql.Schema, src string) *graphql.Query {
+	query, err := graphql.Parse(src, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, graphql.PrepareQuery(schema.Query, query.SelectionSet))
+	return query
+}
+
+func TestComputeComplexity(t *testing.T) {
+	// Given
+	schema := buildTestComplexitySchema()
+
+	tests := map[string]struct {
+		query    string
+		expected Complexity
+	}{
+		"With single object field": {
+			query:    "{ post { title } }",
+			expected: Complexity{Depth: 2, Cost: 2},
+		},
+		"With limit argument on the list field": {
+			query:    "{ posts(limit: 5) { title } }",
+			expected: Complexity{Depth: 2, Cost: 6},
+		},
+		"With nested list fields": {
+			query:    "{ posts(limit: 5) { title related { title } } }",
+			expected: Complexity{Depth: 3, Cost: 1 + 5*(1+1+DefaultListSize)},
+		},
+		"With fragment": {
+			query:    "{ post { ...PostFragment } } fragment PostFragment on Post { title related { title } }",
+			expected: Complexity{Depth: 3, Cost: 1 + 1 + 1 + DefaultListSize},
+		},
+		"With limit argument over the maximum": {
+			query:    "{ posts(limit: 2147483647) { children(limit: 2147483647) { children(limit: 2147483647) { title } } } }",
+			expected: Complexity{Depth: 4, Cost: 1 + MaxListSize*(1+MaxListSize*(1+MaxListSize))},
+		},
+		"With deeply nested list fields which overflow the cost": {
+			query: "{ posts(limit: 2147483647) { children(limit: 2147483647) { children(limit: 2147483647) { " +
+				"children(limit: 2147483647) { children(limit: 2147483647) { children(limit: 2147483647) { " +
+				"children(limit: 2147483647) { children(limit: 2147483647) { title } } } } } } } } }",
+			expected: Complexity{Depth: 9, Cost: math.MaxInt32},
+		},
+		"With introspection query": {
+			query:    "{ __schema { types { name fields { name type { name ofType { name ofType { name } } } } } } }",
+			expected: Complexity{},
+		},
+	}
+
+	// When
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := ComputeComplexity(schema, parseTestQuery(t, schema, test.query))
+
+			// Then
+			assert.Equal(t, test.expected, c)
+		})
+	}
+}
+
+func TestComplexityLimitMiddleware(t *testing.T) {
+	// Given
+	schema := buildTestComplexitySchema()
+	next := func(input *graphql.ComputationInput) *graphql.ComputationOutput {
+		return &graphql.ComputationOutput{Current: "next"}
+	}
+
+	tests := map[string]struct {
+		query     string
+		maxDepth  int
+		maxCost   int
+		expectErr error
+	}{
+		"With the query under the limits": {
+			query:    "{ posts(limit: 5) { title } }",
+			maxDepth: 2,
+			maxCost:  6,
+		},
+		"With no limit": {
+			query: "{ posts(limit: 100) { related { related { title } } } }",
+		},
+		"When the query is too deep": {
+			query:     "{ posts(limit: 1) { related { related { title } } } }",
+			maxDepth:  3,
+			expectErr: ComplexityLimitError{Complexity: Complexity{Depth: 4, Cost: 1 + 1*(1+DefaultListSize*(1+DefaultListSize))}, MaxDepth: 3},
+		},
+		"When the nested limit arguments are too large": {
+			query:     "{ posts(limit: 2147483647) { children(limit: 2147483647) { children(limit: 2147483647) { title } } } }",
+			maxCost:   1000,
+			expectErr: ComplexityLimitError{Complexity: Complexity{Depth: 4, Cost: 1 + MaxListSize*(1+MaxListSize*(1+MaxListSize))}, MaxCost: 1000},
+		},
+		"When the query costs too much": {
+			query:     "{ posts(limit: 100) { title } }",
+			maxCost:   50,
+			expectErr: ComplexityLimitError{Complexity: Complexity{Depth: 2, Cost: 101}, MaxCost: 50},
+		},
+	}
+
+	// When
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			output := ComplexityLimitMiddleware(schema, test.maxDepth, test.maxCost)(&graphql.ComputationInput{
+				Ctx:         context.Background(),
+				ParsedQuery: parseTestQuery(t, schema, test.query),
+			}, next)
+
+			// Then
+			if test.expectErr != nil {
+				assert.Equal(t, test.expectErr, output.Error)
+			} else {
+				assert.Nil(t, output.Error)
+				assert.Equal(t, "next", output.Current)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package graphql
+
+import (
+	"github.com/samsarahq/thunder/graphql"
+	"github.com/sirupsen/logrus"
+	mgo "go.mongodb.org/mongo-driver/mongo"
+	"net/http"
+)
+
+// ErrorCode is a machine-readable code of the GraphQL error
+type ErrorCode string
+
+// List of error codes
+const (
+	CodeNotFound                 ErrorCode = "NOT_FOUND"
+	CodeForbidden                ErrorCode = "FORBIDDEN"
+	CodeUnauthenticated          ErrorCode = "UNAUTHENTICATED"
+	CodeValidationFailed         ErrorCode = "VALIDATION_FAILED"
+	CodeBadRequest               ErrorCode = "BAD_REQUEST"
+	CodeQueryTooComplex          ErrorCode = "QUERY_TOO_COMPLEX"
+	CodePersistedQueryNotFound   ErrorCode = "PERSISTED_QUERY_NOT_FOUND"
+	CodePersistedQueryNotAllowed ErrorCode = "PERSISTED_QUERY_NOT_ALLOWED"
+	CodeInternalServerError      ErrorCode = "INTERNAL_SERVER_ERROR"
+)
+
+// Error is a typed error which is safe to be sent to the client
+type Error struct {
+	Code    ErrorCode
+	Message string
+
+	// Field-level details of the validation error, keyed by the argument name
+	Fields map[string]string
+}
+
+// NewNotFoundError returns an error of the resource which does not exist
+func NewNotFoundError() *Error {
+	return &Error{Code: CodeNotFound, Message: http.StatusText(http.StatusNotFound)}
+}
+
+// NewForbiddenError returns an error of the resource which does not belong to the user
+func NewForbiddenError() *Error {
+	return &Error{Code: CodeForbidden, Message: http.StatusText(http.StatusForbidden)}
+}
+
+// NewUnauthenticatedError returns an error of the protected resource which requires the authorization token
+func NewUnauthenticatedError() *Error {
+	return &Error{Code: CodeUnauthenticated, Message: http.StatusText(http.StatusUnauthorized)}
+}
+
+// NewValidationError returns an error of the invalid arguments along with the reason of each argument
+func NewValidationError(fields map[string]string) *Error {
+	return &Error{Code: CodeValidationFailed, Message: http.StatusText(http.StatusBadRequest), Fields: fields}
+}
+
+// notFoundOrError returns the not found error only if the document does not exist in the database,
+// any other error will be returned as is and masked by the formatError later
+func notFoundOrError(err error) error {
+	if err == mgo.ErrNoDocuments {
+		return NewNotFoundError()
+	}
+	return err
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// SanitizedError allows the message to be sent to the client over the WebSocket connection
+func (e *Error) SanitizedError() string {
+	return e.Message
+}
+
+type errorResponse struct {
+	Message    string                 `json:"message"`
+	Extensions map[string]interface{} `json:"extensions"`
+}
+
+// formatError converts an error to the GraphQL error response,
+// an unexpected error will be masked and written to the application log instead
+func formatError(err error) errorResponse {
+	switch e := graphql.ErrorCause(err).(type) {
+	case *Error:
+		res := errorResponse{Message: e.Message, Extensions: map[string]interface{}{"code": e.Code}}
+		if len(e.Fields) > 0 {
+			res.Extensions["fields"] = e.Fields
+		}
+		return res
+
+	case ComplexityLimitError:
+		return errorResponse{Message: e.Error(), Extensions: map[string]interface{}{
+			"code":     CodeQueryTooComplex,
+			"depth":    e.Depth,
+			"cost":     e.Cost,
+			"maxDepth": e.MaxDepth,
+			"maxCost":  e.MaxCost,
+		}}
+
+	case graphql.SanitizedError:
+		return errorResponse{Message: e.SanitizedError(), Extensions: map[string]interface{}{"code": CodeBadRequest}}
+	}
+
+	logrus.Errorf("unable to execute the GraphQL query: %s", err)
+	return errorResponse{
+		Message:    http.StatusText(http.StatusInternalServerError),
+		Extensions: map[string]interface{}{"code": CodeInternalServerError},
+	}
+}
@@ -0,0 +1,54 @@
+package graphql
+
+import (
+	"errors"
+	"github.com/samsarahq/thunder/graphql"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFormatError(t *testing.T) {
+	// Given
+	tests := map[string]struct {
+		err      error
+		expected errorResponse
+	}{
+		"With typed error": {
+			err:      NewNotFoundError(),
+			expected: errorResponse{Message: "Not Found", Extensions: map[string]interface{}{"code": CodeNotFound}},
+		},
+		"With validation error": {
+			err: NewValidationError(map[string]string{"title": "must not be empty"}),
+			expected: errorResponse{Message: "Bad Request", Extensions: map[string]interface{}{
+				"code":   CodeValidationFailed,
+				"fields": map[string]string{"title": "must not be empty"},
+			}},
+		},
+		"With complexity limit error": {
+			err: ComplexityLimitError{Complexity: Complexity{Depth: 3, Cost: 20}, MaxDepth: 2, MaxCost: 10},
+			expected: errorResponse{Message: "query is too complex: depth 3 (max 2), cost 20 (max 10)", Extensions: map[string]interface{}{
+				"code":     CodeQueryTooComplex,
+				"depth":    3,
+				"cost":     20,
+				"maxDepth": 2,
+				"maxCost":  10,
+			}},
+		},
+		"With sanitized error": {
+			err:      graphql.NewClientError("test client error"),
+			expected: errorResponse{Message: "test client error", Extensions: map[string]interface{}{"code": CodeBadRequest}},
+		},
+		"With internal error": {
+			err:      errors.New("test unable to connect to the database"),
+			expected: errorResponse{Message: "Internal Server Error", Extensions: map[string]interface{}{"code": CodeInternalServerError}},
+		},
+	}
+
+	// When
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Then
+			assert.Equal(t, test.expected, formatError(test.err))
+		})
+	}
+}
@@ -1,14 +1,259 @@
 package graphql
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
+	"sync"
+	"time"
 
+	"github.com/gorilla/websocket"
+	"github.com/samsarahq/thunder/batch"
 	"github.com/samsarahq/thunder/graphql"
+	"github.com/samsarahq/thunder/reactive"
+	"github.com/sirupsen/logrus"
 )
 
-// Handler is a wrapped function to the original graphql.HTTPHandler for avoiding package name conflict
+// minRerunInterval is a minimum duration between each re-running of the same live query
+const minRerunInterval = time.Second * 5
+
+// Handler works the same as the original graphql.HTTPHandler except the errors,
+// which are written as objects with the error code in the "extensions" instead of plain strings
 func Handler(schema *graphql.Schema, middlewares ...graphql.MiddlewareFunc) http.Handler {
-	return graphql.HTTPHandler(schema, middlewares...)
+	return httpHandler{schema: schema, middlewares: middlewares}
+}
+
+type httpHandler struct {
+	schema      *graphql.Schema
+	middlewares []graphql.MiddlewareFunc
+}
+
+type httpRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type httpResponse struct {
+	Data   interface{}     `json:"data"`
+	Errors []errorResponse `json:"errors,omitempty"`
+}
+
+func (h httpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.Body == nil {
+		writeErrorResponse(w, &Error{Code: CodeBadRequest, Message: "request must be a POST with a query"})
+		return
+	}
+
+	var req httpRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeErrorResponse(w, &Error{Code: CodeBadRequest, Message: err.Error()})
+		return
+	}
+
+	query, err := graphql.Parse(req.Query, req.Variables)
+	if err != nil {
+		writeErrorResponse(w, &Error{Code: CodeBadRequest, Message: err.Error()})
+		return
+	}
+
+	schema := h.schema.Query
+	if query.Kind == "mutation" {
+		schema = h.schema.Mutation
+	}
+	if err := graphql.PrepareQuery(schema, query.SelectionSet); err != nil {
+		writeErrorResponse(w, &Error{Code: CodeBadRequest, Message: err.Error()})
+		return
+	}
+
+	var wg sync.WaitGroup
+	e := graphql.Executor{}
+
+	wg.Add(1)
+	runner := reactive.NewRerunner(r.Context(), func(ctx context.Context) (interface{}, error) {
+		defer wg.Done()
+
+		middlewares := append(append([]graphql.MiddlewareFunc{}, h.middlewares...), func(input *graphql.ComputationInput, next graphql.MiddlewareNextFunc) *graphql.ComputationOutput {
+			output := next(input)
+			output.Current, output.Error = e.Execute(input.Ctx, schema, nil, input.ParsedQuery)
+			return output
+		})
+
+		output := graphql.RunMiddlewares(middlewares, &graphql.ComputationInput{
+			Ctx:         batch.WithBatching(ctx),
+			ParsedQuery: query,
+			Query:       req.Query,
+			Variables:   req.Variables,
+		})
+		if output.Error != nil {
+			if graphql.ErrorCause(output.Error) == context.Canceled {
+				return nil, output.Error
+			}
+
+			writeResponse(w, httpResponse{Errors: []errorResponse{formatError(output.Error)}})
+			return nil, output.Error
+		}
+
+		writeResponse(w, httpResponse{Data: output.Current})
+		return nil, nil
+	}, graphql.DefaultMinRerunInterval)
+
+	wg.Wait()
+	runner.Stop()
+}
+
+func writeResponse(w http.ResponseWriter, res httpResponse) {
+	data, err := json.Marshal(res)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// writeErrorResponse writes an error which occurs before the query is executed
+func writeErrorResponse(w http.ResponseWriter, err error) {
+	writeResponse(w, httpResponse{Errors: []errorResponse{formatError(err)}})
+}
+
+// WebSocketHandler provides live queries over the WebSocket connection,
+// the connection is authorized once on the handshake request, so all queries on the connection share the same user.
+// The errors are sent with the same extensions as the HTTP handler in the "metadata" of the error message.
+func WebSocketHandler(schema *graphql.Schema, middlewares ...graphql.MiddlewareFunc) http.Handler {
+	upgrader := &websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		// The authorization relies on the access token rather than the cookie, so any origin is allowed
+		CheckOrigin: func(r *http.Request) bool {
+			return true
+		},
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		socket, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			logrus.Errorf("unable to upgrade to the WebSocket connection: %s", err)
+			return
+		}
+		defer socket.Close()
+
+		conn := graphql.CreateConnection(r.Context(), &jsonSocket{Conn: socket, schema: schema}, schema,
+			graphql.WithExecutionLogger(executionLogger{}),
+			graphql.WithMinRerunInterval(minRerunInterval),
+		)
+		conn.Use(formatErrorMiddleware)
+		for _, mw := range middlewares {
+			conn.Use(mw)
+		}
+		conn.ServeJSONSocket()
+	})
+}
+
+type socketMessage struct {
+	ID       string                 `json:"id,omitempty"`
+	Type     string                 `json:"type"`
+	Message  json.RawMessage        `json:"message,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// jsonSocket validates the query of every subscribe and mutate message before passing it to the connection,
+// since the connection sends a masked error without extensions if the query cannot be parsed
+type jsonSocket struct {
+	*websocket.Conn
+	schema *graphql.Schema
+
+	// The connection writes from many goroutines, the writes must not be interleaved with the validation errors
+	mu sync.Mutex
+}
+
+func (s *jsonSocket) ReadJSON(v interface{}) error {
+	for {
+		var data json.RawMessage
+		if err := s.Conn.ReadJSON(&data); err != nil {
+			return err
+		}
+
+		var msg socketMessage
+		if err := json.Unmarshal(data, &msg); err == nil {
+			if err = s.validate(msg); err != nil {
+				res := formatError(err)
+				message, _ := json.Marshal(res.Message)
+				if err = s.WriteJSON(socketMessage{
+					ID:       msg.ID,
+					Type:     "error",
+					Message:  message,
+					Metadata: map[string]interface{}{"extensions": res.Extensions},
+				}); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		return json.Unmarshal(data, v)
+	}
+}
+
+func (s *jsonSocket) WriteJSON(v interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Conn.WriteJSON(v)
+}
+
+func (s *jsonSocket) validate(msg socketMessage) error {
+	schema := s.schema.Query
+	switch msg.Type {
+	case "subscribe":
+	case "mutate":
+		schema = s.schema.Mutation
+	default:
+		return nil
+	}
+	if schema == nil {
+		return nil
+	}
+
+	var req httpRequest
+	if err := json.Unmarshal(msg.Message, &req); err != nil {
+		return &Error{Code: CodeBadRequest, Message: err.Error()}
+	}
+	query, err := graphql.Parse(req.Query, req.Variables)
+	if err != nil {
+		return &Error{Code: CodeBadRequest, Message: err.Error()}
+	}
+	if err = graphql.PrepareQuery(schema, query.SelectionSet); err != nil {
+		return &Error{Code: CodeBadRequest, Message: err.Error()}
+	}
+	return nil
+}
+
+// formattedError is a sanitized error of the live query which carries the extensions of the formatted error
+type formattedError struct{ errorResponse }
+
+func (e formattedError) Error() string {
+	return e.Message
+}
+
+func (e formattedError) SanitizedError() string {
+	return e.Message
+}
+
+// formatErrorMiddleware formats the error of the live query the same as the HTTP handler,
+// the extensions are sent along with the error message in the metadata
+func formatErrorMiddleware(input *graphql.ComputationInput, next graphql.MiddlewareNextFunc) *graphql.ComputationOutput {
+	output := next(input)
+	if output.Error == nil || graphql.ErrorCause(output.Error) == context.Canceled {
+		return output
+	}
+
+	res := formatError(output.Error)
+	if output.Metadata == nil {
+		output.Metadata = make(map[string]interface{})
+	}
+	output.Metadata["extensions"] = res.Extensions
+	output.Error = formattedError{res}
+	return output
 }
 
 // ServeGraphiqlHandlerFunc provides a GraphQL Playground page
@@ -19,3 +264,14 @@ func ServeGraphiqlHandlerFunc(tmpl []byte) http.HandlerFunc {
 		_, _ = w.Write(tmpl)
 	}
 }
+
+// executionLogger writes errors of the live queries to the application log
+type executionLogger struct{}
+
+func (executionLogger) StartExecution(context.Context, map[string]string, bool) {}
+
+func (executionLogger) FinishExecution(context.Context, map[string]string, time.Duration) {}
+
+func (executionLogger) Error(_ context.Context, err error, tags map[string]string) {
+	logrus.Errorf("unable to execute the live query %v: %s", tags, err)
+}
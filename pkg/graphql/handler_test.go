@@ -1,14 +1,23 @@
 package graphql
 
 import (
+	"encoding/json"
+	"errors"
 	"github.com/golang/mock/gomock"
+	"github.com/gorilla/websocket"
 	mock_http "github.com/nomkhonwaan/myblog/internal/http/mock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
 	mock_blog "github.com/nomkhonwaan/myblog/pkg/blog/mock"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
 	"github.com/nomkhonwaan/myblog/pkg/facebook"
 	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestHandler(t *testing.T) {
@@ -22,12 +31,13 @@ func TestHandler(t *testing.T) {
 		postRepository     = mock_blog.NewMockPostRepository(ctrl)
 		fileRepository     = mock_storage.NewMockFileRepository(ctrl)
 		transport          = mock_http.NewMockRoundTripper(ctrl)
+		bus                = eventbus.NewLocalBus()
 	)
 
 	s, _ := BuildSchema(
-		BuildCategorySchema(categoryRepository),
+		BuildCategorySchema(categoryRepository, bus),
 		BuildTagSchema(tagRepository),
-		BuildPostSchema(postRepository),
+		BuildPostSchema(postRepository, categoryRepository, NewPostValidator(categoryRepository, tagRepository, fileRepository), bus),
 		BuildFileSchema(fileRepository),
 		BuildGraphAPISchema("http://localhost", facebook.NewClient("", transport)),
 	)
@@ -38,6 +48,185 @@ func TestHandler(t *testing.T) {
 	// Then
 }
 
+func TestHandler_ServeHTTP(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		tagRepository = mock_blog.NewMockTagRepository(ctrl)
+	)
+
+	s, _ := BuildSchema(BuildTagSchema(tagRepository))
+	h := Handler(s, VerifyAuthorityMiddleware)
+
+	serve := func(method, body string) string {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(method, "/graphql", strings.NewReader(body)))
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		return w.Body.String()
+	}
+
+	t.Run("With successful executing the query", func(t *testing.T) {
+		// Given
+		tagRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Tag{{Name: "Test"}}, nil)
+
+		// When
+		body := serve(http.MethodPost, `{"query":"{ tags { name } }"}`)
+
+		// Then
+		assert.Equal(t, `{"data":{"tags":[{"name":"Test"}]}}`, body)
+	})
+
+	t.Run("With typed error", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+
+		tagRepository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Tag{}, NewNotFoundError())
+
+		// When
+		body := serve(http.MethodPost, `{"query":"{ tag(slug: \"test-`+id.Hex()+`\") { name } }"}`)
+
+		// Then
+		assert.Equal(t, `{"data":null,"errors":[{"message":"Not Found","extensions":{"code":"NOT_FOUND"}}]}`, body)
+	})
+
+	t.Run("When an unexpected error occurred", func(t *testing.T) {
+		// Given
+		tagRepository.EXPECT().FindAll(gomock.Any()).Return(nil, errors.New("test unable to connect to the database"))
+
+		// When
+		body := serve(http.MethodPost, `{"query":"{ tags { name } }"}`)
+
+		// Then
+		assert.Equal(t, `{"data":null,"errors":[{"message":"Internal Server Error","extensions":{"code":"INTERNAL_SERVER_ERROR"}}]}`, body)
+	})
+
+	t.Run("When the query is invalid", func(t *testing.T) {
+		// Given
+
+		// When
+		body := serve(http.MethodPost, `{"query":"{ unknown }"}`)
+
+		// Then
+		assert.Contains(t, body, `"code":"BAD_REQUEST"`)
+	})
+
+	t.Run("When the request is not a POST", func(t *testing.T) {
+		// Given
+
+		// When
+		body := serve(http.MethodGet, "")
+
+		// Then
+		assert.Equal(t, `{"data":null,"errors":[{"message":"request must be a POST with a query","extensions":{"code":"BAD_REQUEST"}}]}`, body)
+	})
+}
+
+func TestWebSocketHandler(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		tagRepository = mock_blog.NewMockTagRepository(ctrl)
+	)
+
+	tagRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Tag{{Name: "Test", Slug: "test"}}, nil)
+
+	s, _ := BuildSchema(BuildTagSchema(tagRepository))
+	server := httptest.NewServer(WebSocketHandler(s, VerifyAuthorityMiddleware))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+
+	// When
+	err = conn.WriteJSON(map[string]interface{}{
+		"id":      "1",
+		"type":    "subscribe",
+		"message": map[string]interface{}{"query": "{ tags { name } }", "variables": map[string]interface{}{}},
+	})
+
+	// Then
+	assert.Nil(t, err)
+	var res struct {
+		ID      string          `json:"id"`
+		Type    string          `json:"type"`
+		Message json.RawMessage `json:"message"`
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second * 5))
+	err = conn.ReadJSON(&res)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", res.ID)
+	assert.Equal(t, "update", res.Type)
+	assert.Contains(t, string(res.Message), `"name":"Test"`)
+}
+
+func TestWebSocketHandler_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		tagRepository = mock_blog.NewMockTagRepository(ctrl)
+	)
+
+	s, _ := BuildSchema(BuildTagSchema(tagRepository))
+	server := httptest.NewServer(WebSocketHandler(s, ComplexityLimitMiddleware(s, 1, 0)))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+
+	type errorMessage struct {
+		ID       string `json:"id"`
+		Type     string `json:"type"`
+		Message  string `json:"message"`
+		Metadata struct {
+			Extensions map[string]interface{} `json:"extensions"`
+		} `json:"metadata"`
+	}
+
+	tests := map[string]struct {
+		query    string
+		expected string
+	}{
+		"When the query is too complex": {
+			query:    "{ tags { name } }",
+			expected: string(CodeQueryTooComplex),
+		},
+		"With invalid query": {
+			query:    "{ tags { unknown } }",
+			expected: string(CodeBadRequest),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Given
+
+			// When
+			err := conn.WriteJSON(map[string]interface{}{
+				"id":      name,
+				"type":    "subscribe",
+				"message": map[string]interface{}{"query": test.query, "variables": map[string]interface{}{}},
+			})
+
+			// Then
+			assert.Nil(t, err)
+			var res errorMessage
+			_ = conn.SetReadDeadline(time.Now().Add(time.Second * 5))
+			err = conn.ReadJSON(&res)
+			assert.Nil(t, err)
+			assert.Equal(t, name, res.ID)
+			assert.Equal(t, "error", res.Type)
+			assert.NotEmpty(t, res.Message)
+			assert.Equal(t, test.expected, res.Metadata.Extensions["code"])
+		})
+	}
+}
+
 func TestServeGraphiqlHandlerFunc(t *testing.T) {
 	// Given
 	w := httptest.NewRecorder()
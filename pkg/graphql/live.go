@@ -0,0 +1,75 @@
+package graphql
+
+import (
+	"context"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
+	"github.com/samsarahq/thunder/reactive"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"sync"
+	"time"
+)
+
+// engagementRefreshInterval is a duration between each re-fetching of the engagement on a live query
+const engagementRefreshInterval = time.Minute
+
+// LivePosts keeps track of posts which are being watched by live queries,
+// an invalidated post will cause all live queries that depend on it to be re-run
+type LivePosts struct {
+	mu        sync.Mutex
+	resources map[primitive.ObjectID]*reactive.Resource
+}
+
+// NewLivePosts returns a new LivePosts
+func NewLivePosts() *LivePosts {
+	return &LivePosts{resources: make(map[primitive.ObjectID]*reactive.Resource)}
+}
+
+// Watch makes the current live query depend on the post, does nothing on a non-live query
+func (l *LivePosts) Watch(ctx context.Context, id primitive.ObjectID) {
+	if !reactive.HasRerunner(ctx) {
+		return
+	}
+
+	l.mu.Lock()
+	r, ok := l.resources[id]
+	if !ok {
+		r = reactive.NewResource()
+		r.Cleanup(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			if l.resources[id] == r {
+				delete(l.resources, id)
+			}
+		})
+		l.resources[id] = r
+	}
+	l.mu.Unlock()
+
+	reactive.AddDependency(ctx, r, nil)
+}
+
+// Invalidate re-runs all live queries which depend on the post
+func (l *LivePosts) Invalidate(id primitive.ObjectID) {
+	l.mu.Lock()
+	r, ok := l.resources[id]
+	delete(l.resources, id)
+	l.mu.Unlock()
+
+	if ok {
+		r.Invalidate()
+	}
+}
+
+// Subscribe invalidates the post on every post changing event
+func (l *LivePosts) Subscribe(bus eventbus.Bus) {
+	bus.Subscribe(blog.PostPublished{}, func(_ context.Context, e eventbus.Event) {
+		l.Invalidate(e.(blog.PostPublished).Post.ID)
+	})
+	bus.Subscribe(blog.PostUnpublished{}, func(_ context.Context, e eventbus.Event) {
+		l.Invalidate(e.(blog.PostUnpublished).Post.ID)
+	})
+	bus.Subscribe(blog.PostContentChanged{}, func(_ context.Context, e eventbus.Event) {
+		l.Invalidate(e.(blog.PostContentChanged).Post.ID)
+	})
+}
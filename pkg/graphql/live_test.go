@@ -0,0 +1,74 @@
+package graphql
+
+import (
+	"context"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
+	"github.com/samsarahq/thunder/reactive"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+	"time"
+)
+
+func TestLivePosts(t *testing.T) {
+	t.Run("With non-live query", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+		live := NewLivePosts()
+
+		// When
+		live.Watch(context.Background(), id)
+
+		// Then
+		assert.Len(t, live.resources, 0)
+	})
+
+	t.Run("With live query which depends on the changed post", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+		live := NewLivePosts()
+		bus := eventbus.NewLocalBus()
+		live.Subscribe(bus)
+
+		runCh := make(chan struct{}, 2)
+		rerunner := reactive.NewRerunner(context.Background(), func(ctx context.Context) (interface{}, error) {
+			live.Watch(ctx, id)
+			runCh <- struct{}{}
+			return nil, nil
+		}, 0)
+		defer rerunner.Stop()
+		waitForRun(t, runCh)
+
+		// When
+		bus.Publish(context.Background(), blog.PostContentChanged{Post: blog.Post{ID: primitive.NewObjectID()}})
+		bus.Publish(context.Background(), blog.PostPublished{Post: blog.Post{ID: id}})
+
+		// Then
+		waitForRun(t, runCh)
+		select {
+		case <-runCh:
+			t.Fatal("the live query should be re-run only once")
+		case <-time.After(time.Millisecond * 100):
+		}
+	})
+
+	t.Run("When invalidate the post which is not being watched", func(t *testing.T) {
+		// Given
+		live := NewLivePosts()
+
+		// When
+		live.Invalidate(primitive.NewObjectID())
+
+		// Then
+		assert.Len(t, live.resources, 0)
+	})
+}
+
+func waitForRun(t *testing.T, runCh <-chan struct{}) {
+	select {
+	case <-runCh:
+	case <-time.After(time.Second):
+		t.Fatal("the live query has not been run")
+	}
+}
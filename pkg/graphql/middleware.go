@@ -2,10 +2,8 @@ package graphql
 
 import (
 	"context"
-	"errors"
 	"github.com/nomkhonwaan/myblog/pkg/auth"
 	"github.com/samsarahq/thunder/graphql"
-	"net/http"
 )
 
 // AuthorizedID is a context.Context key where an authorized ID value stored
@@ -23,17 +21,26 @@ var (
 		"updatePostFeaturedImage": true,
 		"updatePostAttachments":   true,
 	}
+
+	// adminResources are only accessible by the blog owners since they are not owned by any user
+	adminResources = map[string]bool{
+		"updateCategoryParent": true,
+		"webhooks":             true,
+		"webhookDeliveries":    true,
+		"createWebhook":        true,
+		"deleteWebhook":        true,
+	}
 )
 
 // VerifyAuthorityMiddleware looks on the request header for the authorization token
 func VerifyAuthorityMiddleware(input *graphql.ComputationInput, next graphql.MiddlewareNextFunc) *graphql.ComputationOutput {
 	authID := auth.GetAuthorizedUserID(input.Ctx)
 
-	for _, sel := range input.ParsedQuery.Selections {
+	for _, sel := range rootSelections(input.ParsedQuery.SelectionSet) {
 		if yes := protectedResources[sel.Name]; yes {
 			if authID == nil {
 				return &graphql.ComputationOutput{
-					Error: errors.New(http.StatusText(http.StatusUnauthorized)),
+					Error: NewUnauthenticatedError(),
 				}
 			}
 		}
@@ -42,3 +49,45 @@ func VerifyAuthorityMiddleware(input *graphql.ComputationInput, next graphql.Mid
 	input.Ctx = context.WithValue(input.Ctx, AuthorizedID, authID)
 	return next(input)
 }
+
+// VerifyAdminMiddleware allows only the blog owners who are listed in the admin IDs to access the admin resources
+func VerifyAdminMiddleware(adminIDs []string) graphql.MiddlewareFunc {
+	admins := make(map[string]bool, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = true
+	}
+
+	return func(input *graphql.ComputationInput, next graphql.MiddlewareNextFunc) *graphql.ComputationOutput {
+		authID := auth.GetAuthorizedUserID(input.Ctx)
+
+		for _, sel := range rootSelections(input.ParsedQuery.SelectionSet) {
+			if yes := adminResources[sel.Name]; yes {
+				if authID == nil {
+					return &graphql.ComputationOutput{
+						Error: NewUnauthenticatedError(),
+					}
+				}
+				if id, ok := authID.(string); !ok || !admins[id] {
+					return &graphql.ComputationOutput{
+						Error: NewForbiddenError(),
+					}
+				}
+			}
+		}
+
+		return next(input)
+	}
+}
+
+// rootSelections returns all root fields of the query including the fields which are selected through fragments
+func rootSelections(ss *graphql.SelectionSet) []*graphql.Selection {
+	if ss == nil {
+		return nil
+	}
+
+	sels := ss.Selections
+	for _, f := range ss.Fragments {
+		sels = append(sels, rootSelections(f.SelectionSet)...)
+	}
+	return sels
+}
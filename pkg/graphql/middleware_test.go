@@ -53,3 +53,86 @@ func TestVerifyAuthorityMiddleware(t *testing.T) {
 		assert.EqualError(t, output.Error, "Unauthorized")
 	})
 }
+
+func TestVerifyAdminMiddleware(t *testing.T) {
+	middleware := VerifyAdminMiddleware([]string{"github|1"})
+	newInput := func(authID string, ss *graphql.SelectionSet) *graphql.ComputationInput {
+		ctx := context.Background()
+		if authID != "" {
+			ctx = context.WithValue(ctx, auth.UserProperty, &jwt.Token{Claims: jwt.MapClaims{"sub": authID}})
+		}
+		return &graphql.ComputationInput{Ctx: ctx, ParsedQuery: &graphql.Query{SelectionSet: ss}}
+	}
+	next := func(input *graphql.ComputationInput) *graphql.ComputationOutput {
+		return &graphql.ComputationOutput{}
+	}
+
+	t.Run("With the blog owner", func(t *testing.T) {
+		// Given
+		input := newInput("github|1", &graphql.SelectionSet{Selections: []*graphql.Selection{{Name: "webhooks"}}})
+
+		// When
+		output := middleware(input, next)
+
+		// Then
+		assert.Nil(t, output.Error)
+	})
+
+	t.Run("With another authenticated user", func(t *testing.T) {
+		// Given
+		input := newInput("github|2", &graphql.SelectionSet{Selections: []*graphql.Selection{{Name: "createWebhook"}}})
+
+		// When
+		output := middleware(input, next)
+
+		// Then
+		assert.EqualError(t, output.Error, "Forbidden")
+	})
+
+	t.Run("With another authenticated user changing the category parent", func(t *testing.T) {
+		// Given
+		input := newInput("github|2", &graphql.SelectionSet{Selections: []*graphql.Selection{{Name: "updateCategoryParent"}}})
+
+		// When
+		output := middleware(input, next)
+
+		// Then
+		assert.EqualError(t, output.Error, "Forbidden")
+	})
+
+	t.Run("With another authenticated user selecting through a fragment", func(t *testing.T) {
+		// Given
+		input := newInput("github|2", &graphql.SelectionSet{Fragments: []*graphql.Fragment{{
+			On:           "Query",
+			SelectionSet: &graphql.SelectionSet{Selections: []*graphql.Selection{{Name: "webhookDeliveries"}}},
+		}}})
+
+		// When
+		output := middleware(input, next)
+
+		// Then
+		assert.EqualError(t, output.Error, "Forbidden")
+	})
+
+	t.Run("With unauthenticated request", func(t *testing.T) {
+		// Given
+		input := newInput("", &graphql.SelectionSet{Selections: []*graphql.Selection{{Name: "deleteWebhook"}}})
+
+		// When
+		output := middleware(input, next)
+
+		// Then
+		assert.EqualError(t, output.Error, "Unauthorized")
+	})
+
+	t.Run("With non-admin resources", func(t *testing.T) {
+		// Given
+		input := newInput("github|2", &graphql.SelectionSet{Selections: []*graphql.Selection{{Name: "createPost"}}})
+
+		// When
+		output := middleware(input, next)
+
+		// Then
+		assert.Nil(t, output.Error)
+	})
+}
@@ -0,0 +1,205 @@
+package graphql
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"github.com/samsarahq/thunder/graphql"
+	"github.com/sirupsen/logrus"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	// PersistedQueryNotFound is an error message which tells the client to re-send the query document along with its hash
+	PersistedQueryNotFound = "PersistedQueryNotFound"
+
+	// PersistedQueryNotAllowed is an error message which returns when the query is not in the allow-list
+	PersistedQueryNotAllowed = "PersistedQueryNotAllowed"
+
+	// PersistedQueryHashMismatch is an error message which returns when the hash does not belong to the query document
+	PersistedQueryHashMismatch = "provided sha does not match query"
+
+	persistedQueriesCachePath = "graphql/persisted-queries"
+
+	// maxPersistedQuerySize is a maximum size in bytes of the query document which will be stored on the cache,
+	// a larger query is still executed but the client has to send the query document every time
+	maxPersistedQuerySize = 8 * 1024
+)
+
+var (
+	sha256HashRegExp = regexp.MustCompile(`^[0-9a-f]{64}$`)
+)
+
+// PersistedQueries is a list of registered query documents keyed by their hex-encoded SHA-256 hash
+type PersistedQueries map[string]string
+
+// LoadPersistedQueries reads the JSON object of registered query documents, a hash which does not belong to its query is an error
+func LoadPersistedQueries(r io.Reader) (PersistedQueries, error) {
+	var raw map[string]string
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	queries := make(PersistedQueries, len(raw))
+	for hash, query := range raw {
+		if HashQuery(query) != strings.ToLower(hash) {
+			return nil, fmt.Errorf("persisted query %s: %s", hash, PersistedQueryHashMismatch)
+		}
+		queries[strings.ToLower(hash)] = query
+	}
+	return queries, nil
+}
+
+// HashQuery returns a hex-encoded SHA-256 hash of the query document
+func HashQuery(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+type persistedQueryRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+
+	Extensions struct {
+		PersistedQuery *struct {
+			Version    int    `json:"version"`
+			SHA256Hash string `json:"sha256Hash"`
+		} `json:"persistedQuery"`
+	} `json:"extensions"`
+}
+
+// PersistedQueryMiddleware resolves the query document of the request which sends only the hash,
+// an unknown hash will be stored on the cache once the client re-sends it along with the query document.
+//
+// On the allow-list mode, only registered queries will be executed and nothing will be stored.
+func PersistedQueryMiddleware(cache storage.Cache, registered PersistedQueries, allowListOnly bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.Body == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				writeErrorResponse(w, &Error{Code: CodeBadRequest, Message: err.Error()})
+				return
+			}
+
+			var req persistedQueryRequest
+			if err := json.Unmarshal(body, &req); err != nil {
+				writeErrorResponse(w, &Error{Code: CodeBadRequest, Message: err.Error()})
+				return
+			}
+
+			var hash string
+			if pq := req.Extensions.PersistedQuery; pq != nil {
+				hash = strings.ToLower(pq.SHA256Hash)
+				if !sha256HashRegExp.MatchString(hash) {
+					writeErrorResponse(w, &Error{Code: CodePersistedQueryNotFound, Message: PersistedQueryNotFound})
+					return
+				}
+			}
+
+			switch {
+			case hash == "" && !allowListOnly:
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+				next.ServeHTTP(w, r)
+				return
+
+			case hash == "":
+				hash = HashQuery(req.Query)
+				fallthrough
+
+			case req.Query != "":
+				if HashQuery(req.Query) != hash {
+					writeErrorResponse(w, &Error{Code: CodeBadRequest, Message: PersistedQueryHashMismatch})
+					return
+				}
+				if _, ok := registered[hash]; !ok {
+					if allowListOnly {
+						writeErrorResponse(w, &Error{Code: CodePersistedQueryNotAllowed, Message: PersistedQueryNotAllowed})
+						return
+					}
+					if len(req.Query) <= maxPersistedQuerySize {
+						storePersistedQuery(cache, hash, req.Query)
+					}
+				}
+
+			default:
+				query, ok := findPersistedQuery(cache, registered, hash, allowListOnly)
+				if !ok {
+					writeErrorResponse(w, &Error{Code: CodePersistedQueryNotFound, Message: PersistedQueryNotFound})
+					return
+				}
+				req.Query = query
+			}
+
+			body, _ = json.Marshal(map[string]interface{}{"query": req.Query, "variables": req.Variables})
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			r.ContentLength = int64(len(body))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// AllowListMiddleware rejects the query which is not registered on the allow-list mode,
+// the WebSocket connection does not go through the PersistedQueryMiddleware so its queries are checked here instead
+func AllowListMiddleware(registered PersistedQueries, allowListOnly bool) graphql.MiddlewareFunc {
+	return func(input *graphql.ComputationInput, next graphql.MiddlewareNextFunc) *graphql.ComputationOutput {
+		if allowListOnly {
+			if _, ok := registered[HashQuery(input.Query)]; !ok {
+				return &graphql.ComputationOutput{
+					Error: &Error{Code: CodePersistedQueryNotAllowed, Message: PersistedQueryNotAllowed},
+				}
+			}
+		}
+		return next(input)
+	}
+}
+
+func findPersistedQuery(cache storage.Cache, registered PersistedQueries, hash string, allowListOnly bool) (string, bool) {
+	if query, ok := registered[hash]; ok {
+		return query, true
+	}
+	if allowListOnly {
+		return "", false
+	}
+
+	path := persistedQueryCachePath(hash)
+	if !cache.Exists(path) {
+		return "", false
+	}
+	body, err := cache.Retrieve(path)
+	if err != nil {
+		logrus.Errorf("unable to retrieve persisted query %s from cache: %s", hash, err)
+		return "", false
+	}
+	defer body.Close()
+
+	query, err := ioutil.ReadAll(body)
+	if err != nil {
+		logrus.Errorf("unable to read persisted query %s from cache: %s", hash, err)
+		return "", false
+	}
+	return string(query), true
+}
+
+func storePersistedQuery(cache storage.Cache, hash, query string) {
+	if err := cache.Store(strings.NewReader(query), persistedQueryCachePath(hash)); err != nil {
+		logrus.Errorf("unable to store persisted query %s to cache: %s", hash, err)
+	}
+}
+
+// persistedQueryCachePath returns a cache file path of the hash, the hash must be a valid SHA-256 hex string
+func persistedQueryCachePath(hash string) string {
+	return filepath.Join(persistedQueriesCachePath, hash+".graphql")
+}
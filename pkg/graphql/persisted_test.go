@@ -0,0 +1,264 @@
+package graphql
+
+import (
+	"bytes"
+	"errors"
+	"github.com/golang/mock/gomock"
+	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
+	"github.com/samsarahq/thunder/graphql"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadPersistedQueries(t *testing.T) {
+	t.Run("With valid persisted queries", func(t *testing.T) {
+		// Given
+		query := "{ tags { name } }"
+		hash := HashQuery(query)
+
+		// When
+		queries, err := LoadPersistedQueries(strings.NewReader(`{"` + strings.ToUpper(hash) + `":"` + query + `"}`))
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, PersistedQueries{hash: query}, queries)
+	})
+
+	t.Run("When the hash does not belong to the query", func(t *testing.T) {
+		// Given
+		hash := HashQuery("{ categories { name } }")
+
+		// When
+		_, err := LoadPersistedQueries(strings.NewReader(`{"` + hash + `":"{ tags { name } }"}`))
+
+		// Then
+		assert.EqualError(t, err, "persisted query "+hash+": "+PersistedQueryHashMismatch)
+	})
+
+	t.Run("When unable to decode persisted queries", func(t *testing.T) {
+		// Given
+
+		// When
+		_, err := LoadPersistedQueries(strings.NewReader(`[]`))
+
+		// Then
+		assert.NotNil(t, err)
+	})
+}
+
+func TestPersistedQueryMiddleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		cache = mock_storage.NewMockCache(ctrl)
+	)
+
+	registeredQuery := "{ categories { name } }"
+	registered := PersistedQueries{HashQuery(registeredQuery): registeredQuery}
+
+	query := "{ tags { name } }"
+	hash := HashQuery(query)
+	cachePath := filepath.Join("graphql/persisted-queries", hash+".graphql")
+
+	serve := func(allowListOnly bool, body string) (*httptest.ResponseRecorder, string) {
+		var received string
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			data, _ := ioutil.ReadAll(r.Body)
+			received = string(data)
+		})
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+		PersistedQueryMiddleware(cache, registered, allowListOnly)(next).ServeHTTP(w, r)
+		return w, received
+	}
+
+	withHash := func(hash string) string {
+		return `"extensions":{"persistedQuery":{"version":1,"sha256Hash":"` + hash + `"}}`
+	}
+
+	t.Run("With non-persisted query", func(t *testing.T) {
+		// Given
+		body := `{"query":"` + query + `"}`
+
+		// When
+		_, received := serve(false, body)
+
+		// Then
+		assert.Equal(t, body, received)
+	})
+
+	t.Run("With registered query hash", func(t *testing.T) {
+		// Given
+
+		// When
+		_, received := serve(false, `{"variables":{"slug":"test"},`+withHash(HashQuery(registeredQuery))+`}`)
+
+		// Then
+		assert.Equal(t, `{"query":"`+registeredQuery+`","variables":{"slug":"test"}}`, received)
+	})
+
+	t.Run("With cached query hash", func(t *testing.T) {
+		// Given
+		cache.EXPECT().Exists(cachePath).Return(true)
+		cache.EXPECT().Retrieve(cachePath).Return(ioutil.NopCloser(bytes.NewBufferString(query)), nil)
+
+		// When
+		_, received := serve(false, `{`+withHash(hash)+`}`)
+
+		// Then
+		assert.Equal(t, `{"query":"`+query+`","variables":null}`, received)
+	})
+
+	t.Run("With query document along with its hash", func(t *testing.T) {
+		// Given
+		cache.EXPECT().Store(gomock.Any(), cachePath).DoAndReturn(func(body *strings.Reader, _ string) error {
+			data, _ := ioutil.ReadAll(body)
+			assert.Equal(t, query, string(data))
+			return nil
+		})
+
+		// When
+		_, received := serve(false, `{"query":"`+query+`",`+withHash(hash)+`}`)
+
+		// Then
+		assert.Equal(t, `{"query":"`+query+`","variables":null}`, received)
+	})
+
+	t.Run("With a query document which is too large to be stored", func(t *testing.T) {
+		// Given
+		largeQuery := "{ tags { name } }" + strings.Repeat(" ", maxPersistedQuerySize)
+
+		// When
+		_, received := serve(false, `{"query":"`+largeQuery+`",`+withHash(HashQuery(largeQuery))+`}`)
+
+		// Then
+		assert.Equal(t, `{"query":"`+largeQuery+`","variables":null}`, received)
+	})
+
+	t.Run("When the query hash is not found", func(t *testing.T) {
+		// Given
+		cache.EXPECT().Exists(cachePath).Return(false)
+
+		// When
+		w, received := serve(false, `{`+withHash(hash)+`}`)
+
+		// Then
+		assert.Equal(t, "", received)
+		assert.Equal(t, `{"data":null,"errors":[{"message":"PersistedQueryNotFound","extensions":{"code":"PERSISTED_QUERY_NOT_FOUND"}}]}`, w.Body.String())
+	})
+
+	t.Run("When unable to retrieve the cached query", func(t *testing.T) {
+		// Given
+		cache.EXPECT().Exists(cachePath).Return(true)
+		cache.EXPECT().Retrieve(cachePath).Return(nil, errors.New("test unable to retrieve the cached query"))
+
+		// When
+		w, _ := serve(false, `{`+withHash(hash)+`}`)
+
+		// Then
+		assert.Equal(t, `{"data":null,"errors":[{"message":"PersistedQueryNotFound","extensions":{"code":"PERSISTED_QUERY_NOT_FOUND"}}]}`, w.Body.String())
+	})
+
+	t.Run("When the hash is not a valid SHA-256 hash", func(t *testing.T) {
+		// Given
+
+		// When
+		w, received := serve(false, `{`+withHash("../../etc/passwd")+`}`)
+
+		// Then
+		assert.Equal(t, "", received)
+		assert.Equal(t, `{"data":null,"errors":[{"message":"PersistedQueryNotFound","extensions":{"code":"PERSISTED_QUERY_NOT_FOUND"}}]}`, w.Body.String())
+	})
+
+	t.Run("When the hash does not belong to the query", func(t *testing.T) {
+		// Given
+
+		// When
+		w, received := serve(false, `{"query":"`+query+`",`+withHash(HashQuery(registeredQuery))+`}`)
+
+		// Then
+		assert.Equal(t, "", received)
+		assert.Equal(t, `{"data":null,"errors":[{"message":"provided sha does not match query","extensions":{"code":"BAD_REQUEST"}}]}`, w.Body.String())
+	})
+
+	t.Run("With registered query on the allow-list mode", func(t *testing.T) {
+		// Given
+
+		// When
+		_, received := serve(true, `{"query":"`+registeredQuery+`"}`)
+
+		// Then
+		assert.Equal(t, `{"query":"`+registeredQuery+`","variables":null}`, received)
+	})
+
+	t.Run("When the query is not registered on the allow-list mode", func(t *testing.T) {
+		// Given
+
+		// When
+		w, received := serve(true, `{"query":"`+query+`",`+withHash(hash)+`}`)
+
+		// Then
+		assert.Equal(t, "", received)
+		assert.Equal(t, `{"data":null,"errors":[{"message":"PersistedQueryNotAllowed","extensions":{"code":"PERSISTED_QUERY_NOT_ALLOWED"}}]}`, w.Body.String())
+	})
+
+	t.Run("When the query hash is not registered on the allow-list mode", func(t *testing.T) {
+		// Given
+
+		// When
+		w, received := serve(true, `{`+withHash(hash)+`}`)
+
+		// Then
+		assert.Equal(t, "", received)
+		assert.Equal(t, `{"data":null,"errors":[{"message":"PersistedQueryNotFound","extensions":{"code":"PERSISTED_QUERY_NOT_FOUND"}}]}`, w.Body.String())
+	})
+}
+
+func TestAllowListMiddleware(t *testing.T) {
+	registeredQuery := "{ categories { name } }"
+	registered := PersistedQueries{HashQuery(registeredQuery): registeredQuery}
+
+	next := func(input *graphql.ComputationInput) *graphql.ComputationOutput {
+		return &graphql.ComputationOutput{}
+	}
+
+	t.Run("With registered query on the allow-list mode", func(t *testing.T) {
+		// Given
+		input := &graphql.ComputationInput{Query: registeredQuery}
+
+		// When
+		output := AllowListMiddleware(registered, true)(input, next)
+
+		// Then
+		assert.Nil(t, output.Error)
+	})
+
+	t.Run("When the query is not registered on the allow-list mode", func(t *testing.T) {
+		// Given
+		input := &graphql.ComputationInput{Query: "{ tags { name } }"}
+
+		// When
+		output := AllowListMiddleware(registered, true)(input, next)
+
+		// Then
+		assert.EqualError(t, output.Error, PersistedQueryNotAllowed)
+	})
+
+	t.Run("When the allow-list mode is disabled", func(t *testing.T) {
+		// Given
+		input := &graphql.ComputationInput{Query: "{ tags { name } }"}
+
+		// When
+		output := AllowListMiddleware(registered, false)(input, next)
+
+		// Then
+		assert.Nil(t, output.Error)
+	})
+}
@@ -2,19 +2,25 @@ package graphql
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"github.com/nomkhonwaan/myblog/pkg/analytics"
 	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
 	"github.com/nomkhonwaan/myblog/pkg/facebook"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
 	slugify "github.com/nomkhonwaan/myblog/pkg/slug"
 	"github.com/nomkhonwaan/myblog/pkg/storage"
 	"github.com/nomkhonwaan/myblog/pkg/timeutil"
+	"github.com/nomkhonwaan/myblog/pkg/webhook"
 	"github.com/russross/blackfriday/v2"
+	"github.com/samsarahq/thunder/batch"
 	"github.com/samsarahq/thunder/graphql"
 	"github.com/samsarahq/thunder/graphql/schemabuilder"
+	"github.com/samsarahq/thunder/reactive"
 	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"net/http"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -28,14 +34,25 @@ func BuildSchema(buildSchemaFunc ...func(*schemabuilder.Schema)) (*graphql.Schem
 }
 
 // BuildCategorySchema builds all category related schemas
-func BuildCategorySchema(repository blog.CategoryRepository) func(*schemabuilder.Schema) {
+func BuildCategorySchema(repository blog.CategoryRepository, bus eventbus.Bus) func(*schemabuilder.Schema) {
 	return func(s *schemabuilder.Schema) {
 		q := s.Query()
 		q.FieldFunc("category", FindCategoryBySlugFieldFunc(repository))
 		q.FieldFunc("categories", FindAllCategoriesFieldFunc(repository))
 
+		m := s.Mutation()
+		m.FieldFunc("updateCategoryParent", UpdateCategoryParentFieldFunc(repository, bus))
+
+		categoryTree := NewCategoryTreeBatchFunc(repository)
+		c := s.Object("Category", blog.Category{})
+		c.FieldFunc("parent", FindParentOfCategoryFieldFunc(categoryTree))
+		c.FieldFunc("children", FindAllChildrenOfCategoryFieldFunc(categoryTree))
+		c.FieldFunc("ancestors", FindAllAncestorsOfCategoryFieldFunc(categoryTree))
+		c.FieldFunc("permalink", GetCategoryPermalinkFieldFunc(categoryTree))
+
 		p := s.Object("Post", blog.Post{})
-		p.FieldFunc("categories", FindAllCategoriesBelongedToPostFieldFunc(repository))
+		p.FieldFunc("categories", FindAllCategoriesBelongedToPostFieldFunc(NewCategoriesBatchFunc(repository)))
+		p.FieldFunc("breadcrumbs", FindAllBreadcrumbsOfPostFieldFunc(categoryTree))
 	}
 }
 
@@ -47,42 +64,52 @@ func BuildTagSchema(repository blog.TagRepository) func(*schemabuilder.Schema) {
 		q.FieldFunc("tags", FindAllTagsFieldFunc(repository))
 
 		p := s.Object("Post", blog.Post{})
-		p.FieldFunc("tags", FindAllTagsBelongedToPostFieldFunc(repository))
+		p.FieldFunc("tags", FindAllTagsBelongedToPostFieldFunc(NewTagsBatchFunc(repository)))
 	}
 }
 
 // BuildPostSchema builds all post related schemas
-func BuildPostSchema(repository blog.PostRepository) func(*schemabuilder.Schema) {
+func BuildPostSchema(repository blog.PostRepository, categoryRepository blog.CategoryRepository, validator PostValidator, bus eventbus.Bus) func(*schemabuilder.Schema) {
+	live := NewLivePosts()
+	live.Subscribe(bus)
+
 	return func(s *schemabuilder.Schema) {
 		q := s.Query()
 		q.FieldFunc("latestPublishedPosts", FindAllLatestPublishedPostsFieldFunc(repository))
 		q.FieldFunc("myPosts", FindAllMyPostsFieldFunc(repository))
-		q.FieldFunc("post", FindPostBySlugFieldFunc(repository))
+		q.FieldFunc("post", FindPostBySlugFieldFunc(repository, live))
 
 		m := s.Mutation()
 		m.FieldFunc("createPost", CreatePostFieldFunc(repository))
-		m.FieldFunc("updatePostTitle", UpdatePostTitleFieldFunc(repository))
-		m.FieldFunc("updatePostStatus", UpdatePostStatusFieldFunc(repository))
-		m.FieldFunc("updatePostContent", UpdatePostContentFieldFunc(repository))
-		m.FieldFunc("updatePostCategories", UpdatePostCategoriesFieldFunc(repository))
-		m.FieldFunc("updatePostTags", UpdatePostTagsFieldFunc(repository))
-		m.FieldFunc("updatePostFeaturedImage", UpdatePostFeaturedImageFieldFunc(repository))
-		m.FieldFunc("updatePostAttachments", UpdatePostAttachmentsFieldFunc(repository))
+		m.FieldFunc("updatePostTitle", UpdatePostTitleFieldFunc(repository, validator, bus))
+		m.FieldFunc("updatePostStatus", UpdatePostStatusFieldFunc(repository, validator, bus))
+		m.FieldFunc("updatePostContent", UpdatePostContentFieldFunc(repository, validator, bus))
+		m.FieldFunc("updatePostCategories", UpdatePostCategoriesFieldFunc(repository, validator, bus))
+		m.FieldFunc("updatePostTags", UpdatePostTagsFieldFunc(repository, validator, bus))
+		m.FieldFunc("updatePostFeaturedImage", UpdatePostFeaturedImageFieldFunc(repository, validator, bus))
+		m.FieldFunc("updatePostAttachments", UpdatePostAttachmentsFieldFunc(repository, validator, bus))
 
+		categoryStats := NewStatsBatchFunc(repository.FindAllCategoryStats)
 		c := s.Object("Category", blog.Category{})
-		c.FieldFunc("latestPublishedPosts", FindAllLPPBelongedToCategoryFieldFunc(repository))
+		c.FieldFunc("latestPublishedPosts", FindAllLPPBelongedToCategoryFieldFunc(repository, NewCategoryTreeBatchFunc(categoryRepository)))
+		c.FieldFunc("postCount", GetCategoryPostCountFieldFunc(categoryStats))
+		c.FieldFunc("latestPublishedAt", GetCategoryLatestPublishedAtFieldFunc(categoryStats))
 
+		tagStats := NewStatsBatchFunc(repository.FindAllTagStats)
 		t := s.Object("Tag", blog.Tag{})
 		t.FieldFunc("latestPublishedPosts", FindAllLPPBelongedToTagFieldFunc(repository))
+		t.FieldFunc("postCount", GetTagPostCountFieldFunc(tagStats))
+		t.FieldFunc("latestPublishedAt", GetTagLatestPublishedAtFieldFunc(tagStats))
 	}
 }
 
 // BuildFileSchema builds all file related schemas
 func BuildFileSchema(repository storage.FileRepository) func(*schemabuilder.Schema) {
 	return func(s *schemabuilder.Schema) {
+		files := NewFilesBatchFunc(repository)
 		p := s.Object("Post", blog.Post{})
-		p.FieldFunc("featuredImage", FindFeaturedImageBelongedToPostFieldFunc(repository))
-		p.FieldFunc("attachments", FindAllAttachmentsBelongedToPostFieldFunc(repository))
+		p.FieldFunc("featuredImage", FindFeaturedImageBelongedToPostFieldFunc(files))
+		p.FieldFunc("attachments", FindAllAttachmentsBelongedToPostFieldFunc(files))
 	}
 }
 
@@ -90,7 +117,38 @@ func BuildFileSchema(repository storage.FileRepository) func(*schemabuilder.Sche
 func BuildGraphAPISchema(baseURL string, c facebook.Client) func(*schemabuilder.Schema) {
 	return func(s *schemabuilder.Schema) {
 		p := s.Object("Post", blog.Post{})
-		p.FieldFunc("engagement", GetURLNodeShareCountFieldFunc(baseURL, c))
+		p.FieldFunc("engagement", GetURLNodeShareCountFieldFunc(baseURL, NewURLNodeBatchFunc(c)))
+	}
+}
+
+// BuildWebhookSchema builds all webhook related schemas
+func BuildWebhookSchema(repository webhook.WebhookRepository, deliveryRepository webhook.DeliveryRepository, resolver webhook.Resolver) func(*schemabuilder.Schema) {
+	return func(s *schemabuilder.Schema) {
+		q := s.Query()
+		q.FieldFunc("webhooks", FindAllWebhooksFieldFunc(repository))
+		q.FieldFunc("webhookDeliveries", FindAllWebhookDeliveriesFieldFunc(deliveryRepository))
+
+		m := s.Mutation()
+		m.FieldFunc("createWebhook", CreateWebhookFieldFunc(repository, resolver))
+		m.FieldFunc("deleteWebhook", DeleteWebhookFieldFunc(repository))
+
+		wh := s.Object("Webhook", webhook.Webhook{})
+		wh.FieldFunc("id", func(wh webhook.Webhook) string { return wh.ID.Hex() })
+
+		d := s.Object("WebhookDelivery", webhook.Delivery{})
+		d.FieldFunc("id", func(d webhook.Delivery) string { return d.ID.Hex() })
+		d.FieldFunc("webhookId", func(d webhook.Delivery) string { return d.WebhookID.Hex() })
+	}
+}
+
+// BuildViewSchema builds all page view related schemas
+func BuildViewSchema(repository analytics.ViewRepository, postRepository blog.PostRepository) func(*schemabuilder.Schema) {
+	return func(s *schemabuilder.Schema) {
+		q := s.Query()
+		q.FieldFunc("popularPosts", FindAllPopularPostsFieldFunc(repository, postRepository))
+
+		p := s.Object("Post", blog.Post{})
+		p.FieldFunc("viewCount", GetPostViewCountFieldFunc(NewViewCountBatchFunc(repository)))
 	}
 }
 
@@ -102,7 +160,11 @@ func BuildGraphAPISchema(baseURL string, c facebook.Client) func(*schemabuilder.
 // ```
 func FindCategoryBySlugFieldFunc(repository blog.CategoryRepository) interface{} {
 	return func(ctx context.Context, args struct{ Slug Slug }) (blog.Category, error) {
-		return repository.FindByID(ctx, args.Slug.MustGetID())
+		c, err := repository.FindByID(ctx, args.Slug.MustGetID())
+		if err != nil {
+			return blog.Category{}, notFoundOrError(err)
+		}
+		return c, nil
 	}
 }
 
@@ -127,14 +189,202 @@ func FindAllCategoriesFieldFunc(repository blog.CategoryRepository) interface{}
 //		}
 //	}
 // ```
-func FindAllCategoriesBelongedToPostFieldFunc(repository blog.CategoryRepository) interface{} {
+func FindAllCategoriesBelongedToPostFieldFunc(f *batch.Func) interface{} {
 	return func(ctx context.Context, p blog.Post) ([]blog.Category, error) {
-		ids := make([]primitive.ObjectID, len(p.Categories))
-		for i, c := range p.Categories {
-			ids[i] = c.ID
+		result, err := f.Invoke(ctx, getDBRefIDs(p.Categories))
+		if err != nil {
+			return nil, err
+		}
+		return result.([]blog.Category), nil
+	}
+}
+
+// NewCategoriesBatchFunc returns a batch function which combines all category lookups of posts
+// in the same GraphQL request into a single query
+func NewCategoriesBatchFunc(repository blog.CategoryRepository) *batch.Func {
+	return &batch.Func{
+		Many: func(ctx context.Context, args []interface{}) ([]interface{}, error) {
+			var cats []blog.Category
+			if ids := mergeObjectIDs(args); len(ids) > 0 {
+				var err error
+				if cats, err = repository.FindAllByIDs(ctx, ids); err != nil {
+					return nil, err
+				}
+			}
+
+			results := make([]interface{}, len(args))
+			for i, arg := range args {
+				belonged := make([]blog.Category, 0)
+				for _, c := range cats {
+					if containsObjectID(arg.([]primitive.ObjectID), c.ID) {
+						belonged = append(belonged, c)
+					}
+				}
+				results[i] = belonged
+			}
+			return results, nil
+		},
+	}
+}
+
+// UpdateCategoryParentFieldFunc handles the following mutation
+// ```graphql
+//	mutation {
+//		updateCategoryParent(slug: string!, parentSlug: string) { ... }
+//	}
+// ```
+func UpdateCategoryParentFieldFunc(repository blog.CategoryRepository, bus eventbus.Bus) interface{} {
+	return func(ctx context.Context, args struct {
+		Slug       Slug
+		ParentSlug Slug `graphql:",optional"`
+	}) (blog.Category, error) {
+		cats, err := repository.FindAll(ctx)
+		if err != nil {
+			return blog.Category{}, err
+		}
+		tree := blog.NewCategoryTree(cats)
+
+		c, ok := tree.Get(args.Slug.MustGetID().(primitive.ObjectID))
+		if !ok {
+			return blog.Category{}, NewNotFoundError()
+		}
+		parentID := primitive.NilObjectID
+		if args.ParentSlug != "" {
+			parent, ok := tree.Get(args.ParentSlug.MustGetID().(primitive.ObjectID))
+			if !ok {
+				return blog.Category{}, NewNotFoundError()
+			}
+			if tree.IsDescendant(parent, c) {
+				return blog.Category{}, NewValidationError(map[string]string{"parentSlug": "must be neither the category itself nor its descendant"})
+			}
+			parentID = parent.ID
+		}
+
+		updated, err := repository.UpdateParent(ctx, c.ID, parentID)
+		if err != nil {
+			return blog.Category{}, err
+		}
+
+		bus.Publish(ctx, blog.CategoryParentChanged{Category: updated})
+		return updated, nil
+	}
+}
+
+// NewCategoryTreeBatchFunc returns a batch function which loads all categories only once
+// for all hierarchy lookups in the same GraphQL request
+func NewCategoryTreeBatchFunc(repository blog.CategoryRepository) *batch.Func {
+	return &batch.Func{
+		Many: func(ctx context.Context, args []interface{}) ([]interface{}, error) {
+			cats, err := repository.FindAll(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			tree := blog.NewCategoryTree(cats)
+			results := make([]interface{}, len(args))
+			for i := range args {
+				results[i] = tree
+			}
+			return results, nil
+		},
+	}
+}
+
+// FindParentOfCategoryFieldFunc handles the following query in the Category type
+// ```graphql
+//	{
+//		Category {
+//			...
+//			parent { ... }
+//		}
+//	}
+// ```
+func FindParentOfCategoryFieldFunc(f *batch.Func) interface{} {
+	return func(ctx context.Context, c blog.Category) (*blog.Category, error) {
+		tree, err := invokeCategoryTreeBatchFunc(ctx, f, c.ID)
+		if err != nil {
+			return nil, err
+		}
+		if parent, ok := tree.Parent(c); ok {
+			return &parent, nil
+		}
+		return nil, nil
+	}
+}
+
+// FindAllChildrenOfCategoryFieldFunc handles the following query in the Category type
+// ```graphql
+//	{
+//		Category {
+//			...
+//			children { ... }
+//		}
+//	}
+// ```
+func FindAllChildrenOfCategoryFieldFunc(f *batch.Func) interface{} {
+	return func(ctx context.Context, c blog.Category) ([]blog.Category, error) {
+		tree, err := invokeCategoryTreeBatchFunc(ctx, f, c.ID)
+		return tree.Children(c), err
+	}
+}
+
+// FindAllAncestorsOfCategoryFieldFunc handles the following query in the Category type
+// ```graphql
+//	{
+//		Category {
+//			...
+//			ancestors { ... }
+//		}
+//	}
+// ```
+func FindAllAncestorsOfCategoryFieldFunc(f *batch.Func) interface{} {
+	return func(ctx context.Context, c blog.Category) ([]blog.Category, error) {
+		tree, err := invokeCategoryTreeBatchFunc(ctx, f, c.ID)
+		return tree.Ancestors(c), err
+	}
+}
+
+// GetCategoryPermalinkFieldFunc handles the following query in the Category type
+// ```graphql
+//	{
+//		Category {
+//			...
+//			permalink
+//		}
+//	}
+// ```
+func GetCategoryPermalinkFieldFunc(f *batch.Func) interface{} {
+	return func(ctx context.Context, c blog.Category) (string, error) {
+		tree, err := invokeCategoryTreeBatchFunc(ctx, f, c.ID)
+		return tree.Permalink(c), err
+	}
+}
+
+// FindAllBreadcrumbsOfPostFieldFunc handles the following query in the Post type
+// ```graphql
+//	{
+//		Post {
+//			...
+//			breadcrumbs { ... }
+//		}
+//	}
+// ```
+func FindAllBreadcrumbsOfPostFieldFunc(f *batch.Func) interface{} {
+	return func(ctx context.Context, p blog.Post) ([]blog.Breadcrumb, error) {
+		tree, err := invokeCategoryTreeBatchFunc(ctx, f, p.ID)
+		if err != nil {
+			return nil, err
 		}
-		return repository.FindAllByIDs(ctx, ids)
+		return tree.Breadcrumbs(p), nil
+	}
+}
+
+func invokeCategoryTreeBatchFunc(ctx context.Context, f *batch.Func, id primitive.ObjectID) (blog.CategoryTree, error) {
+	result, err := f.Invoke(ctx, id)
+	if err != nil {
+		return blog.CategoryTree{}, err
 	}
+	return result.(blog.CategoryTree), nil
 }
 
 // FindTagBySlugFieldFunc handles the following query
@@ -145,7 +395,11 @@ func FindAllCategoriesBelongedToPostFieldFunc(repository blog.CategoryRepository
 // ```
 func FindTagBySlugFieldFunc(repository blog.TagRepository) interface{} {
 	return func(ctx context.Context, args struct{ Slug Slug }) (blog.Tag, error) {
-		return repository.FindByID(ctx, args.Slug.MustGetID())
+		t, err := repository.FindByID(ctx, args.Slug.MustGetID())
+		if err != nil {
+			return blog.Tag{}, notFoundOrError(err)
+		}
+		return t, nil
 	}
 }
 
@@ -170,13 +424,41 @@ func FindAllTagsFieldFunc(repository blog.TagRepository) interface{} {
 //		}
 //	}
 // ```
-func FindAllTagsBelongedToPostFieldFunc(repository blog.TagRepository) interface{} {
+func FindAllTagsBelongedToPostFieldFunc(f *batch.Func) interface{} {
 	return func(ctx context.Context, p blog.Post) ([]blog.Tag, error) {
-		ids := make([]primitive.ObjectID, len(p.Tags))
-		for i, t := range p.Tags {
-			ids[i] = t.ID
+		result, err := f.Invoke(ctx, getDBRefIDs(p.Tags))
+		if err != nil {
+			return nil, err
 		}
-		return repository.FindAllByIDs(ctx, ids)
+		return result.([]blog.Tag), nil
+	}
+}
+
+// NewTagsBatchFunc returns a batch function which combines all tag lookups of posts
+// in the same GraphQL request into a single query
+func NewTagsBatchFunc(repository blog.TagRepository) *batch.Func {
+	return &batch.Func{
+		Many: func(ctx context.Context, args []interface{}) ([]interface{}, error) {
+			var tags []blog.Tag
+			if ids := mergeObjectIDs(args); len(ids) > 0 {
+				var err error
+				if tags, err = repository.FindAllByIDs(ctx, ids); err != nil {
+					return nil, err
+				}
+			}
+
+			results := make([]interface{}, len(args))
+			for i, arg := range args {
+				belonged := make([]blog.Tag, 0)
+				for _, t := range tags {
+					if containsObjectID(arg.([]primitive.ObjectID), t.ID) {
+						belonged = append(belonged, t)
+					}
+				}
+				results[i] = belonged
+			}
+			return results, nil
+		},
 	}
 }
 
@@ -188,6 +470,10 @@ func FindAllTagsBelongedToPostFieldFunc(repository blog.TagRepository) interface
 // ```
 func FindAllLatestPublishedPostsFieldFunc(repository blog.PostRepository) interface{} {
 	return func(ctx context.Context, args struct{ Offset, Limit int64 }) ([]blog.Post, error) {
+		if err := validateOffsetAndLimit(args.Offset, args.Limit); err != nil {
+			return nil, err
+		}
+
 		return repository.FindAll(ctx, blog.NewPostQueryBuilder().WithStatus(blog.StatusPublished).
 			WithOffset(args.Offset).WithLimit(args.Limit).Build())
 	}
@@ -198,13 +484,29 @@ func FindAllLatestPublishedPostsFieldFunc(repository blog.PostRepository) interf
 //	{
 //		Category {
 //			...
-//			latestPublishedPosts(offset: int!, limit: int!) { ... }
+//			latestPublishedPosts(offset: int!, limit: int!, includeDescendants: bool) { ... }
 //		}
 //	}
 // ```
-func FindAllLPPBelongedToCategoryFieldFunc(repository blog.PostRepository) interface{} {
-	return func(ctx context.Context, c blog.Category, args struct{ Offset, Limit int64 }) ([]blog.Post, error) {
-		return repository.FindAll(ctx, blog.NewPostQueryBuilder().WithCategory(c).WithStatus(blog.StatusPublished).
+func FindAllLPPBelongedToCategoryFieldFunc(repository blog.PostRepository, categoryTree *batch.Func) interface{} {
+	return func(ctx context.Context, c blog.Category, args struct {
+		Offset, Limit      int64
+		IncludeDescendants bool `graphql:",optional"`
+	}) ([]blog.Post, error) {
+		if err := validateOffsetAndLimit(args.Offset, args.Limit); err != nil {
+			return nil, err
+		}
+
+		var descendants []blog.Category
+		if args.IncludeDescendants {
+			tree, err := invokeCategoryTreeBatchFunc(ctx, categoryTree, c.ID)
+			if err != nil {
+				return nil, err
+			}
+			descendants = tree.Descendants(c)
+		}
+
+		return repository.FindAll(ctx, blog.NewPostQueryBuilder().WithCategory(c, descendants...).WithStatus(blog.StatusPublished).
 			WithOffset(args.Offset).WithLimit(args.Limit).Build())
 	}
 }
@@ -220,11 +522,134 @@ func FindAllLPPBelongedToCategoryFieldFunc(repository blog.PostRepository) inter
 // ```
 func FindAllLPPBelongedToTagFieldFunc(repository blog.PostRepository) interface{} {
 	return func(ctx context.Context, t blog.Tag, args struct{ Offset, Limit int64 }) ([]blog.Post, error) {
+		if err := validateOffsetAndLimit(args.Offset, args.Limit); err != nil {
+			return nil, err
+		}
+
 		return repository.FindAll(ctx, blog.NewPostQueryBuilder().WithTag(t).WithStatus(blog.StatusPublished).
 			WithOffset(args.Offset).WithLimit(args.Limit).Build())
 	}
 }
 
+// validateOffsetAndLimit returns a validation error if the limit is not between 1 and blog.MaxPostLimit,
+// a zero limit must not reach the repository since it means no limit
+func validateOffsetAndLimit(offset, limit int64) error {
+	fields := make(map[string]string)
+	if offset < 0 {
+		fields["offset"] = "must be a non-negative integer"
+	}
+	if limit < 1 || limit > blog.MaxPostLimit {
+		fields["limit"] = fmt.Sprintf("must be an integer between 1 and %d", blog.MaxPostLimit)
+	}
+	if len(fields) > 0 {
+		return NewValidationError(fields)
+	}
+	return nil
+}
+
+// NewStatsBatchFunc returns a batch function which combines all statistic lookups of categories or tags
+// in the same GraphQL request into a single aggregation
+func NewStatsBatchFunc(findAllStats func(ctx context.Context, ids interface{}) (map[primitive.ObjectID]blog.Stats, error)) *batch.Func {
+	return &batch.Func{
+		Many: func(ctx context.Context, args []interface{}) ([]interface{}, error) {
+			ids := make([]primitive.ObjectID, len(args))
+			for i, arg := range args {
+				ids[i] = arg.(primitive.ObjectID)
+			}
+
+			stats, err := findAllStats(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+
+			results := make([]interface{}, len(args))
+			for i, id := range ids {
+				results[i] = stats[id]
+			}
+			return results, nil
+		},
+	}
+}
+
+// GetCategoryPostCountFieldFunc handles the following query in the Category type
+// ```graphql
+//	{
+//		Category {
+//			...
+//			postCount
+//		}
+//	}
+// ```
+func GetCategoryPostCountFieldFunc(f *batch.Func) interface{} {
+	return func(ctx context.Context, c blog.Category) (int64, error) {
+		stats, err := invokeStatsBatchFunc(ctx, f, c.ID)
+		return int64(stats.PostCount), err
+	}
+}
+
+// GetCategoryLatestPublishedAtFieldFunc handles the following query in the Category type
+// ```graphql
+//	{
+//		Category {
+//			...
+//			latestPublishedAt
+//		}
+//	}
+// ```
+func GetCategoryLatestPublishedAtFieldFunc(f *batch.Func) interface{} {
+	return func(ctx context.Context, c blog.Category) (*time.Time, error) {
+		return invokeLatestPublishedAt(ctx, f, c.ID)
+	}
+}
+
+// GetTagPostCountFieldFunc handles the following query in the Tag type
+// ```graphql
+//	{
+//		Tag {
+//			...
+//			postCount
+//		}
+//	}
+// ```
+func GetTagPostCountFieldFunc(f *batch.Func) interface{} {
+	return func(ctx context.Context, t blog.Tag) (int64, error) {
+		stats, err := invokeStatsBatchFunc(ctx, f, t.ID)
+		return int64(stats.PostCount), err
+	}
+}
+
+// GetTagLatestPublishedAtFieldFunc handles the following query in the Tag type
+// ```graphql
+//	{
+//		Tag {
+//			...
+//			latestPublishedAt
+//		}
+//	}
+// ```
+func GetTagLatestPublishedAtFieldFunc(f *batch.Func) interface{} {
+	return func(ctx context.Context, t blog.Tag) (*time.Time, error) {
+		return invokeLatestPublishedAt(ctx, f, t.ID)
+	}
+}
+
+func invokeStatsBatchFunc(ctx context.Context, f *batch.Func, id primitive.ObjectID) (blog.Stats, error) {
+	result, err := f.Invoke(ctx, id)
+	if err != nil {
+		return blog.Stats{}, err
+	}
+	return result.(blog.Stats), nil
+}
+
+// invokeLatestPublishedAt returns nil if there is no published post
+func invokeLatestPublishedAt(ctx context.Context, f *batch.Func, id primitive.ObjectID) (*time.Time, error) {
+	stats, err := invokeStatsBatchFunc(ctx, f, id)
+	if err != nil || stats.LatestPublishedAt.IsZero() {
+		return nil, err
+	}
+	return &stats.LatestPublishedAt, nil
+}
+
 // FindAllMyPostsFieldFunc handles the following query
 // ```graphql
 //	{
@@ -233,6 +658,10 @@ func FindAllLPPBelongedToTagFieldFunc(repository blog.PostRepository) interface{
 // ```
 func FindAllMyPostsFieldFunc(repository blog.PostRepository) interface{} {
 	return func(ctx context.Context, args struct{ Offset, Limit int64 }) ([]blog.Post, error) {
+		if err := validateOffsetAndLimit(args.Offset, args.Limit); err != nil {
+			return nil, err
+		}
+
 		return repository.FindAll(ctx, blog.NewPostQueryBuilder().WithAuthorID(ctx.Value(AuthorizedID).(string)).
 			WithOffset(args.Offset).WithLimit(args.Limit).Build())
 	}
@@ -244,14 +673,18 @@ func FindAllMyPostsFieldFunc(repository blog.PostRepository) interface{} {
 //		post(slug: string!) { ... }
 //	}
 // ```
-func FindPostBySlugFieldFunc(repository blog.PostRepository) interface{} {
+//
+// On a live query, the result will be re-sent whenever the post is changed elsewhere.
+func FindPostBySlugFieldFunc(repository blog.PostRepository, live *LivePosts) interface{} {
 	return func(ctx context.Context, args struct{ Slug Slug }) (blog.Post, error) {
 		id := args.Slug.MustGetID()
 
 		p, err := repository.FindByID(ctx, id)
 		if err != nil {
-			return blog.Post{}, errors.New(http.StatusText(http.StatusNotFound))
+			return blog.Post{}, notFoundOrError(err)
 		}
+		live.Watch(ctx, p.ID)
+
 		if p.Status == blog.StatusPublished {
 			return p, nil
 		}
@@ -262,7 +695,7 @@ func FindPostBySlugFieldFunc(repository blog.PostRepository) interface{} {
 			}
 		}
 
-		return blog.Post{}, errors.New(http.StatusText(http.StatusForbidden))
+		return blog.Post{}, NewForbiddenError()
 	}
 }
 
@@ -284,27 +717,23 @@ func CreatePostFieldFunc(repository blog.PostRepository) interface{} {
 //		updatePostTitle(slug: string!, title: string!) { ... }
 //	}
 // ```
-func UpdatePostTitleFieldFunc(repository blog.PostRepository) interface{} {
+func UpdatePostTitleFieldFunc(repository blog.PostRepository, validator PostValidator, bus eventbus.Bus) interface{} {
 	return func(ctx context.Context, args struct {
 		Slug  Slug
 		Title string
 	}) (blog.Post, error) {
-		id := args.Slug.MustGetID()
-
-		p, err := repository.FindByID(ctx, id)
+		id, p, err := findAuthorizedPost(ctx, repository, args.Slug)
 		if err != nil {
-			return blog.Post{}, errors.New(http.StatusText(http.StatusNotFound))
+			return blog.Post{}, err
 		}
 
-		if authID := ctx.Value(AuthorizedID); authID != nil {
-			if p.AuthorID == authID.(string) {
-				slug := fmt.Sprintf("%s-%s", slugify.Make(args.Title), id.(primitive.ObjectID).Hex())
-				return repository.Save(ctx, id, blog.NewPostQueryBuilder().WithTitle(args.Title).WithSlug(slug).
-					Build())
-			}
+		if err := validator.ValidateTitle(args.Title); err != nil {
+			return blog.Post{}, err
 		}
 
-		return blog.Post{}, errors.New(http.StatusText(http.StatusForbidden))
+		title := strings.TrimSpace(args.Title)
+		slug := fmt.Sprintf("%s-%s", slugify.Make(title), id.Hex())
+		return savePost(ctx, repository, bus, id, p, blog.NewPostQueryBuilder().WithTitle(title).WithSlug(slug).Build())
 	}
 }
 
@@ -314,29 +743,25 @@ func UpdatePostTitleFieldFunc(repository blog.PostRepository) interface{} {
 //		updatePostStatus(slug: string!, status: Status!) { ... }
 //	}
 // ```
-func UpdatePostStatusFieldFunc(repository blog.PostRepository) interface{} {
+func UpdatePostStatusFieldFunc(repository blog.PostRepository, validator PostValidator, bus eventbus.Bus) interface{} {
 	return func(ctx context.Context, args struct {
 		Slug   Slug
 		Status blog.Status
 	}) (blog.Post, error) {
-		id := args.Slug.MustGetID()
-
-		p, err := repository.FindByID(ctx, id)
+		id, p, err := findAuthorizedPost(ctx, repository, args.Slug)
 		if err != nil {
-			return blog.Post{}, errors.New(http.StatusText(http.StatusNotFound))
+			return blog.Post{}, err
 		}
 
-		if authID := ctx.Value(AuthorizedID); authID != nil {
-			if p.AuthorID == authID.(string) {
-				qb := blog.NewPostQueryBuilder().WithStatus(args.Status)
-				if args.Status.IsPublished() && p.PublishedAt.IsZero() {
-					qb.WithPublishedAt(time.Now())
-				}
-				return repository.Save(ctx, id, qb.Build())
-			}
+		if err := validator.ValidateStatus(args.Status); err != nil {
+			return blog.Post{}, err
 		}
 
-		return blog.Post{}, errors.New(http.StatusText(http.StatusForbidden))
+		qb := blog.NewPostQueryBuilder().WithStatus(args.Status)
+		if args.Status.IsPublished() && p.PublishedAt.IsZero() {
+			qb.WithPublishedAt(time.Now())
+		}
+		return savePost(ctx, repository, bus, id, p, qb.Build())
 	}
 }
 
@@ -346,28 +771,24 @@ func UpdatePostStatusFieldFunc(repository blog.PostRepository) interface{} {
 //		updatePostContent(slug: string!, markdown: string!) { ... }
 //	}
 // ```
-func UpdatePostContentFieldFunc(repository blog.PostRepository) interface{} {
+func UpdatePostContentFieldFunc(repository blog.PostRepository, validator PostValidator, bus eventbus.Bus) interface{} {
 	return func(ctx context.Context, args struct {
 		Slug     Slug
 		Markdown string
 	}) (blog.Post, error) {
-		id := args.Slug.MustGetID()
-
-		p, err := repository.FindByID(ctx, id)
+		id, p, err := findAuthorizedPost(ctx, repository, args.Slug)
 		if err != nil {
-			return blog.Post{}, errors.New(http.StatusText(http.StatusNotFound))
+			return blog.Post{}, err
 		}
 
-		if authID := ctx.Value(AuthorizedID); authID != nil {
-			if p.AuthorID == authID.(string) {
-				html := blackfriday.Run([]byte(args.Markdown), blackfriday.
-					WithExtensions(blackfriday.CommonExtensions+blackfriday.Footnotes))
-				return repository.Save(ctx, id, blog.NewPostQueryBuilder().WithMarkdown(args.Markdown).
-					WithHTML(string(html)).Build())
-			}
+		if err := validator.ValidateMarkdown(args.Markdown); err != nil {
+			return blog.Post{}, err
 		}
 
-		return blog.Post{}, errors.New(http.StatusText(http.StatusForbidden))
+		html := blackfriday.Run([]byte(args.Markdown), blackfriday.
+			WithExtensions(blackfriday.CommonExtensions+blackfriday.Footnotes))
+		return savePost(ctx, repository, bus, id, p, blog.NewPostQueryBuilder().WithMarkdown(args.Markdown).
+			WithHTML(string(html)).Build())
 	}
 }
 
@@ -377,29 +798,22 @@ func UpdatePostContentFieldFunc(repository blog.PostRepository) interface{} {
 //		updatePostCategories(slug: string!, categorySlugs: [string!]!) { ... }
 //	}
 // ```
-func UpdatePostCategoriesFieldFunc(repository blog.PostRepository) interface{} {
+func UpdatePostCategoriesFieldFunc(repository blog.PostRepository, validator PostValidator, bus eventbus.Bus) interface{} {
 	return func(ctx context.Context, args struct {
 		Slug          Slug
 		CategorySlugs []Slug
 	}) (blog.Post, error) {
-		id := args.Slug.MustGetID()
-
-		p, err := repository.FindByID(ctx, id)
+		id, p, err := findAuthorizedPost(ctx, repository, args.Slug)
 		if err != nil {
-			return blog.Post{}, errors.New(http.StatusText(http.StatusNotFound))
+			return blog.Post{}, err
 		}
 
-		if authID := ctx.Value(AuthorizedID); authID != nil {
-			if p.AuthorID == authID.(string) {
-				var cats []blog.Category
-				for _, slug := range args.CategorySlugs {
-					cats = append(cats, blog.Category{ID: slug.MustGetID().(primitive.ObjectID)})
-				}
-				return repository.Save(ctx, id, blog.NewPostQueryBuilder().WithCategories(cats).Build())
-			}
+		cats, err := validator.ValidateCategories(ctx, args.CategorySlugs)
+		if err != nil {
+			return blog.Post{}, err
 		}
 
-		return blog.Post{}, errors.New(http.StatusText(http.StatusForbidden))
+		return savePost(ctx, repository, bus, id, p, blog.NewPostQueryBuilder().WithCategories(cats).Build())
 	}
 }
 
@@ -409,29 +823,22 @@ func UpdatePostCategoriesFieldFunc(repository blog.PostRepository) interface{} {
 //		updatePostTags(slug: string!, tags: [string!]!) { ... }
 //	}
 // ```
-func UpdatePostTagsFieldFunc(repository blog.PostRepository) interface{} {
+func UpdatePostTagsFieldFunc(repository blog.PostRepository, validator PostValidator, bus eventbus.Bus) interface{} {
 	return func(ctx context.Context, args struct {
 		Slug     Slug
 		TagSlugs []Slug
 	}) (blog.Post, error) {
-		id := args.Slug.MustGetID()
-
-		p, err := repository.FindByID(ctx, id)
+		id, p, err := findAuthorizedPost(ctx, repository, args.Slug)
 		if err != nil {
-			return blog.Post{}, errors.New(http.StatusText(http.StatusNotFound))
+			return blog.Post{}, err
 		}
 
-		if authID := ctx.Value(AuthorizedID); authID != nil {
-			if p.AuthorID == authID.(string) {
-				var tags []blog.Tag
-				for _, slug := range args.TagSlugs {
-					tags = append(tags, blog.Tag{ID: slug.MustGetID().(primitive.ObjectID)})
-				}
-				return repository.Save(ctx, id, blog.NewPostQueryBuilder().WithTags(tags).Build())
-			}
+		tags, err := validator.ValidateTags(ctx, args.TagSlugs)
+		if err != nil {
+			return blog.Post{}, err
 		}
 
-		return blog.Post{}, errors.New(http.StatusText(http.StatusForbidden))
+		return savePost(ctx, repository, bus, id, p, blog.NewPostQueryBuilder().WithTags(tags).Build())
 	}
 }
 
@@ -441,32 +848,27 @@ func UpdatePostTagsFieldFunc(repository blog.PostRepository) interface{} {
 //		updatePostFeaturedImage(slug: string!, featuredImageSlug: string!) { ... }
 //	}
 // ```
-func UpdatePostFeaturedImageFieldFunc(repository blog.PostRepository) interface{} {
+func UpdatePostFeaturedImageFieldFunc(repository blog.PostRepository, validator PostValidator, bus eventbus.Bus) interface{} {
 	return func(ctx context.Context, args struct {
 		Slug              Slug
 		FeaturedImageSlug storage.Slug `graphql:",optional"`
 	}) (blog.Post, error) {
-		id := args.Slug.MustGetID()
-
-		p, err := repository.FindByID(ctx, id)
+		id, p, err := findAuthorizedPost(ctx, repository, args.Slug)
 		if err != nil {
-			return blog.Post{}, errors.New(http.StatusText(http.StatusNotFound))
+			return blog.Post{}, err
 		}
 
-		if authID := ctx.Value(AuthorizedID); authID != nil {
-			if p.AuthorID == authID.(string) {
-				if args.FeaturedImageSlug == "" {
-					return repository.Save(ctx, id, blog.NewPostQueryBuilder().
-						WithFeaturedImage(storage.File{}).Build())
-				}
-				return repository.Save(ctx, id, blog.NewPostQueryBuilder().
-					WithFeaturedImage(storage.File{
-						ID: args.FeaturedImageSlug.MustGetID().(primitive.ObjectID),
-					}).Build())
-			}
+		if args.FeaturedImageSlug == "" {
+			return savePost(ctx, repository, bus, id, p, blog.NewPostQueryBuilder().
+				WithFeaturedImage(storage.File{}).Build())
+		}
+
+		file, err := validator.ValidateFeaturedImage(ctx, args.FeaturedImageSlug, p.AuthorID)
+		if err != nil {
+			return blog.Post{}, err
 		}
 
-		return blog.Post{}, errors.New(http.StatusText(http.StatusForbidden))
+		return savePost(ctx, repository, bus, id, p, blog.NewPostQueryBuilder().WithFeaturedImage(file).Build())
 	}
 }
 
@@ -476,32 +878,63 @@ func UpdatePostFeaturedImageFieldFunc(repository blog.PostRepository) interface{
 //		updatePostAttachments(slug: string!, attachmentSlugs: [string!]!) { ... }
 //	}
 // ```
-func UpdatePostAttachmentsFieldFunc(repository blog.PostRepository) interface{} {
+func UpdatePostAttachmentsFieldFunc(repository blog.PostRepository, validator PostValidator, bus eventbus.Bus) interface{} {
 	return func(ctx context.Context, args struct {
 		Slug            Slug
 		AttachmentSlugs []storage.Slug
 	}) (blog.Post, error) {
-		id := args.Slug.MustGetID()
-
-		p, err := repository.FindByID(ctx, id)
+		id, p, err := findAuthorizedPost(ctx, repository, args.Slug)
 		if err != nil {
-			return blog.Post{}, errors.New(http.StatusText(http.StatusNotFound))
+			return blog.Post{}, err
 		}
 
-		if authID := ctx.Value(AuthorizedID); authID != nil {
-			if p.AuthorID == authID.(string) {
-				var attachments []storage.File
-				for _, slug := range args.AttachmentSlugs {
-					attachments = append(attachments, storage.File{ID: slug.MustGetID().(primitive.ObjectID)})
-				}
-				return repository.Save(ctx, id, blog.NewPostQueryBuilder().WithAttachments(attachments).Build())
-			}
+		attachments, err := validator.ValidateAttachments(ctx, args.AttachmentSlugs, p.AuthorID)
+		if err != nil {
+			return blog.Post{}, err
 		}
 
-		return blog.Post{}, errors.New(http.StatusText(http.StatusForbidden))
+		return savePost(ctx, repository, bus, id, p, blog.NewPostQueryBuilder().WithAttachments(attachments).Build())
 	}
 }
 
+// findAuthorizedPost returns the post of the slug which belongs to the authorized user
+func findAuthorizedPost(ctx context.Context, repository blog.PostRepository, slug Slug) (primitive.ObjectID, blog.Post, error) {
+	id, err := slug.GetID()
+	if err != nil {
+		return primitive.NilObjectID, blog.Post{}, NewValidationError(map[string]string{"slug": fmt.Sprintf("%q is not a valid slug", slug)})
+	}
+
+	p, err := repository.FindByID(ctx, id)
+	if err != nil {
+		return primitive.NilObjectID, blog.Post{}, notFoundOrError(err)
+	}
+
+	if authID := ctx.Value(AuthorizedID); authID == nil || p.AuthorID != authID.(string) {
+		return primitive.NilObjectID, blog.Post{}, NewForbiddenError()
+	}
+
+	return id.(primitive.ObjectID), p, nil
+}
+
+// savePost saves the post and publishes an event whether the post has been published, unpublished or changed
+func savePost(ctx context.Context, repository blog.PostRepository, bus eventbus.Bus, id interface{}, p blog.Post, q blog.PostQuery) (blog.Post, error) {
+	saved, err := repository.Save(ctx, id, q)
+	if err != nil {
+		return saved, err
+	}
+
+	switch {
+	case !p.Status.IsPublished() && saved.Status.IsPublished():
+		bus.Publish(ctx, blog.PostPublished{Post: saved})
+	case p.Status.IsPublished() && !saved.Status.IsPublished():
+		bus.Publish(ctx, blog.PostUnpublished{Post: saved})
+	default:
+		bus.Publish(ctx, blog.PostContentChanged{Post: saved, Previous: p})
+	}
+
+	return saved, nil
+}
+
 // FindFeaturedImageBelongedToPostFieldFunc handles the following query in the Post type
 // ```graphql
 //	{
@@ -511,10 +944,13 @@ func UpdatePostAttachmentsFieldFunc(repository blog.PostRepository) interface{}
 //		}
 //	}
 // ```
-func FindFeaturedImageBelongedToPostFieldFunc(repository storage.FileRepository) interface{} {
+func FindFeaturedImageBelongedToPostFieldFunc(f *batch.Func) interface{} {
 	return func(ctx context.Context, p blog.Post) storage.File {
-		file, _ := repository.FindByID(ctx, p.FeaturedImage.ID)
-		return file
+		result, err := f.Invoke(ctx, getDBRefIDs([]mongo.DBRef{p.FeaturedImage}))
+		if err != nil || len(result.([]storage.File)) == 0 {
+			return storage.File{}
+		}
+		return result.([]storage.File)[0]
 	}
 }
 
@@ -527,13 +963,41 @@ func FindFeaturedImageBelongedToPostFieldFunc(repository storage.FileRepository)
 //		}
 //	}
 // ```
-func FindAllAttachmentsBelongedToPostFieldFunc(repository storage.FileRepository) interface{} {
+func FindAllAttachmentsBelongedToPostFieldFunc(f *batch.Func) interface{} {
 	return func(ctx context.Context, p blog.Post) ([]storage.File, error) {
-		ids := make([]primitive.ObjectID, len(p.Attachments))
-		for i, f := range p.Attachments {
-			ids[i] = f.ID
+		result, err := f.Invoke(ctx, getDBRefIDs(p.Attachments))
+		if err != nil {
+			return nil, err
 		}
-		return repository.FindAllByIDs(ctx, ids)
+		return result.([]storage.File), nil
+	}
+}
+
+// NewFilesBatchFunc returns a batch function which combines all featured image and attachment lookups of posts
+// in the same GraphQL request into a single query
+func NewFilesBatchFunc(repository storage.FileRepository) *batch.Func {
+	return &batch.Func{
+		Many: func(ctx context.Context, args []interface{}) ([]interface{}, error) {
+			var files []storage.File
+			if ids := mergeObjectIDs(args); len(ids) > 0 {
+				var err error
+				if files, err = repository.FindAllByIDs(ctx, ids); err != nil {
+					return nil, err
+				}
+			}
+
+			results := make([]interface{}, len(args))
+			for i, arg := range args {
+				belonged := make([]storage.File, 0)
+				for _, file := range files {
+					if containsObjectID(arg.([]primitive.ObjectID), file.ID) {
+						belonged = append(belonged, file)
+					}
+				}
+				results[i] = belonged
+			}
+			return results, nil
+		},
 	}
 }
 
@@ -546,15 +1010,279 @@ func FindAllAttachmentsBelongedToPostFieldFunc(repository storage.FileRepository
 //		}
 //	}
 // ```
-func GetURLNodeShareCountFieldFunc(baseURL string, c facebook.Client) interface{} {
+//
+// On a live query, the engagement will be re-fetched every engagementRefreshInterval.
+func GetURLNodeShareCountFieldFunc(baseURL string, f *batch.Func) interface{} {
 	return func(ctx context.Context, p blog.Post) (engagement blog.Engagement) {
+		if reactive.HasRerunner(ctx) {
+			reactive.InvalidateAfter(ctx, engagementRefreshInterval)
+		}
+
 		id := baseURL + "/" + p.PublishedAt.In(timeutil.TimeZoneAsiaBangkok).Format("2006/1/2") + "/" + p.Slug
-		urlNode, err := c.GetURLNodeFields(id)
+		result, err := f.Invoke(ctx, id)
 		if err != nil {
 			logrus.Errorf("unable to retrieve URLNode on ID: %s", id)
 			return
 		}
+		urlNode := result.(facebook.URLNode)
 		engagement.ShareCount = urlNode.Engagement.ShareCount
+		engagement.CommentCount = urlNode.Engagement.CommentCount + urlNode.Engagement.CommentPluginCount
 		return
 	}
 }
+
+// NewURLNodeBatchFunc returns a batch function which combines all URLNode lookups of posts
+// in the same GraphQL request into a single Graph API request
+func NewURLNodeBatchFunc(c facebook.Client) *batch.Func {
+	return &batch.Func{
+		Many: func(ctx context.Context, args []interface{}) ([]interface{}, error) {
+			ids := make([]string, 0, len(args))
+			seen := make(map[string]bool, len(args))
+			for _, arg := range args {
+				if id := arg.(string); !seen[id] {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+
+			urlNodes, err := c.GetURLNodesFields(ids)
+			if err != nil {
+				return nil, err
+			}
+
+			results := make([]interface{}, len(args))
+			for i, arg := range args {
+				results[i] = urlNodes[arg.(string)]
+			}
+			return results, nil
+		},
+		MaxSize: facebook.MaxURLNodesPerRequest,
+	}
+}
+
+// FindAllPopularPostsFieldFunc handles the following query
+// ```graphql
+//	{
+//		popularPosts(period: Period!, limit: int!) { ... }
+//	}
+// ```
+func FindAllPopularPostsFieldFunc(repository analytics.ViewRepository, postRepository blog.PostRepository) interface{} {
+	return func(ctx context.Context, args struct {
+		Period analytics.Period
+		Limit  int64
+	}) ([]blog.Post, error) {
+		if !args.Period.IsValid() {
+			return nil, NewValidationError(map[string]string{"period": fmt.Sprintf("%q is not a valid period", args.Period)})
+		}
+		if err := validateOffsetAndLimit(0, args.Limit); err != nil {
+			return nil, err
+		}
+
+		views, err := repository.FindAllPopular(ctx, args.Period.Since(time.Now()), args.Limit)
+		if err != nil {
+			return nil, err
+		}
+		if len(views) == 0 {
+			return []blog.Post{}, nil
+		}
+
+		ids := make([]primitive.ObjectID, len(views))
+		for i, v := range views {
+			ids[i] = v.PostID
+		}
+
+		found, err := postRepository.FindAll(ctx, blog.NewPostQueryBuilder().
+			WithIDs(ids).WithStatus(blog.StatusPublished).WithLimit(int64(len(ids))).Build())
+		if err != nil {
+			return nil, err
+		}
+
+		postsByID := make(map[primitive.ObjectID]blog.Post, len(found))
+		for _, p := range found {
+			postsByID[p.ID] = p
+		}
+
+		posts := make([]blog.Post, 0, len(views))
+		for _, id := range ids {
+			if p, ok := postsByID[id]; ok {
+				posts = append(posts, p)
+			}
+		}
+
+		return posts, nil
+	}
+}
+
+// NewViewCountBatchFunc returns a batch function which combines all page view lookups of posts
+// in the same GraphQL request into a single aggregation
+func NewViewCountBatchFunc(repository analytics.ViewRepository) *batch.Func {
+	return &batch.Func{
+		Many: func(ctx context.Context, args []interface{}) ([]interface{}, error) {
+			ids := make([]primitive.ObjectID, len(args))
+			for i, arg := range args {
+				ids[i] = arg.(primitive.ObjectID)
+			}
+
+			counts, err := repository.CountAllByPostIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+
+			results := make([]interface{}, len(args))
+			for i, id := range ids {
+				results[i] = counts[id]
+			}
+			return results, nil
+		},
+	}
+}
+
+// GetPostViewCountFieldFunc handles the following query in the Post type
+// ```graphql
+//	{
+//		Post {
+//			...
+//			viewCount
+//		}
+//	}
+// ```
+func GetPostViewCountFieldFunc(f *batch.Func) interface{} {
+	return func(ctx context.Context, p blog.Post) (int64, error) {
+		result, err := f.Invoke(ctx, p.ID)
+		if err != nil {
+			return 0, err
+		}
+		return result.(int64), nil
+	}
+}
+
+// FindAllWebhooksFieldFunc handles the following query
+// ```graphql
+//	{
+//		webhooks { ... }
+//	}
+// ```
+func FindAllWebhooksFieldFunc(repository webhook.WebhookRepository) interface{} {
+	return func(ctx context.Context) ([]webhook.Webhook, error) {
+		return repository.FindAll(ctx)
+	}
+}
+
+// FindAllWebhookDeliveriesFieldFunc handles the following query
+// ```graphql
+//	{
+//		webhookDeliveries(webhookId: string, offset: int!, limit: int!) { ... }
+//	}
+// ```
+func FindAllWebhookDeliveriesFieldFunc(repository webhook.DeliveryRepository) interface{} {
+	return func(ctx context.Context, args struct {
+		WebhookID     string `graphql:"webhookId,optional"`
+		Offset, Limit int64
+	}) ([]webhook.Delivery, error) {
+		var webhookID primitive.ObjectID
+		if args.WebhookID != "" {
+			id, err := primitive.ObjectIDFromHex(args.WebhookID)
+			if err != nil {
+				return nil, NewValidationError(map[string]string{"webhookId": "must be a valid ID"})
+			}
+			webhookID = id
+		}
+
+		return repository.FindAll(ctx, webhookID, args.Offset, args.Limit)
+	}
+}
+
+// CreateWebhookFieldFunc handles the following mutation
+// ```graphql
+//	mutation {
+//		createWebhook(url: string!, events: [string!]!, secret: string!) { ... }
+//	}
+// ```
+func CreateWebhookFieldFunc(repository webhook.WebhookRepository, resolver webhook.Resolver) interface{} {
+	return func(ctx context.Context, args struct {
+		URL    string `graphql:"url"`
+		Events []webhook.Event
+		Secret string
+	}) (webhook.Webhook, error) {
+		fields := make(map[string]string)
+		u, err := url.Parse(args.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			fields["url"] = "must be an absolute HTTP or HTTPS URL"
+		} else if err = webhook.ValidateHost(ctx, resolver, u); err != nil {
+			fields["url"] = "must be a resolvable public host"
+		}
+		if len(args.Events) == 0 {
+			fields["events"] = "must contain at least one event"
+		}
+		for _, e := range args.Events {
+			if !e.IsValid() {
+				fields["events"] = "must contain only valid events"
+			}
+		}
+		if args.Secret == "" {
+			fields["secret"] = "must not be empty"
+		}
+		if len(fields) > 0 {
+			return webhook.Webhook{}, NewValidationError(fields)
+		}
+
+		return repository.Create(ctx, webhook.Webhook{URL: u.String(), Events: args.Events, Secret: args.Secret})
+	}
+}
+
+// DeleteWebhookFieldFunc handles the following mutation
+// ```graphql
+//	mutation {
+//		deleteWebhook(id: string!)
+//	}
+// ```
+func DeleteWebhookFieldFunc(repository webhook.WebhookRepository) interface{} {
+	return func(ctx context.Context, args struct {
+		ID string `graphql:"id"`
+	}) (bool, error) {
+		id, err := primitive.ObjectIDFromHex(args.ID)
+		if err != nil {
+			return false, NewValidationError(map[string]string{"id": "must be a valid ID"})
+		}
+
+		if err = repository.Delete(ctx, id); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+}
+
+// getDBRefIDs returns list of IDs from list of DBRefs, an empty reference will be skipped
+func getDBRefIDs(refs []mongo.DBRef) []primitive.ObjectID {
+	ids := make([]primitive.ObjectID, 0, len(refs))
+	for _, ref := range refs {
+		if !ref.ID.IsZero() {
+			ids = append(ids, ref.ID)
+		}
+	}
+	return ids
+}
+
+// mergeObjectIDs returns list of unique IDs from all batch function arguments
+func mergeObjectIDs(args []interface{}) []primitive.ObjectID {
+	ids := make([]primitive.ObjectID, 0, len(args))
+	seen := make(map[primitive.ObjectID]bool, len(args))
+	for _, arg := range args {
+		for _, id := range arg.([]primitive.ObjectID) {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+func containsObjectID(ids []primitive.ObjectID, id primitive.ObjectID) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
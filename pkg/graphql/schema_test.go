@@ -6,18 +6,28 @@ import (
 	"errors"
 	"github.com/golang/mock/gomock"
 	mock_http "github.com/nomkhonwaan/myblog/internal/http/mock"
+	"github.com/nomkhonwaan/myblog/pkg/analytics"
+	mock_analytics "github.com/nomkhonwaan/myblog/pkg/analytics/mock"
 	"github.com/nomkhonwaan/myblog/pkg/blog"
 	mock_blog "github.com/nomkhonwaan/myblog/pkg/blog/mock"
+	mock_eventbus "github.com/nomkhonwaan/myblog/pkg/eventbus/mock"
 	"github.com/nomkhonwaan/myblog/pkg/facebook"
 	"github.com/nomkhonwaan/myblog/pkg/mongo"
 	"github.com/nomkhonwaan/myblog/pkg/storage"
 	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
+	"github.com/nomkhonwaan/myblog/pkg/webhook"
+	mock_webhook "github.com/nomkhonwaan/myblog/pkg/webhook/mock"
+	"github.com/samsarahq/thunder/batch"
 	"github.com/stretchr/testify/assert"
 	"github.com/tkuchiki/faketime"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	mgo "go.mongodb.org/mongo-driver/mongo"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -43,6 +53,45 @@ func TestFindCategoryBySlugFieldFunc(t *testing.T) {
 	assert.Equal(t, blog.Category{Name: "Test", Slug: "test-" + id.Hex()}, c)
 }
 
+func TestFindCategoryBySlugFieldFunc_WithError(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository = mock_blog.NewMockCategoryRepository(ctrl)
+	)
+
+	tests := map[string]struct {
+		err      error
+		expected string
+	}{
+		"When the category does not exist": {
+			err:      mgo.ErrNoDocuments,
+			expected: "Not Found",
+		},
+		"When unable to query the database": {
+			err:      errors.New("test unable to find a category"),
+			expected: "test unable to find a category",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Given
+			id := primitive.NewObjectID()
+
+			repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Category{}, test.err)
+
+			// When
+			_, err := FindCategoryBySlugFieldFunc(repository).(func(context.Context, struct{ Slug Slug }) (blog.Category, error))(context.Background(), struct{ Slug Slug }{Slug: Slug("test-" + id.Hex())})
+
+			// Then
+			assert.EqualError(t, err, test.expected)
+		})
+	}
+}
+
 func TestFindAllCategoriesFieldFunc(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -74,15 +123,37 @@ func TestFindAllCategoriesBelongedToPostFieldFunc(t *testing.T) {
 	)
 
 	id := primitive.NewObjectID()
+	sharedID := primitive.NewObjectID()
+	f := NewCategoriesBatchFunc(repository)
+	ctx := batch.WithBatching(context.Background())
 
-	repository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{id}).Return([]blog.Category{{Name: "Test", Slug: "test-" + id.Hex()}}, nil)
+	repository.EXPECT().FindAllByIDs(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ids interface{}) ([]blog.Category, error) {
+		assert.ElementsMatch(t, []primitive.ObjectID{id, sharedID}, ids)
+		return []blog.Category{{ID: sharedID, Name: "Shared"}, {ID: id, Name: "Test"}}, nil
+	})
 
 	// When
-	cats, err := FindAllCategoriesBelongedToPostFieldFunc(repository).(func(context.Context, blog.Post) ([]blog.Category, error))(context.Background(), blog.Post{Categories: []mongo.DBRef{{ID: id}}})
+	var (
+		wg                 sync.WaitGroup
+		cats, sharedCats   []blog.Category
+		err, sharedCatsErr error
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		cats, err = FindAllCategoriesBelongedToPostFieldFunc(f).(func(context.Context, blog.Post) ([]blog.Category, error))(ctx, blog.Post{Categories: []mongo.DBRef{{ID: id}, {ID: sharedID}}})
+	}()
+	go func() {
+		defer wg.Done()
+		sharedCats, sharedCatsErr = FindAllCategoriesBelongedToPostFieldFunc(f).(func(context.Context, blog.Post) ([]blog.Category, error))(ctx, blog.Post{Categories: []mongo.DBRef{{ID: sharedID}}})
+	}()
+	wg.Wait()
 
 	// Then
 	assert.Nil(t, err)
-	assert.Equal(t, []blog.Category{{Name: "Test", Slug: "test-" + id.Hex()}}, cats)
+	assert.Nil(t, sharedCatsErr)
+	assert.Equal(t, []blog.Category{{ID: sharedID, Name: "Shared"}, {ID: id, Name: "Test"}}, cats)
+	assert.Equal(t, []blog.Category{{ID: sharedID, Name: "Shared"}}, sharedCats)
 }
 
 func TestFindTagBySlugFieldFunc(t *testing.T) {
@@ -106,6 +177,45 @@ func TestFindTagBySlugFieldFunc(t *testing.T) {
 	assert.Equal(t, blog.Tag{Name: "Test", Slug: "test-" + id.Hex()}, tag)
 }
 
+func TestFindTagBySlugFieldFunc_WithError(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository = mock_blog.NewMockTagRepository(ctrl)
+	)
+
+	tests := map[string]struct {
+		err      error
+		expected string
+	}{
+		"When the tag does not exist": {
+			err:      mgo.ErrNoDocuments,
+			expected: "Not Found",
+		},
+		"When unable to query the database": {
+			err:      errors.New("test unable to find a tag"),
+			expected: "test unable to find a tag",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Given
+			id := primitive.NewObjectID()
+
+			repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Tag{}, test.err)
+
+			// When
+			_, err := FindTagBySlugFieldFunc(repository).(func(context.Context, struct{ Slug Slug }) (blog.Tag, error))(context.Background(), struct{ Slug Slug }{Slug: Slug("test-" + id.Hex())})
+
+			// Then
+			assert.EqualError(t, err, test.expected)
+		})
+	}
+}
+
 func TestFindAllTagsFieldFunc(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -136,16 +246,45 @@ func TestFindAllTagsBelongedToPostFieldFunc(t *testing.T) {
 		repository = mock_blog.NewMockTagRepository(ctrl)
 	)
 
-	id := primitive.NewObjectID()
+	ctx := batch.WithBatching(context.Background())
 
-	repository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{id}).Return([]blog.Tag{{Name: "Test", Slug: "test-" + id.Hex()}}, nil)
+	t.Run("With successful finding all tags", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
 
-	// When
-	tags, err := FindAllTagsBelongedToPostFieldFunc(repository).(func(context.Context, blog.Post) ([]blog.Tag, error))(context.Background(), blog.Post{Tags: []mongo.DBRef{{ID: id}}})
+		repository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{id}).Return([]blog.Tag{{ID: id, Name: "Test", Slug: "test-" + id.Hex()}}, nil)
 
-	// Then
-	assert.Nil(t, err)
-	assert.Equal(t, []blog.Tag{{Name: "Test", Slug: "test-" + id.Hex()}}, tags)
+		// When
+		tags, err := FindAllTagsBelongedToPostFieldFunc(NewTagsBatchFunc(repository)).(func(context.Context, blog.Post) ([]blog.Tag, error))(ctx, blog.Post{Tags: []mongo.DBRef{{ID: id}}})
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.Tag{{ID: id, Name: "Test", Slug: "test-" + id.Hex()}}, tags)
+	})
+
+	t.Run("With no tag on the post", func(t *testing.T) {
+		// Given
+
+		// When
+		tags, err := FindAllTagsBelongedToPostFieldFunc(NewTagsBatchFunc(repository)).(func(context.Context, blog.Post) ([]blog.Tag, error))(ctx, blog.Post{})
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.Tag{}, tags)
+	})
+
+	t.Run("When unable to find all tags", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+
+		repository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{id}).Return(nil, errors.New("test unable to find all tags"))
+
+		// When
+		_, err := FindAllTagsBelongedToPostFieldFunc(NewTagsBatchFunc(repository)).(func(context.Context, blog.Post) ([]blog.Tag, error))(ctx, blog.Post{Tags: []mongo.DBRef{{ID: id}}})
+
+		// Then
+		assert.EqualError(t, err, "test unable to find all tags")
+	})
 }
 
 func TestFindAllLatestPublishedPostsFieldFunc(t *testing.T) {
@@ -168,8 +307,7 @@ func TestFindAllLatestPublishedPostsFieldFunc(t *testing.T) {
 	assert.Equal(t, []blog.Post{{Title: "Test", Slug: "test-" + id.Hex()}}, posts)
 }
 
-func TestFindAllLPPBelongedToCategoryFieldFunc(t *testing.T) {
-	// Given
+func TestFindAllLatestPublishedPostsFieldFunc_InvalidArguments(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -177,17 +315,229 @@ func TestFindAllLPPBelongedToCategoryFieldFunc(t *testing.T) {
 		repository = mock_blog.NewMockPostRepository(ctrl)
 	)
 
+	f := FindAllLatestPublishedPostsFieldFunc(repository).(func(context.Context, struct{ Offset, Limit int64 }) ([]blog.Post, error))
+
+	tests := map[string]struct {
+		offset, limit int64
+		expected      map[string]string
+	}{
+		"With zero limit": {
+			limit:    0,
+			expected: map[string]string{"limit": "must be an integer between 1 and 100"},
+		},
+		"With huge limit": {
+			limit:    1000000,
+			expected: map[string]string{"limit": "must be an integer between 1 and 100"},
+		},
+		"With negative offset": {
+			offset:   -1,
+			limit:    5,
+			expected: map[string]string{"offset": "must be a non-negative integer"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Given
+
+			// When
+			_, err := f(context.Background(), struct{ Offset, Limit int64 }{Offset: test.offset, Limit: test.limit})
+
+			// Then
+			assert.Equal(t, NewValidationError(test.expected), err)
+		})
+	}
+}
+
+func TestFindAllLPPBelongedToCategoryFieldFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository         = mock_blog.NewMockPostRepository(ctrl)
+		categoryRepository = mock_blog.NewMockCategoryRepository(ctrl)
+	)
+
+	type args = struct {
+		Offset, Limit      int64
+		IncludeDescendants bool `graphql:",optional"`
+	}
+
 	id := primitive.NewObjectID()
 	postID := primitive.NewObjectID()
+	f := FindAllLPPBelongedToCategoryFieldFunc(repository, NewCategoryTreeBatchFunc(categoryRepository)).(func(context.Context, blog.Category, args) ([]blog.Post, error))
+
+	t.Run("With successful finding all latest published posts of the category", func(t *testing.T) {
+		// Given
+		repository.EXPECT().FindAll(gomock.Any(), blog.NewPostQueryBuilder().WithCategory(blog.Category{ID: id}).WithStatus(blog.StatusPublished).WithOffset(0).WithLimit(6).Build()).Return([]blog.Post{{Title: "Test", Slug: "test-" + postID.Hex()}}, nil)
+
+		// When
+		posts, err := f(context.Background(), blog.Category{ID: id}, args{Offset: 0, Limit: 6})
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.Post{{Title: "Test", Slug: "test-" + postID.Hex()}}, posts)
+	})
+
+	t.Run("With including posts of all descendant categories", func(t *testing.T) {
+		// Given
+		cat := blog.Category{ID: id}
+		child := blog.Category{ID: primitive.NewObjectID(), Parent: mongo.DBRef{ID: id}}
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{cat, child}, nil)
+		repository.EXPECT().FindAll(gomock.Any(), blog.NewPostQueryBuilder().WithCategory(cat, child).WithStatus(blog.StatusPublished).WithOffset(0).WithLimit(6).Build()).Return([]blog.Post{{Title: "Test", Slug: "test-" + postID.Hex()}}, nil)
+
+		// When
+		posts, err := f(batch.WithBatching(context.Background()), cat, args{Offset: 0, Limit: 6, IncludeDescendants: true})
+
+		// Then
+		assert.Nil(t, err)
+		assert.Len(t, posts, 1)
+	})
+}
+
+func TestUpdateCategoryParentFieldFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository = mock_blog.NewMockCategoryRepository(ctrl)
+		bus        = mock_eventbus.NewMockBus(ctrl)
+	)
+
+	type args = struct {
+		Slug       Slug
+		ParentSlug Slug `graphql:",optional"`
+	}
+
+	parent := blog.Category{ID: primitive.NewObjectID(), Slug: "programming"}
+	child := blog.Category{ID: primitive.NewObjectID(), Slug: "go", Parent: mongo.DBRef{ID: parent.ID}}
+	f := UpdateCategoryParentFieldFunc(repository, bus).(func(context.Context, args) (blog.Category, error))
+
+	t.Run("With successful updating the parent category", func(t *testing.T) {
+		// Given
+		other := blog.Category{ID: primitive.NewObjectID(), Slug: "web"}
+		repository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{parent, child, other}, nil)
+		repository.EXPECT().UpdateParent(gomock.Any(), child.ID, other.ID).Return(child, nil)
+		bus.EXPECT().Publish(gomock.Any(), blog.CategoryParentChanged{Category: child})
+
+		// When
+		_, err := f(context.Background(), args{Slug: Slug("go-" + child.ID.Hex()), ParentSlug: Slug("web-" + other.ID.Hex())})
+
+		// Then
+		assert.Nil(t, err)
+	})
+
+	t.Run("With successful removing the parent category", func(t *testing.T) {
+		// Given
+		repository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{parent, child}, nil)
+		repository.EXPECT().UpdateParent(gomock.Any(), child.ID, primitive.NilObjectID).Return(child, nil)
+		bus.EXPECT().Publish(gomock.Any(), blog.CategoryParentChanged{Category: child})
+
+		// When
+		_, err := f(context.Background(), args{Slug: Slug("go-" + child.ID.Hex())})
+
+		// Then
+		assert.Nil(t, err)
+	})
+
+	t.Run("When the parent category is one of its descendants", func(t *testing.T) {
+		// Given
+		repository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{parent, child}, nil)
+
+		// When
+		_, err := f(context.Background(), args{Slug: Slug("programming-" + parent.ID.Hex()), ParentSlug: Slug("go-" + child.ID.Hex())})
+
+		// Then
+		assert.EqualError(t, err, "Bad Request")
+	})
+
+	t.Run("When unable to update the parent category", func(t *testing.T) {
+		// Given
+		repository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{parent, child}, nil)
+		repository.EXPECT().UpdateParent(gomock.Any(), child.ID, primitive.NilObjectID).Return(blog.Category{}, errors.New("test unable to update the parent category"))
+
+		// When
+		_, err := f(context.Background(), args{Slug: Slug("go-" + child.ID.Hex())})
+
+		// Then
+		assert.EqualError(t, err, "test unable to update the parent category")
+	})
+
+	t.Run("When the category does not exist", func(t *testing.T) {
+		// Given
+		repository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{parent}, nil)
+
+		// When
+		_, err := f(context.Background(), args{Slug: Slug("go-" + child.ID.Hex())})
 
-	repository.EXPECT().FindAll(gomock.Any(), blog.NewPostQueryBuilder().WithCategory(blog.Category{ID: id}).WithStatus(blog.StatusPublished).WithOffset(0).WithLimit(6).Build()).Return([]blog.Post{{Title: "Test", Slug: "test-" + postID.Hex()}}, nil)
+		// Then
+		assert.EqualError(t, err, "Not Found")
+	})
+}
+
+func TestCategoryHierarchyFieldFuncs(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository = mock_blog.NewMockCategoryRepository(ctrl)
+	)
+
+	parent := blog.Category{ID: primitive.NewObjectID(), Name: "Programming", Slug: "programming"}
+	child := blog.Category{ID: primitive.NewObjectID(), Name: "Go", Slug: "go", Parent: mongo.DBRef{ID: parent.ID}}
+	f := NewCategoryTreeBatchFunc(repository)
+	ctx := batch.WithBatching(context.Background())
+
+	repository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{parent, child}, nil)
 
 	// When
-	posts, err := FindAllLPPBelongedToCategoryFieldFunc(repository).(func(context.Context, blog.Category, struct{ Offset, Limit int64 }) ([]blog.Post, error))(context.Background(), blog.Category{ID: id}, struct{ Offset, Limit int64 }{Offset: 0, Limit: 6})
+	var (
+		wg          sync.WaitGroup
+		parentOf    *blog.Category
+		children    []blog.Category
+		ancestors   []blog.Category
+		permalink   string
+		breadcrumbs []blog.Breadcrumb
+	)
+	wg.Add(5)
+	go func() {
+		defer wg.Done()
+		parentOf, _ = FindParentOfCategoryFieldFunc(f).(func(context.Context, blog.Category) (*blog.Category, error))(ctx, child)
+	}()
+	go func() {
+		defer wg.Done()
+		children, _ = FindAllChildrenOfCategoryFieldFunc(f).(func(context.Context, blog.Category) ([]blog.Category, error))(ctx, parent)
+	}()
+	go func() {
+		defer wg.Done()
+		ancestors, _ = FindAllAncestorsOfCategoryFieldFunc(f).(func(context.Context, blog.Category) ([]blog.Category, error))(ctx, child)
+	}()
+	go func() {
+		defer wg.Done()
+		permalink, _ = GetCategoryPermalinkFieldFunc(f).(func(context.Context, blog.Category) (string, error))(ctx, child)
+	}()
+	go func() {
+		defer wg.Done()
+		breadcrumbs, _ = FindAllBreadcrumbsOfPostFieldFunc(f).(func(context.Context, blog.Post) ([]blog.Breadcrumb, error))(ctx, blog.Post{
+			Title:       "Test",
+			Slug:        "test",
+			PublishedAt: time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC),
+			Categories:  []mongo.DBRef{{ID: child.ID}},
+		})
+	}()
+	wg.Wait()
 
 	// Then
-	assert.Nil(t, err)
-	assert.Equal(t, []blog.Post{{Title: "Test", Slug: "test-" + postID.Hex()}}, posts)
+	assert.Equal(t, &parent, parentOf)
+	assert.Equal(t, []blog.Category{child}, children)
+	assert.Equal(t, []blog.Category{parent}, ancestors)
+	assert.Equal(t, "/category/programming/go", permalink)
+	assert.Equal(t, []blog.Breadcrumb{
+		{Name: "Programming", Path: "/category/programming"},
+		{Name: "Go", Path: "/category/programming/go"},
+		{Name: "Test", Path: "/2020/3/29/test"},
+	}, breadcrumbs)
 }
 
 func TestFindAllLPPBelongedToTagFieldFunc(t *testing.T) {
@@ -212,6 +562,76 @@ func TestFindAllLPPBelongedToTagFieldFunc(t *testing.T) {
 	assert.Equal(t, []blog.Post{{Title: "Test", Slug: "test-" + postID.Hex()}}, posts)
 }
 
+func TestGetCategoryStatsFieldFunc(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository = mock_blog.NewMockPostRepository(ctrl)
+	)
+
+	id := primitive.NewObjectID()
+	emptyID := primitive.NewObjectID()
+	publishedAt := time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC)
+	f := NewStatsBatchFunc(repository.FindAllCategoryStats)
+	ctx := batch.WithBatching(context.Background())
+
+	repository.EXPECT().FindAllCategoryStats(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ids interface{}) (map[primitive.ObjectID]blog.Stats, error) {
+		assert.ElementsMatch(t, []primitive.ObjectID{id, id, emptyID}, ids)
+		return map[primitive.ObjectID]blog.Stats{id: {PostCount: 2, LatestPublishedAt: publishedAt}}, nil
+	})
+
+	// When
+	var (
+		wg          sync.WaitGroup
+		postCount   int64
+		latest      *time.Time
+		emptyLatest *time.Time
+	)
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		postCount, _ = GetCategoryPostCountFieldFunc(f).(func(context.Context, blog.Category) (int64, error))(ctx, blog.Category{ID: id})
+	}()
+	go func() {
+		defer wg.Done()
+		latest, _ = GetCategoryLatestPublishedAtFieldFunc(f).(func(context.Context, blog.Category) (*time.Time, error))(ctx, blog.Category{ID: id})
+	}()
+	go func() {
+		defer wg.Done()
+		emptyLatest, _ = GetCategoryLatestPublishedAtFieldFunc(f).(func(context.Context, blog.Category) (*time.Time, error))(ctx, blog.Category{ID: emptyID})
+	}()
+	wg.Wait()
+
+	// Then
+	assert.Equal(t, int64(2), postCount)
+	assert.Equal(t, &publishedAt, latest)
+	assert.Nil(t, emptyLatest)
+}
+
+func TestGetTagStatsFieldFunc(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository = mock_blog.NewMockPostRepository(ctrl)
+	)
+
+	id := primitive.NewObjectID()
+	f := NewStatsBatchFunc(repository.FindAllTagStats)
+	ctx := batch.WithBatching(context.Background())
+
+	repository.EXPECT().FindAllTagStats(gomock.Any(), []primitive.ObjectID{id}).Return(nil, errors.New("test unable to find all tag stats"))
+
+	// When
+	_, err := GetTagPostCountFieldFunc(f).(func(context.Context, blog.Tag) (int64, error))(ctx, blog.Tag{ID: id})
+
+	// Then
+	assert.EqualError(t, err, "test unable to find all tag stats")
+}
+
 func TestFindAllMyPostsFieldFunc(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -231,6 +651,12 @@ func TestFindAllMyPostsFieldFunc(t *testing.T) {
 	// Then
 	assert.Nil(t, err)
 	assert.Equal(t, []blog.Post{{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}}, posts)
+
+	// When
+	_, err = FindAllMyPostsFieldFunc(repository).(func(context.Context, struct{ Offset, Limit int64 }) ([]blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct{ Offset, Limit int64 }{Offset: 0, Limit: 0})
+
+	// Then
+	assert.Equal(t, NewValidationError(map[string]string{"limit": "must be an integer between 1 and 100"}), err)
 }
 
 func TestFindPostBySlugFieldFunc(t *testing.T) {
@@ -248,7 +674,7 @@ func TestFindPostBySlugFieldFunc(t *testing.T) {
 		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
 
 		// When
-		p, err := FindPostBySlugFieldFunc(repository).(func(context.Context, struct{ Slug Slug }) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct{ Slug Slug }{Slug: Slug("test-" + id.Hex())})
+		p, err := FindPostBySlugFieldFunc(repository, NewLivePosts()).(func(context.Context, struct{ Slug Slug }) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct{ Slug Slug }{Slug: Slug("test-" + id.Hex())})
 
 		// Then
 		assert.Nil(t, err)
@@ -259,15 +685,28 @@ func TestFindPostBySlugFieldFunc(t *testing.T) {
 		// Given
 		id := primitive.NewObjectID()
 
-		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, errors.New("test unable to find a post"))
+		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, mgo.ErrNoDocuments)
 
 		// When
-		_, err := FindPostBySlugFieldFunc(repository).(func(context.Context, struct{ Slug Slug }) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct{ Slug Slug }{Slug: Slug("test-" + id.Hex())})
+		_, err := FindPostBySlugFieldFunc(repository, NewLivePosts()).(func(context.Context, struct{ Slug Slug }) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct{ Slug Slug }{Slug: Slug("test-" + id.Hex())})
 
 		// Then
 		assert.EqualError(t, err, "Not Found")
 	})
 
+	t.Run("When unable to query the database", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+
+		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, errors.New("test unable to find a post"))
+
+		// When
+		_, err := FindPostBySlugFieldFunc(repository, NewLivePosts()).(func(context.Context, struct{ Slug Slug }) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct{ Slug Slug }{Slug: Slug("test-" + id.Hex())})
+
+		// Then
+		assert.EqualError(t, err, "test unable to find a post")
+	})
+
 	t.Run("When finding a published post", func(t *testing.T) {
 		// Given
 		id := primitive.NewObjectID()
@@ -275,7 +714,7 @@ func TestFindPostBySlugFieldFunc(t *testing.T) {
 		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), Status: blog.StatusPublished}, nil)
 
 		// When
-		p, err := FindPostBySlugFieldFunc(repository).(func(context.Context, struct{ Slug Slug }) (blog.Post, error))(context.Background(), struct{ Slug Slug }{Slug: Slug("test-" + id.Hex())})
+		p, err := FindPostBySlugFieldFunc(repository, NewLivePosts()).(func(context.Context, struct{ Slug Slug }) (blog.Post, error))(context.Background(), struct{ Slug Slug }{Slug: Slug("test-" + id.Hex())})
 
 		// Then
 		assert.Nil(t, err)
@@ -289,7 +728,7 @@ func TestFindPostBySlugFieldFunc(t *testing.T) {
 		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex()}, nil)
 
 		// When
-		_, err := FindPostBySlugFieldFunc(repository).(func(context.Context, struct{ Slug Slug }) (blog.Post, error))(context.Background(), struct{ Slug Slug }{Slug: Slug("test-" + id.Hex())})
+		_, err := FindPostBySlugFieldFunc(repository, NewLivePosts()).(func(context.Context, struct{ Slug Slug }) (blog.Post, error))(context.Background(), struct{ Slug Slug }{Slug: Slug("test-" + id.Hex())})
 
 		// Then
 		assert.EqualError(t, err, "Forbidden")
@@ -323,6 +762,8 @@ func TestUpdatePostTitleFieldFunc(t *testing.T) {
 
 	var (
 		repository = mock_blog.NewMockPostRepository(ctrl)
+		bus        = mock_eventbus.NewMockBus(ctrl)
+		validator  = PostValidator{}
 	)
 
 	t.Run("With successful updating post title", func(t *testing.T) {
@@ -331,9 +772,10 @@ func TestUpdatePostTitleFieldFunc(t *testing.T) {
 
 		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
 		repository.EXPECT().Save(gomock.Any(), id, blog.NewPostQueryBuilder().WithTitle("Test2").WithSlug("test2-"+id.Hex()).Build()).Return(blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), AuthorID: "authorizedID"}, nil)
+		bus.EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(blog.PostContentChanged{}))
 
 		// When
-		p, err := UpdatePostTitleFieldFunc(repository).(func(context.Context, struct {
+		p, err := UpdatePostTitleFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug  Slug
 			Title string
 		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
@@ -353,10 +795,10 @@ func TestUpdatePostTitleFieldFunc(t *testing.T) {
 		// Given
 		id := primitive.NewObjectID()
 
-		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, errors.New("test unable to find a post"))
+		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, mgo.ErrNoDocuments)
 
 		// When
-		_, err := UpdatePostTitleFieldFunc(repository).(func(context.Context, struct {
+		_, err := UpdatePostTitleFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug  Slug
 			Title string
 		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
@@ -371,17 +813,17 @@ func TestUpdatePostTitleFieldFunc(t *testing.T) {
 		assert.EqualError(t, err, "Not Found")
 	})
 
-	t.Run("When try to update other post title", func(t *testing.T) {
+	t.Run("When unable to query the database", func(t *testing.T) {
 		// Given
 		id := primitive.NewObjectID()
 
-		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
+		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, errors.New("test unable to find a post"))
 
 		// When
-		_, err := UpdatePostTitleFieldFunc(repository).(func(context.Context, struct {
+		_, err := UpdatePostTitleFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug  Slug
 			Title string
-		}) (blog.Post, error))(context.Background(), struct {
+		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
 			Slug  Slug
 			Title string
 		}{
@@ -390,78 +832,167 @@ func TestUpdatePostTitleFieldFunc(t *testing.T) {
 		})
 
 		// Then
-		assert.EqualError(t, err, "Forbidden")
+		assert.EqualError(t, err, "test unable to find a post")
 	})
-}
-
-func TestUpdatePostStatusFieldFunc(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
 
-	var (
-		repository = mock_blog.NewMockPostRepository(ctrl)
-	)
-
-	now := time.Date(2020, 4, 6, 9, 42, 0, 0, time.UTC)
-	f := faketime.NewFaketimeWithTime(now)
-	defer f.Undo()
-	f.Do()
-
-	t.Run("With successful updating post status", func(t *testing.T) {
+	t.Run("When try to update other post title", func(t *testing.T) {
 		// Given
 		id := primitive.NewObjectID()
 
-		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), Status: blog.StatusDraft, AuthorID: "authorizedID"}, nil)
-		repository.EXPECT().Save(gomock.Any(), id, blog.NewPostQueryBuilder().WithStatus(blog.StatusPublished).WithPublishedAt(now).Build()).Return(blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), Status: blog.StatusPublished, AuthorID: "authorizedID", PublishedAt: now}, nil)
+		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
 
 		// When
-		p, err := UpdatePostStatusFieldFunc(repository).(func(context.Context, struct {
-			Slug   Slug
-			Status blog.Status
-		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
-			Slug   Slug
-			Status blog.Status
+		_, err := UpdatePostTitleFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug  Slug
+			Title string
+		}) (blog.Post, error))(context.Background(), struct {
+			Slug  Slug
+			Title string
 		}{
-			Slug:   Slug("test-" + id.Hex()),
-			Status: blog.StatusPublished,
+			Slug:  Slug("test-" + id.Hex()),
+			Title: "Test2",
 		})
 
 		// Then
-		assert.Nil(t, err)
-		assert.Equal(t, blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), Status: blog.StatusPublished, AuthorID: "authorizedID", PublishedAt: now}, p)
+		assert.EqualError(t, err, "Forbidden")
 	})
 
-	t.Run("When unable to find a post", func(t *testing.T) {
+	t.Run("When the post slug is malformed", func(t *testing.T) {
 		// Given
-		id := primitive.NewObjectID()
-
-		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, errors.New("test unable to find a post"))
 
 		// When
-		_, err := UpdatePostStatusFieldFunc(repository).(func(context.Context, struct {
-			Slug   Slug
-			Status blog.Status
+		_, err := UpdatePostTitleFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug  Slug
+			Title string
 		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
-			Slug   Slug
-			Status blog.Status
+			Slug  Slug
+			Title string
 		}{
-			Slug:   Slug("test-" + id.Hex()),
-			Status: blog.StatusPublished,
+			Slug:  Slug("test-invalid-object-id"),
+			Title: "Test2",
 		})
 
 		// Then
-		assert.EqualError(t, err, "Not Found")
+		assert.Equal(t, NewValidationError(map[string]string{"slug": `"test-invalid-object-id" is not a valid slug`}), err)
 	})
 
-	t.Run("When updating already published post", func(t *testing.T) {
+	t.Run("When the title is blank", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
+
+		// When
+		_, err := UpdatePostTitleFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug  Slug
+			Title string
+		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
+			Slug  Slug
+			Title string
+		}{
+			Slug:  Slug("test-" + id.Hex()),
+			Title: "  ",
+		})
+
+		// Then
+		assert.Equal(t, NewValidationError(map[string]string{"title": "must not be blank"}), err)
+	})
+
+	t.Run("When the title is too long", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
+
+		// When
+		_, err := UpdatePostTitleFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug  Slug
+			Title string
+		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
+			Slug  Slug
+			Title string
+		}{
+			Slug:  Slug("test-" + id.Hex()),
+			Title: strings.Repeat("a", MaxPostTitleLength+1),
+		})
+
+		// Then
+		assert.Equal(t, NewValidationError(map[string]string{"title": "must not exceed 200 characters"}), err)
+	})
+}
+
+func TestUpdatePostStatusFieldFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository = mock_blog.NewMockPostRepository(ctrl)
+		bus        = mock_eventbus.NewMockBus(ctrl)
+		validator  = PostValidator{}
+	)
+
+	now := time.Date(2020, 4, 6, 9, 42, 0, 0, time.UTC)
+	f := faketime.NewFaketimeWithTime(now)
+	defer f.Undo()
+	f.Do()
+
+	t.Run("With successful updating post status", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), Status: blog.StatusDraft, AuthorID: "authorizedID"}, nil)
+		repository.EXPECT().Save(gomock.Any(), id, blog.NewPostQueryBuilder().WithStatus(blog.StatusPublished).WithPublishedAt(now).Build()).Return(blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), Status: blog.StatusPublished, AuthorID: "authorizedID", PublishedAt: now}, nil)
+		bus.EXPECT().Publish(gomock.Any(), blog.PostPublished{Post: blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), Status: blog.StatusPublished, AuthorID: "authorizedID", PublishedAt: now}})
+
+		// When
+		p, err := UpdatePostStatusFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug   Slug
+			Status blog.Status
+		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
+			Slug   Slug
+			Status blog.Status
+		}{
+			Slug:   Slug("test-" + id.Hex()),
+			Status: blog.StatusPublished,
+		})
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), Status: blog.StatusPublished, AuthorID: "authorizedID", PublishedAt: now}, p)
+	})
+
+	t.Run("When unable to find a post", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+
+		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, mgo.ErrNoDocuments)
+
+		// When
+		_, err := UpdatePostStatusFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug   Slug
+			Status blog.Status
+		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
+			Slug   Slug
+			Status blog.Status
+		}{
+			Slug:   Slug("test-" + id.Hex()),
+			Status: blog.StatusPublished,
+		})
+
+		// Then
+		assert.EqualError(t, err, "Not Found")
+	})
+
+	t.Run("When updating already published post", func(t *testing.T) {
 		// Given
 		id := primitive.NewObjectID()
 
 		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), Status: blog.StatusPublished, AuthorID: "authorizedID", PublishedAt: now}, nil)
 		repository.EXPECT().Save(gomock.Any(), gomock.Any(), blog.NewPostQueryBuilder().WithStatus(blog.StatusPublished).Build()).Return(blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), Status: blog.StatusPublished, AuthorID: "authorizedID", PublishedAt: now}, nil)
+		bus.EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(blog.PostContentChanged{}))
 
 		// When
-		p, err := UpdatePostStatusFieldFunc(repository).(func(context.Context, struct {
+		p, err := UpdatePostStatusFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug   Slug
 			Status blog.Status
 		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
@@ -477,6 +1008,31 @@ func TestUpdatePostStatusFieldFunc(t *testing.T) {
 		assert.Equal(t, blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), Status: blog.StatusPublished, AuthorID: "authorizedID", PublishedAt: now}, p)
 	})
 
+	t.Run("With successful unpublishing post", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), Status: blog.StatusPublished, AuthorID: "authorizedID", PublishedAt: now}, nil)
+		repository.EXPECT().Save(gomock.Any(), id, blog.NewPostQueryBuilder().WithStatus(blog.StatusDraft).Build()).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), Status: blog.StatusDraft, AuthorID: "authorizedID", PublishedAt: now}, nil)
+		bus.EXPECT().Publish(gomock.Any(), blog.PostUnpublished{Post: blog.Post{Title: "Test", Slug: "test-" + id.Hex(), Status: blog.StatusDraft, AuthorID: "authorizedID", PublishedAt: now}})
+
+		// When
+		p, err := UpdatePostStatusFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug   Slug
+			Status blog.Status
+		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
+			Slug   Slug
+			Status blog.Status
+		}{
+			Slug:   Slug("test-" + id.Hex()),
+			Status: blog.StatusDraft,
+		})
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, blog.StatusDraft, p.Status)
+	})
+
 	t.Run("When try to update other post status", func(t *testing.T) {
 		// Given
 		id := primitive.NewObjectID()
@@ -484,7 +1040,7 @@ func TestUpdatePostStatusFieldFunc(t *testing.T) {
 		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
 
 		// When
-		_, err := UpdatePostStatusFieldFunc(repository).(func(context.Context, struct {
+		_, err := UpdatePostStatusFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug   Slug
 			Status blog.Status
 		}) (blog.Post, error))(context.Background(), struct {
@@ -498,6 +1054,28 @@ func TestUpdatePostStatusFieldFunc(t *testing.T) {
 		// Then
 		assert.EqualError(t, err, "Forbidden")
 	})
+
+	t.Run("When the status is invalid", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
+
+		// When
+		_, err := UpdatePostStatusFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug   Slug
+			Status blog.Status
+		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
+			Slug   Slug
+			Status blog.Status
+		}{
+			Slug:   Slug("test-" + id.Hex()),
+			Status: blog.Status("DELETED"),
+		})
+
+		// Then
+		assert.Equal(t, NewValidationError(map[string]string{"status": "must be either PUBLISHED or DRAFT"}), err)
+	})
 }
 
 func TestUpdatePostContentFieldFunc(t *testing.T) {
@@ -506,6 +1084,8 @@ func TestUpdatePostContentFieldFunc(t *testing.T) {
 
 	var (
 		repository = mock_blog.NewMockPostRepository(ctrl)
+		bus        = mock_eventbus.NewMockBus(ctrl)
+		validator  = PostValidator{}
 	)
 
 	t.Run("With successful updating post content", func(t *testing.T) {
@@ -514,9 +1094,10 @@ func TestUpdatePostContentFieldFunc(t *testing.T) {
 
 		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
 		repository.EXPECT().Save(gomock.Any(), id, blog.NewPostQueryBuilder().WithMarkdown("Test").WithHTML("<p>Test</p>\n").Build()).Return(blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), Markdown: "Test", HTML: "<p>Test</p>\n", AuthorID: "authorizedID"}, nil)
+		bus.EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(blog.PostContentChanged{}))
 
 		// When
-		p, err := UpdatePostContentFieldFunc(repository).(func(context.Context, struct {
+		p, err := UpdatePostContentFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug     Slug
 			Markdown string
 		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
@@ -536,10 +1117,10 @@ func TestUpdatePostContentFieldFunc(t *testing.T) {
 		// Given
 		id := primitive.NewObjectID()
 
-		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, errors.New("test unable to find a post"))
+		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, mgo.ErrNoDocuments)
 
 		// When
-		_, err := UpdatePostContentFieldFunc(repository).(func(context.Context, struct {
+		_, err := UpdatePostContentFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug     Slug
 			Markdown string
 		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
@@ -561,7 +1142,7 @@ func TestUpdatePostContentFieldFunc(t *testing.T) {
 		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
 
 		// When
-		_, err := UpdatePostContentFieldFunc(repository).(func(context.Context, struct {
+		_, err := UpdatePostContentFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug     Slug
 			Markdown string
 		}) (blog.Post, error))(context.Background(), struct {
@@ -575,6 +1156,28 @@ func TestUpdatePostContentFieldFunc(t *testing.T) {
 		// Then
 		assert.EqualError(t, err, "Forbidden")
 	})
+
+	t.Run("When the content is too long", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
+
+		// When
+		_, err := UpdatePostContentFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug     Slug
+			Markdown string
+		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
+			Slug     Slug
+			Markdown string
+		}{
+			Slug:     Slug("test-" + id.Hex()),
+			Markdown: strings.Repeat("a", MaxPostMarkdownLength+1),
+		})
+
+		// Then
+		assert.Equal(t, NewValidationError(map[string]string{"markdown": "must not exceed 100000 characters"}), err)
+	})
 }
 
 func TestUpdatePostCategoriesFieldFunc(t *testing.T) {
@@ -582,7 +1185,10 @@ func TestUpdatePostCategoriesFieldFunc(t *testing.T) {
 	defer ctrl.Finish()
 
 	var (
-		repository = mock_blog.NewMockPostRepository(ctrl)
+		repository         = mock_blog.NewMockPostRepository(ctrl)
+		bus                = mock_eventbus.NewMockBus(ctrl)
+		categoryRepository = mock_blog.NewMockCategoryRepository(ctrl)
+		validator          = NewPostValidator(categoryRepository, nil, nil)
 	)
 
 	t.Run("With successful updating post categories", func(t *testing.T) {
@@ -591,10 +1197,12 @@ func TestUpdatePostCategoriesFieldFunc(t *testing.T) {
 		catID := primitive.NewObjectID()
 
 		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
+		categoryRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{catID}).Return([]blog.Category{{ID: catID}}, nil)
 		repository.EXPECT().Save(gomock.Any(), id, blog.NewPostQueryBuilder().WithCategories([]blog.Category{{ID: catID}}).Build()).Return(blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), AuthorID: "authorizedID", Categories: []mongo.DBRef{{ID: catID}}}, nil)
+		bus.EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(blog.PostContentChanged{}))
 
 		// When
-		p, err := UpdatePostCategoriesFieldFunc(repository).(func(context.Context, struct {
+		p, err := UpdatePostCategoriesFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug          Slug
 			CategorySlugs []Slug
 		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
@@ -615,10 +1223,10 @@ func TestUpdatePostCategoriesFieldFunc(t *testing.T) {
 		id := primitive.NewObjectID()
 		catID := primitive.NewObjectID()
 
-		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, errors.New("test unable to find a post"))
+		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, mgo.ErrNoDocuments)
 
 		// When
-		_, err := UpdatePostCategoriesFieldFunc(repository).(func(context.Context, struct {
+		_, err := UpdatePostCategoriesFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug          Slug
 			CategorySlugs []Slug
 		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
@@ -641,7 +1249,7 @@ func TestUpdatePostCategoriesFieldFunc(t *testing.T) {
 		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
 
 		// When
-		_, err := UpdatePostCategoriesFieldFunc(repository).(func(context.Context, struct {
+		_, err := UpdatePostCategoriesFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug          Slug
 			CategorySlugs []Slug
 		}) (blog.Post, error))(context.Background(), struct {
@@ -655,6 +1263,53 @@ func TestUpdatePostCategoriesFieldFunc(t *testing.T) {
 		// Then
 		assert.EqualError(t, err, "Forbidden")
 	})
+
+	t.Run("When the category does not exist", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+		catID := primitive.NewObjectID()
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
+		categoryRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{catID}).Return(nil, nil)
+
+		// When
+		_, err := UpdatePostCategoriesFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug          Slug
+			CategorySlugs []Slug
+		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
+			Slug          Slug
+			CategorySlugs []Slug
+		}{
+			Slug:          Slug("test-" + id.Hex()),
+			CategorySlugs: []Slug{Slug("test-" + catID.Hex())},
+		})
+
+		// Then
+		assert.Equal(t, NewValidationError(map[string]string{"categorySlugs": `"test-` + catID.Hex() + `" does not exist`}), err)
+	})
+
+	t.Run("When the category slug is duplicated", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+		catID := primitive.NewObjectID()
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
+
+		// When
+		_, err := UpdatePostCategoriesFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug          Slug
+			CategorySlugs []Slug
+		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
+			Slug          Slug
+			CategorySlugs []Slug
+		}{
+			Slug:          Slug("test-" + id.Hex()),
+			CategorySlugs: []Slug{Slug("test-" + catID.Hex()), Slug("test-" + catID.Hex())},
+		})
+
+		// Then
+		assert.Equal(t, NewValidationError(map[string]string{"categorySlugs": `"test-` + catID.Hex() + `" is duplicated`}), err)
+	})
 }
 
 func TestUpdatePostTagsFieldFunc(t *testing.T) {
@@ -662,7 +1317,10 @@ func TestUpdatePostTagsFieldFunc(t *testing.T) {
 	defer ctrl.Finish()
 
 	var (
-		repository = mock_blog.NewMockPostRepository(ctrl)
+		repository    = mock_blog.NewMockPostRepository(ctrl)
+		bus           = mock_eventbus.NewMockBus(ctrl)
+		tagRepository = mock_blog.NewMockTagRepository(ctrl)
+		validator     = NewPostValidator(nil, tagRepository, nil)
 	)
 
 	t.Run("With successful updating post tags", func(t *testing.T) {
@@ -671,10 +1329,12 @@ func TestUpdatePostTagsFieldFunc(t *testing.T) {
 		tagID := primitive.NewObjectID()
 
 		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
+		tagRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{tagID}).Return([]blog.Tag{{ID: tagID}}, nil)
 		repository.EXPECT().Save(gomock.Any(), id, blog.NewPostQueryBuilder().WithTags([]blog.Tag{{ID: tagID}}).Build()).Return(blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), AuthorID: "authorizedID", Tags: []mongo.DBRef{{ID: tagID}}}, nil)
+		bus.EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(blog.PostContentChanged{}))
 
 		// When
-		p, err := UpdatePostTagsFieldFunc(repository).(func(context.Context, struct {
+		p, err := UpdatePostTagsFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug     Slug
 			TagSlugs []Slug
 		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
@@ -695,10 +1355,10 @@ func TestUpdatePostTagsFieldFunc(t *testing.T) {
 		id := primitive.NewObjectID()
 		catID := primitive.NewObjectID()
 
-		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, errors.New("test unable to find a post"))
+		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, mgo.ErrNoDocuments)
 
 		// When
-		_, err := UpdatePostTagsFieldFunc(repository).(func(context.Context, struct {
+		_, err := UpdatePostTagsFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug     Slug
 			TagSlugs []Slug
 		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
@@ -721,7 +1381,7 @@ func TestUpdatePostTagsFieldFunc(t *testing.T) {
 		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
 
 		// When
-		_, err := UpdatePostTagsFieldFunc(repository).(func(context.Context, struct {
+		_, err := UpdatePostTagsFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug     Slug
 			TagSlugs []Slug
 		}) (blog.Post, error))(context.Background(), struct {
@@ -735,6 +1395,52 @@ func TestUpdatePostTagsFieldFunc(t *testing.T) {
 		// Then
 		assert.EqualError(t, err, "Forbidden")
 	})
+
+	t.Run("When the tag slug is malformed", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
+
+		// When
+		_, err := UpdatePostTagsFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug     Slug
+			TagSlugs []Slug
+		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
+			Slug     Slug
+			TagSlugs []Slug
+		}{
+			Slug:     Slug("test-" + id.Hex()),
+			TagSlugs: []Slug{Slug("test")},
+		})
+
+		// Then
+		assert.Equal(t, NewValidationError(map[string]string{"tagSlugs": `"test" is not a valid slug`}), err)
+	})
+
+	t.Run("When unable to find all tags", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+		tagID := primitive.NewObjectID()
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
+		tagRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{tagID}).Return(nil, errors.New("test unable to find all tags"))
+
+		// When
+		_, err := UpdatePostTagsFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug     Slug
+			TagSlugs []Slug
+		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
+			Slug     Slug
+			TagSlugs []Slug
+		}{
+			Slug:     Slug("test-" + id.Hex()),
+			TagSlugs: []Slug{Slug("test-" + tagID.Hex())},
+		})
+
+		// Then
+		assert.EqualError(t, err, "test unable to find all tags")
+	})
 }
 
 func TestUpdatePostFeaturedImageFieldFunc(t *testing.T) {
@@ -742,7 +1448,10 @@ func TestUpdatePostFeaturedImageFieldFunc(t *testing.T) {
 	defer ctrl.Finish()
 
 	var (
-		repository = mock_blog.NewMockPostRepository(ctrl)
+		repository     = mock_blog.NewMockPostRepository(ctrl)
+		bus            = mock_eventbus.NewMockBus(ctrl)
+		fileRepository = mock_storage.NewMockFileRepository(ctrl)
+		validator      = NewPostValidator(nil, nil, fileRepository)
 	)
 
 	t.Run("With successful updating featured image", func(t *testing.T) {
@@ -750,11 +1459,15 @@ func TestUpdatePostFeaturedImageFieldFunc(t *testing.T) {
 		id := primitive.NewObjectID()
 		fileID := primitive.NewObjectID()
 
+		file := storage.File{ID: fileID, Path: "authorizedID/test-" + fileID.Hex() + ".png", FileName: "test.png"}
+
 		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
-		repository.EXPECT().Save(gomock.Any(), id, blog.NewPostQueryBuilder().WithFeaturedImage(storage.File{ID: fileID}).Build()).Return(blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), AuthorID: "authorizedID", FeaturedImage: mongo.DBRef{ID: fileID}}, nil)
+		fileRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{fileID}).Return([]storage.File{file}, nil)
+		repository.EXPECT().Save(gomock.Any(), id, blog.NewPostQueryBuilder().WithFeaturedImage(file).Build()).Return(blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), AuthorID: "authorizedID", FeaturedImage: mongo.DBRef{ID: fileID}}, nil)
+		bus.EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(blog.PostContentChanged{}))
 
 		// When
-		p, err := UpdatePostFeaturedImageFieldFunc(repository).(func(context.Context, struct {
+		p, err := UpdatePostFeaturedImageFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug              Slug
 			FeaturedImageSlug storage.Slug `graphql:",optional"`
 		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
@@ -775,10 +1488,10 @@ func TestUpdatePostFeaturedImageFieldFunc(t *testing.T) {
 		id := primitive.NewObjectID()
 		fileID := primitive.NewObjectID()
 
-		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, errors.New("test unable to  find a post"))
+		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, mgo.ErrNoDocuments)
 
 		// When
-		_, err := UpdatePostFeaturedImageFieldFunc(repository).(func(context.Context, struct {
+		_, err := UpdatePostFeaturedImageFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug              Slug
 			FeaturedImageSlug storage.Slug `graphql:",optional"`
 		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
@@ -799,9 +1512,10 @@ func TestUpdatePostFeaturedImageFieldFunc(t *testing.T) {
 
 		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
 		repository.EXPECT().Save(gomock.Any(), gomock.Any(), blog.NewPostQueryBuilder().WithFeaturedImage(storage.File{}).Build()).Return(blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), AuthorID: "authorizedID", FeaturedImage: mongo.DBRef{}}, nil)
+		bus.EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(blog.PostContentChanged{}))
 
 		// When
-		p, err := UpdatePostFeaturedImageFieldFunc(repository).(func(context.Context, struct {
+		p, err := UpdatePostFeaturedImageFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug              Slug
 			FeaturedImageSlug storage.Slug `graphql:",optional"`
 		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
@@ -824,7 +1538,7 @@ func TestUpdatePostFeaturedImageFieldFunc(t *testing.T) {
 		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
 
 		// When
-		_, err := UpdatePostFeaturedImageFieldFunc(repository).(func(context.Context, struct {
+		_, err := UpdatePostFeaturedImageFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug              Slug
 			FeaturedImageSlug storage.Slug `graphql:",optional"`
 		}) (blog.Post, error))(context.Background(), struct {
@@ -838,6 +1552,54 @@ func TestUpdatePostFeaturedImageFieldFunc(t *testing.T) {
 		// Then
 		assert.EqualError(t, err, "Forbidden")
 	})
+
+	t.Run("When the featured image is not an image", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+		fileID := primitive.NewObjectID()
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
+		fileRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{fileID}).Return([]storage.File{{ID: fileID, Path: "authorizedID/test-" + fileID.Hex() + ".pdf", FileName: "test.pdf"}}, nil)
+
+		// When
+		_, err := UpdatePostFeaturedImageFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug              Slug
+			FeaturedImageSlug storage.Slug `graphql:",optional"`
+		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
+			Slug              Slug
+			FeaturedImageSlug storage.Slug `graphql:",optional"`
+		}{
+			Slug:              Slug("test-" + id.Hex()),
+			FeaturedImageSlug: storage.Slug("test-" + fileID.Hex() + ".pdf"),
+		})
+
+		// Then
+		assert.Equal(t, NewValidationError(map[string]string{"featuredImageSlug": `"test-` + fileID.Hex() + `.pdf" is not an image`}), err)
+	})
+
+	t.Run("When the featured image does not belong to the author", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+		fileID := primitive.NewObjectID()
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
+		fileRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{fileID}).Return([]storage.File{{ID: fileID, Path: "otherID/test-" + fileID.Hex() + ".png", FileName: "test.png"}}, nil)
+
+		// When
+		_, err := UpdatePostFeaturedImageFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug              Slug
+			FeaturedImageSlug storage.Slug `graphql:",optional"`
+		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
+			Slug              Slug
+			FeaturedImageSlug storage.Slug `graphql:",optional"`
+		}{
+			Slug:              Slug("test-" + id.Hex()),
+			FeaturedImageSlug: storage.Slug("test-" + fileID.Hex() + ".png"),
+		})
+
+		// Then
+		assert.Equal(t, NewValidationError(map[string]string{"featuredImageSlug": `"test-` + fileID.Hex() + `.png" does not belong to the author`}), err)
+	})
 }
 
 func TestUpdatePostAttachmentsFieldFunc(t *testing.T) {
@@ -845,7 +1607,10 @@ func TestUpdatePostAttachmentsFieldFunc(t *testing.T) {
 	defer ctrl.Finish()
 
 	var (
-		repository = mock_blog.NewMockPostRepository(ctrl)
+		repository     = mock_blog.NewMockPostRepository(ctrl)
+		bus            = mock_eventbus.NewMockBus(ctrl)
+		fileRepository = mock_storage.NewMockFileRepository(ctrl)
+		validator      = NewPostValidator(nil, nil, fileRepository)
 	)
 
 	t.Run("With successful updating post attachments", func(t *testing.T) {
@@ -853,11 +1618,15 @@ func TestUpdatePostAttachmentsFieldFunc(t *testing.T) {
 		id := primitive.NewObjectID()
 		fileID := primitive.NewObjectID()
 
+		file := storage.File{ID: fileID, Path: "authorizedID/test-" + fileID.Hex() + ".pdf", FileName: "test.pdf"}
+
 		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
-		repository.EXPECT().Save(gomock.Any(), id, blog.NewPostQueryBuilder().WithAttachments([]storage.File{{ID: fileID}}).Build()).Return(blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), AuthorID: "authorizedID", Attachments: []mongo.DBRef{{ID: fileID}}}, nil)
+		fileRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{fileID}).Return([]storage.File{file}, nil)
+		repository.EXPECT().Save(gomock.Any(), id, blog.NewPostQueryBuilder().WithAttachments([]storage.File{file}).Build()).Return(blog.Post{Title: "Test2", Slug: "test2-" + id.Hex(), AuthorID: "authorizedID", Attachments: []mongo.DBRef{{ID: fileID}}}, nil)
+		bus.EXPECT().Publish(gomock.Any(), gomock.AssignableToTypeOf(blog.PostContentChanged{}))
 
 		// When
-		p, err := UpdatePostAttachmentsFieldFunc(repository).(func(context.Context, struct {
+		p, err := UpdatePostAttachmentsFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug            Slug
 			AttachmentSlugs []storage.Slug
 		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
@@ -878,10 +1647,10 @@ func TestUpdatePostAttachmentsFieldFunc(t *testing.T) {
 		id := primitive.NewObjectID()
 		fileID := primitive.NewObjectID()
 
-		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, errors.New("test unable to  find a post"))
+		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, mgo.ErrNoDocuments)
 
 		// When
-		_, err := UpdatePostAttachmentsFieldFunc(repository).(func(context.Context, struct {
+		_, err := UpdatePostAttachmentsFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug            Slug
 			AttachmentSlugs []storage.Slug
 		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
@@ -904,7 +1673,7 @@ func TestUpdatePostAttachmentsFieldFunc(t *testing.T) {
 		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
 
 		// When
-		_, err := UpdatePostAttachmentsFieldFunc(repository).(func(context.Context, struct {
+		_, err := UpdatePostAttachmentsFieldFunc(repository, validator, bus).(func(context.Context, struct {
 			Slug            Slug
 			AttachmentSlugs []storage.Slug
 		}) (blog.Post, error))(context.Background(), struct {
@@ -918,6 +1687,30 @@ func TestUpdatePostAttachmentsFieldFunc(t *testing.T) {
 		// Then
 		assert.EqualError(t, err, "Forbidden")
 	})
+
+	t.Run("When the attachment does not exist", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+		fileID := primitive.NewObjectID()
+
+		repository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{Title: "Test", Slug: "test-" + id.Hex(), AuthorID: "authorizedID"}, nil)
+		fileRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{fileID}).Return(nil, nil)
+
+		// When
+		_, err := UpdatePostAttachmentsFieldFunc(repository, validator, bus).(func(context.Context, struct {
+			Slug            Slug
+			AttachmentSlugs []storage.Slug
+		}) (blog.Post, error))(context.WithValue(context.Background(), AuthorizedID, "authorizedID"), struct {
+			Slug            Slug
+			AttachmentSlugs []storage.Slug
+		}{
+			Slug:            Slug("test-" + id.Hex()),
+			AttachmentSlugs: []storage.Slug{storage.Slug("test-" + fileID.Hex() + ".pdf")},
+		})
+
+		// Then
+		assert.Equal(t, NewValidationError(map[string]string{"attachmentSlugs": `"test-` + fileID.Hex() + `.pdf" does not exist`}), err)
+	})
 }
 
 func TestFindFeaturedImageBelongedToPostFieldFunc(t *testing.T) {
@@ -929,15 +1722,43 @@ func TestFindFeaturedImageBelongedToPostFieldFunc(t *testing.T) {
 		repository = mock_storage.NewMockFileRepository(ctrl)
 	)
 
-	id := primitive.NewObjectID()
+	ctx := batch.WithBatching(context.Background())
 
-	repository.EXPECT().FindByID(gomock.Any(), id).Return(storage.File{Path: filepath.Join("authorizedID", "test.png"), FileName: "test.png", Slug: "test-" + id.Hex() + ".png"}, nil)
+	t.Run("With successful finding the featured image", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
 
-	// When
-	f := FindFeaturedImageBelongedToPostFieldFunc(repository).(func(context.Context, blog.Post) storage.File)(context.Background(), blog.Post{FeaturedImage: mongo.DBRef{ID: id}})
+		repository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{id}).Return([]storage.File{{ID: id, Path: filepath.Join("authorizedID", "test.png"), FileName: "test.png", Slug: "test-" + id.Hex() + ".png"}}, nil)
 
-	// Then
-	assert.Equal(t, storage.File{Path: filepath.Join("authorizedID", "test.png"), FileName: "test.png", Slug: "test-" + id.Hex() + ".png"}, f)
+		// When
+		f := FindFeaturedImageBelongedToPostFieldFunc(NewFilesBatchFunc(repository)).(func(context.Context, blog.Post) storage.File)(ctx, blog.Post{FeaturedImage: mongo.DBRef{ID: id}})
+
+		// Then
+		assert.Equal(t, storage.File{ID: id, Path: filepath.Join("authorizedID", "test.png"), FileName: "test.png", Slug: "test-" + id.Hex() + ".png"}, f)
+	})
+
+	t.Run("With no featured image on the post", func(t *testing.T) {
+		// Given
+
+		// When
+		f := FindFeaturedImageBelongedToPostFieldFunc(NewFilesBatchFunc(repository)).(func(context.Context, blog.Post) storage.File)(ctx, blog.Post{})
+
+		// Then
+		assert.Equal(t, storage.File{}, f)
+	})
+
+	t.Run("When unable to find the featured image", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+
+		repository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{id}).Return(nil, errors.New("test unable to find all files"))
+
+		// When
+		f := FindFeaturedImageBelongedToPostFieldFunc(NewFilesBatchFunc(repository)).(func(context.Context, blog.Post) storage.File)(ctx, blog.Post{FeaturedImage: mongo.DBRef{ID: id}})
+
+		// Then
+		assert.Equal(t, storage.File{}, f)
+	})
 }
 
 func TestFindAllAttachmentsBelongedToPostFieldFunc(t *testing.T) {
@@ -950,15 +1771,37 @@ func TestFindAllAttachmentsBelongedToPostFieldFunc(t *testing.T) {
 	)
 
 	id := primitive.NewObjectID()
+	featuredImageID := primitive.NewObjectID()
+	f := NewFilesBatchFunc(repository)
+	ctx := batch.WithBatching(context.Background())
 
-	repository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{id}).Return([]storage.File{{Path: filepath.Join("authorizedID", "test.png"), FileName: "test.png", Slug: "test-" + id.Hex() + ".png"}}, nil)
+	repository.EXPECT().FindAllByIDs(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ids interface{}) ([]storage.File, error) {
+		assert.ElementsMatch(t, []primitive.ObjectID{id, featuredImageID}, ids)
+		return []storage.File{{ID: id, FileName: "test.png"}, {ID: featuredImageID, FileName: "featured.png"}}, nil
+	})
 
 	// When
-	files, err := FindAllAttachmentsBelongedToPostFieldFunc(repository).(func(context.Context, blog.Post) ([]storage.File, error))(context.Background(), blog.Post{Attachments: []mongo.DBRef{{ID: id}}})
+	var (
+		wg            sync.WaitGroup
+		files         []storage.File
+		err           error
+		featuredImage storage.File
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		files, err = FindAllAttachmentsBelongedToPostFieldFunc(f).(func(context.Context, blog.Post) ([]storage.File, error))(ctx, blog.Post{Attachments: []mongo.DBRef{{ID: id}}})
+	}()
+	go func() {
+		defer wg.Done()
+		featuredImage = FindFeaturedImageBelongedToPostFieldFunc(f).(func(context.Context, blog.Post) storage.File)(ctx, blog.Post{FeaturedImage: mongo.DBRef{ID: featuredImageID}})
+	}()
+	wg.Wait()
 
 	// Then
 	assert.Nil(t, err)
-	assert.Equal(t, []storage.File{{Path: filepath.Join("authorizedID", "test.png"), FileName: "test.png", Slug: "test-" + id.Hex() + ".png"}}, files)
+	assert.Equal(t, []storage.File{{ID: id, FileName: "test.png"}}, files)
+	assert.Equal(t, storage.File{ID: featuredImageID, FileName: "featured.png"}, featuredImage)
 }
 
 func TestGetURLNodeShareCountFieldFunc(t *testing.T) {
@@ -975,34 +1818,332 @@ func TestGetURLNodeShareCountFieldFunc(t *testing.T) {
 	f.Do()
 
 	c := facebook.NewClient("", transport)
+	ctx := batch.WithBatching(context.Background())
 
-	t.Run("With successful getting URLNode", func(t *testing.T) {
+	t.Run("With successful getting URLNodes", func(t *testing.T) {
 		// Given
 		id := primitive.NewObjectID()
+		anotherID := primitive.NewObjectID()
+		urlNodeID := "http://localhost/2020/4/6/test-" + id.Hex()
+		anotherURLNodeID := "http://localhost/2020/4/6/another-" + anotherID.Hex()
 
 		transport.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			assert.ElementsMatch(t, []string{urlNodeID, anotherURLNodeID}, strings.Split(r.URL.Query().Get("ids"), ","))
+
 			return &http.Response{
-				Body: ioutil.NopCloser(bytes.NewBufferString(`{"engagement":{"share_count":1}}`)),
+				Body: ioutil.NopCloser(bytes.NewBufferString(`{"` + urlNodeID + `":{"engagement":{"comment_count":2,"comment_plugin_count":3,"share_count":1}},"` + anotherURLNodeID + `":{"engagement":{"share_count":4}}}`)),
 			}, nil
 		})
 
 		// When
-		engagement := GetURLNodeShareCountFieldFunc("http://localhost", c).(func(context.Context, blog.Post) (engagement blog.Engagement))(context.Background(), blog.Post{Slug: "test-" + id.Hex(), PublishedAt: now})
+		var (
+			wg                          sync.WaitGroup
+			engagement, otherEngagement blog.Engagement
+		)
+		f := NewURLNodeBatchFunc(c)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			engagement = GetURLNodeShareCountFieldFunc("http://localhost", f).(func(context.Context, blog.Post) (engagement blog.Engagement))(ctx, blog.Post{Slug: "test-" + id.Hex(), PublishedAt: now})
+		}()
+		go func() {
+			defer wg.Done()
+			otherEngagement = GetURLNodeShareCountFieldFunc("http://localhost", f).(func(context.Context, blog.Post) (engagement blog.Engagement))(ctx, blog.Post{Slug: "another-" + anotherID.Hex(), PublishedAt: now})
+		}()
+		wg.Wait()
 
 		// Then
-		assert.Equal(t, blog.Engagement{ShareCount: 1}, engagement)
+		assert.Equal(t, blog.Engagement{ShareCount: 1, CommentCount: 5}, engagement)
+		assert.Equal(t, blog.Engagement{ShareCount: 4}, otherEngagement)
 	})
 
-	t.Run("When unable to getting URLNode", func(t *testing.T) {
+	t.Run("When unable to getting URLNodes", func(t *testing.T) {
 		// Given
 		id := primitive.NewObjectID()
 
-		transport.EXPECT().RoundTrip(gomock.Any()).Return(nil, errors.New("test unable to getting URLNode"))
+		transport.EXPECT().RoundTrip(gomock.Any()).Return(nil, errors.New("test unable to getting URLNodes"))
 
 		// When
-		engagement := GetURLNodeShareCountFieldFunc("http://localhost", c).(func(context.Context, blog.Post) (engagement blog.Engagement))(context.Background(), blog.Post{Slug: "test-" + id.Hex(), PublishedAt: now})
+		engagement := GetURLNodeShareCountFieldFunc("http://localhost", NewURLNodeBatchFunc(c)).(func(context.Context, blog.Post) (engagement blog.Engagement))(ctx, blog.Post{Slug: "test-" + id.Hex(), PublishedAt: now})
 
 		// Then
 		assert.Equal(t, blog.Engagement{}, engagement)
 	})
 }
+
+func TestFindAllPopularPostsFieldFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository     = mock_analytics.NewMockViewRepository(ctrl)
+		postRepository = mock_blog.NewMockPostRepository(ctrl)
+	)
+
+	now := time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC)
+	f := faketime.NewFaketimeWithTime(now)
+	defer f.Undo()
+	f.Do()
+
+	type args struct {
+		Period analytics.Period
+		Limit  int64
+	}
+
+	t.Run("With successful finding all popular posts", func(t *testing.T) {
+		// Given
+		firstID, secondID, deletedID := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+		ids := []primitive.ObjectID{firstID, secondID, deletedID}
+
+		repository.EXPECT().FindAllPopular(gomock.Any(), analytics.PeriodWeek.Since(now), int64(3)).Return([]analytics.View{
+			{PostID: firstID, Count: 3},
+			{PostID: secondID, Count: 2},
+			{PostID: deletedID, Count: 1},
+		}, nil)
+		postRepository.EXPECT().FindAll(gomock.Any(), blog.NewPostQueryBuilder().WithIDs(ids).WithStatus(blog.StatusPublished).WithLimit(3).Build()).
+			Return([]blog.Post{{ID: secondID, Status: blog.StatusPublished}, {ID: firstID, Status: blog.StatusPublished}}, nil)
+
+		// When
+		posts, err := FindAllPopularPostsFieldFunc(repository, postRepository).(func(context.Context, struct {
+			Period analytics.Period
+			Limit  int64
+		}) ([]blog.Post, error))(context.Background(), args{Period: analytics.PeriodWeek, Limit: 3})
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.Post{{ID: firstID, Status: blog.StatusPublished}, {ID: secondID, Status: blog.StatusPublished}}, posts)
+	})
+
+	t.Run("With no page views", func(t *testing.T) {
+		// Given
+		repository.EXPECT().FindAllPopular(gomock.Any(), analytics.PeriodDay.Since(now), int64(3)).Return(nil, nil)
+
+		// When
+		posts, err := FindAllPopularPostsFieldFunc(repository, postRepository).(func(context.Context, struct {
+			Period analytics.Period
+			Limit  int64
+		}) ([]blog.Post, error))(context.Background(), args{Period: analytics.PeriodDay, Limit: 3})
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, []blog.Post{}, posts)
+	})
+
+	t.Run("With invalid limit", func(t *testing.T) {
+		for _, limit := range []int64{0, -1, blog.MaxPostLimit + 1} {
+			// Given
+
+			// When
+			_, err := FindAllPopularPostsFieldFunc(repository, postRepository).(func(context.Context, struct {
+				Period analytics.Period
+				Limit  int64
+			}) ([]blog.Post, error))(context.Background(), args{Period: analytics.PeriodWeek, Limit: limit})
+
+			// Then
+			assert.Equal(t, NewValidationError(map[string]string{"limit": "must be an integer between 1 and 100"}), err)
+		}
+	})
+
+	t.Run("With unknown period", func(t *testing.T) {
+		// Given
+
+		// When
+		_, err := FindAllPopularPostsFieldFunc(repository, postRepository).(func(context.Context, struct {
+			Period analytics.Period
+			Limit  int64
+		}) ([]blog.Post, error))(context.Background(), args{Period: "DECADE", Limit: 3})
+
+		// Then
+		assert.Equal(t, NewValidationError(map[string]string{"period": `"DECADE" is not a valid period`}), err)
+	})
+
+	t.Run("When unable to find the popular posts", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+
+		repository.EXPECT().FindAllPopular(gomock.Any(), analytics.PeriodWeek.Since(now), int64(3)).Return([]analytics.View{{PostID: id, Count: 1}}, nil)
+		postRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, errors.New("test unable to find all posts"))
+
+		// When
+		_, err := FindAllPopularPostsFieldFunc(repository, postRepository).(func(context.Context, struct {
+			Period analytics.Period
+			Limit  int64
+		}) ([]blog.Post, error))(context.Background(), args{Period: analytics.PeriodWeek, Limit: 3})
+
+		// Then
+		assert.EqualError(t, err, "test unable to find all posts")
+	})
+
+	t.Run("When unable to find all popular posts", func(t *testing.T) {
+		// Given
+		repository.EXPECT().FindAllPopular(gomock.Any(), time.Time{}, int64(3)).Return(nil, errors.New("test unable to find all popular posts"))
+
+		// When
+		_, err := FindAllPopularPostsFieldFunc(repository, postRepository).(func(context.Context, struct {
+			Period analytics.Period
+			Limit  int64
+		}) ([]blog.Post, error))(context.Background(), args{Period: analytics.PeriodAll, Limit: 3})
+
+		// Then
+		assert.EqualError(t, err, "test unable to find all popular posts")
+	})
+}
+
+func TestGetPostViewCountFieldFunc(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository = mock_analytics.NewMockViewRepository(ctrl)
+	)
+
+	id := primitive.NewObjectID()
+	unviewedID := primitive.NewObjectID()
+	f := NewViewCountBatchFunc(repository)
+	ctx := batch.WithBatching(context.Background())
+
+	repository.EXPECT().CountAllByPostIDs(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ids interface{}) (map[primitive.ObjectID]int64, error) {
+		assert.ElementsMatch(t, []primitive.ObjectID{id, unviewedID}, ids)
+		return map[primitive.ObjectID]int64{id: 42}, nil
+	})
+
+	// When
+	var (
+		wg                  sync.WaitGroup
+		viewCount, unviewed int64
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		viewCount, _ = GetPostViewCountFieldFunc(f).(func(context.Context, blog.Post) (int64, error))(ctx, blog.Post{ID: id})
+	}()
+	go func() {
+		defer wg.Done()
+		unviewed, _ = GetPostViewCountFieldFunc(f).(func(context.Context, blog.Post) (int64, error))(ctx, blog.Post{ID: unviewedID})
+	}()
+	wg.Wait()
+
+	// Then
+	assert.Equal(t, int64(42), viewCount)
+	assert.Equal(t, int64(0), unviewed)
+}
+
+func TestCreateWebhookFieldFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository = mock_webhook.NewMockWebhookRepository(ctrl)
+		resolver   = mock_webhook.NewMockResolver(ctrl)
+	)
+
+	type args struct {
+		URL    string `graphql:"url"`
+		Events []webhook.Event
+		Secret string
+	}
+	createWebhook := CreateWebhookFieldFunc(repository, resolver).(func(context.Context, struct {
+		URL    string `graphql:"url"`
+		Events []webhook.Event
+		Secret string
+	}) (webhook.Webhook, error))
+
+	t.Run("With successful creating a new webhook", func(t *testing.T) {
+		// Given
+		wh := webhook.Webhook{URL: "https://example.com/hook", Events: []webhook.Event{webhook.EventPostPublished}, Secret: "secret"}
+		resolver.EXPECT().LookupIPAddr(gomock.Any(), "example.com").Return([]net.IPAddr{{IP: net.ParseIP("93.184.216.34")}}, nil)
+		repository.EXPECT().Create(gomock.Any(), wh).Return(wh, nil)
+
+		// When
+		result, err := createWebhook(context.Background(), args{URL: wh.URL, Events: wh.Events, Secret: wh.Secret})
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, wh, result)
+	})
+
+	tests := map[string]args{
+		"When the URL is invalid":   {URL: "ftp://example.com", Events: []webhook.Event{webhook.EventPostPublished}, Secret: "secret"},
+		"When the URL is internal":  {URL: "http://127.0.0.1:8080/hook", Events: []webhook.Event{webhook.EventPostPublished}, Secret: "secret"},
+		"When the event is unknown": {URL: "https://203.0.113.10/hook", Events: []webhook.Event{"post.deleted"}, Secret: "secret"},
+		"When the secret is empty":  {URL: "https://203.0.113.10/hook", Events: []webhook.Event{webhook.EventPostPublished}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Given
+
+			// When
+			_, err := createWebhook(context.Background(), test)
+
+			// Then
+			assert.EqualError(t, err, "Bad Request")
+		})
+	}
+}
+
+func TestDeleteWebhookFieldFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository = mock_webhook.NewMockWebhookRepository(ctrl)
+	)
+
+	deleteWebhook := DeleteWebhookFieldFunc(repository).(func(context.Context, struct {
+		ID string `graphql:"id"`
+	}) (bool, error))
+
+	t.Run("With successful deleting the webhook", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+		repository.EXPECT().Delete(gomock.Any(), id).Return(nil)
+
+		// When
+		ok, err := deleteWebhook(context.Background(), struct {
+			ID string `graphql:"id"`
+		}{ID: id.Hex()})
+
+		// Then
+		assert.Nil(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("When the ID is invalid", func(t *testing.T) {
+		// Given
+
+		// When
+		_, err := deleteWebhook(context.Background(), struct {
+			ID string `graphql:"id"`
+		}{ID: "invalid"})
+
+		// Then
+		assert.EqualError(t, err, "Bad Request")
+	})
+}
+
+func TestFindAllWebhookDeliveriesFieldFunc(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository = mock_webhook.NewMockDeliveryRepository(ctrl)
+	)
+
+	webhookID := primitive.NewObjectID()
+	repository.EXPECT().FindAll(gomock.Any(), webhookID, int64(0), int64(10)).Return([]webhook.Delivery{{WebhookID: webhookID}}, nil)
+
+	// When
+	deliveries, err := FindAllWebhookDeliveriesFieldFunc(repository).(func(context.Context, struct {
+		WebhookID     string `graphql:"webhookId,optional"`
+		Offset, Limit int64
+	}) ([]webhook.Delivery, error))(context.Background(), struct {
+		WebhookID     string `graphql:"webhookId,optional"`
+		Offset, Limit int64
+	}{WebhookID: webhookID.Hex(), Limit: 10})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []webhook.Delivery{{WebhookID: webhookID}}, deliveries)
+}
@@ -1,8 +1,8 @@
 package graphql
 
 import (
+	"github.com/nomkhonwaan/myblog/pkg/blog"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"strings"
 )
 
 // Slug is a valid URL string composes with title and ID
@@ -10,14 +10,13 @@ type Slug string
 
 // GetID returns an ID from the slug string
 func (s Slug) GetID() (interface{}, error) {
-	sl := strings.Split(string(s), "-")
-	return primitive.ObjectIDFromHex(sl[len(sl)-1])
+	return blog.GetIDFromSlug(string(s))
 }
 
-// MustGetID always return ID from the slug string
+// MustGetID always return ID from the slug string, a malformed slug returns the zero ID which matches nothing
 func (s Slug) MustGetID() interface{} {
 	if id, err := s.GetID(); err == nil {
 		return id
 	}
-	return primitive.NewObjectID()
+	return primitive.NilObjectID
 }
@@ -40,7 +40,6 @@ func TestSlug_MustGetID(t *testing.T) {
 		result := slug.MustGetID()
 
 		// Then
-		assert.IsType(t, primitive.ObjectID{}, result)
-		assert.NotEmpty(t, result.(primitive.ObjectID).Hex())
+		assert.Equal(t, primitive.NilObjectID, result)
 	})
 }
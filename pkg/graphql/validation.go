@@ -0,0 +1,206 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxPostTitleLength is the maximum number of characters of the post title
+	MaxPostTitleLength = 200
+
+	// MaxPostMarkdownLength is the maximum number of characters of the post content
+	MaxPostMarkdownLength = 100000
+)
+
+// PostValidator validates arguments of the post mutations,
+// all referenced categories, tags and files must exist and files must belong to the author
+type PostValidator struct {
+	categoryRepository blog.CategoryRepository
+	tagRepository      blog.TagRepository
+	fileRepository     storage.FileRepository
+}
+
+// NewPostValidator returns a new post validator
+func NewPostValidator(categoryRepository blog.CategoryRepository, tagRepository blog.TagRepository, fileRepository storage.FileRepository) PostValidator {
+	return PostValidator{
+		categoryRepository: categoryRepository,
+		tagRepository:      tagRepository,
+		fileRepository:     fileRepository,
+	}
+}
+
+// ValidateTitle returns a validation error if the title is blank or too long
+func (v PostValidator) ValidateTitle(title string) error {
+	title = strings.TrimSpace(title)
+	switch {
+	case title == "":
+		return NewValidationError(map[string]string{"title": "must not be blank"})
+	case utf8.RuneCountInString(title) > MaxPostTitleLength:
+		return NewValidationError(map[string]string{"title": fmt.Sprintf("must not exceed %d characters", MaxPostTitleLength)})
+	}
+	return nil
+}
+
+// ValidateStatus returns a validation error if the status is neither published nor draft
+func (v PostValidator) ValidateStatus(status blog.Status) error {
+	if !status.IsPublished() && !status.IsDraft() {
+		return NewValidationError(map[string]string{"status": fmt.Sprintf("must be either %s or %s", blog.StatusPublished, blog.StatusDraft)})
+	}
+	return nil
+}
+
+// ValidateMarkdown returns a validation error if the content is too long
+func (v PostValidator) ValidateMarkdown(markdown string) error {
+	if utf8.RuneCountInString(markdown) > MaxPostMarkdownLength {
+		return NewValidationError(map[string]string{"markdown": fmt.Sprintf("must not exceed %d characters", MaxPostMarkdownLength)})
+	}
+	return nil
+}
+
+// ValidateCategories returns all categories of the slugs in the same order
+func (v PostValidator) ValidateCategories(ctx context.Context, slugs []Slug) ([]blog.Category, error) {
+	const field = "categorySlugs"
+
+	ss := make([]idSlug, len(slugs))
+	for i, slug := range slugs {
+		ss[i] = slug
+	}
+
+	ids, err := getSlugIDs(field, ss)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	found, err := v.categoryRepository.FindAllByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	cats := make(map[primitive.ObjectID]blog.Category, len(found))
+	for _, c := range found {
+		cats[c.ID] = c
+	}
+
+	result := make([]blog.Category, 0, len(ids))
+	for i, id := range ids {
+		c, ok := cats[id]
+		if !ok {
+			return nil, NewValidationError(map[string]string{field: fmt.Sprintf("%q does not exist", slugs[i])})
+		}
+		result = append(result, c)
+	}
+	return result, nil
+}
+
+// ValidateTags returns all tags of the slugs in the same order
+func (v PostValidator) ValidateTags(ctx context.Context, slugs []Slug) ([]blog.Tag, error) {
+	const field = "tagSlugs"
+
+	ss := make([]idSlug, len(slugs))
+	for i, slug := range slugs {
+		ss[i] = slug
+	}
+
+	ids, err := getSlugIDs(field, ss)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	found, err := v.tagRepository.FindAllByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[primitive.ObjectID]blog.Tag, len(found))
+	for _, t := range found {
+		tags[t.ID] = t
+	}
+
+	result := make([]blog.Tag, 0, len(ids))
+	for i, id := range ids {
+		t, ok := tags[id]
+		if !ok {
+			return nil, NewValidationError(map[string]string{field: fmt.Sprintf("%q does not exist", slugs[i])})
+		}
+		result = append(result, t)
+	}
+	return result, nil
+}
+
+// ValidateFeaturedImage returns an image file of the slug which belongs to the author
+func (v PostValidator) ValidateFeaturedImage(ctx context.Context, slug storage.Slug, authorID string) (storage.File, error) {
+	const field = "featuredImageSlug"
+
+	files, err := v.validateFiles(ctx, field, []storage.Slug{slug}, authorID)
+	if err != nil {
+		return storage.File{}, err
+	}
+	if !files[0].IsImage() {
+		return storage.File{}, NewValidationError(map[string]string{field: fmt.Sprintf("%q is not an image", slug)})
+	}
+	return files[0], nil
+}
+
+// ValidateAttachments returns all files of the slugs in the same order which belong to the author
+func (v PostValidator) ValidateAttachments(ctx context.Context, slugs []storage.Slug, authorID string) ([]storage.File, error) {
+	return v.validateFiles(ctx, "attachmentSlugs", slugs, authorID)
+}
+
+func (v PostValidator) validateFiles(ctx context.Context, field string, slugs []storage.Slug, authorID string) ([]storage.File, error) {
+	ss := make([]idSlug, len(slugs))
+	for i, slug := range slugs {
+		ss[i] = slug
+	}
+
+	ids, err := getSlugIDs(field, ss)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	found, err := v.fileRepository.FindAllByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[primitive.ObjectID]storage.File, len(found))
+	for _, file := range found {
+		files[file.ID] = file
+	}
+
+	result := make([]storage.File, 0, len(ids))
+	for i, id := range ids {
+		file, ok := files[id]
+		if !ok {
+			return nil, NewValidationError(map[string]string{field: fmt.Sprintf("%q does not exist", slugs[i])})
+		}
+		if !file.IsOwnedBy(authorID) {
+			return nil, NewValidationError(map[string]string{field: fmt.Sprintf("%q does not belong to the author", slugs[i])})
+		}
+		result = append(result, file)
+	}
+	return result, nil
+}
+
+// idSlug is a slug of either the post, category, tag or file which ends with an ID
+type idSlug interface {
+	GetID() (interface{}, error)
+}
+
+// getSlugIDs parses IDs of all slugs, a malformed or duplicated slug is a validation error of the field
+func getSlugIDs(field string, slugs []idSlug) ([]primitive.ObjectID, error) {
+	ids := make([]primitive.ObjectID, 0, len(slugs))
+	for _, slug := range slugs {
+		id, err := slug.GetID()
+		if err != nil {
+			return nil, NewValidationError(map[string]string{field: fmt.Sprintf("%q is not a valid slug", slug)})
+		}
+		if containsObjectID(ids, id.(primitive.ObjectID)) {
+			return nil, NewValidationError(map[string]string{field: fmt.Sprintf("%q is duplicated", slug)})
+		}
+		ids = append(ids, id.(primitive.ObjectID))
+	}
+	return ids, nil
+}
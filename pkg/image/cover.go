@@ -0,0 +1,252 @@
+//go:generate mockgen -destination=./mock/cover_mock.go github.com/nomkhonwaan/myblog/pkg/image CoverDrawer
+
+package image
+
+import (
+	"bytes"
+	"errors"
+	"github.com/disintegration/imaging"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+	"image"
+	"image/color"
+	"image/draw"
+	"io"
+	"strings"
+	"unicode"
+)
+
+const (
+	// CoverWidth is a width of the cover image which is recommended by the opengraph and Twitter Card
+	CoverWidth = 1200
+
+	// CoverHeight is a height of the cover image which is recommended by the opengraph and Twitter Card
+	CoverHeight = 630
+
+	coverPadding       = 80
+	coverTitleSize     = 64
+	coverTextSize      = 32
+	coverTitleMaxLines = 3
+)
+
+var (
+	coverBackgroundColor = color.RGBA{R: 33, G: 37, B: 41, A: 255}
+	coverOverlayColor    = color.RGBA{A: 160}
+	coverAccentColor     = color.RGBA{R: 255, G: 111, B: 97, A: 255}
+	coverTitleColor      = color.White
+	coverTextColor       = color.RGBA{R: 206, G: 212, B: 218, A: 255}
+
+	errNoFonts = errors.New("no fonts to draw the cover")
+)
+
+// Cover is a content which will be drawn on the cover image
+type Cover struct {
+	Title      string
+	AuthorName string
+	SiteName   string
+}
+
+// CoverDrawer uses to draw the cover image which represents a post on the social networks
+type CoverDrawer interface {
+	Draw(c Cover) (io.Reader, error)
+}
+
+// TemplateCoverDrawer draws the cover content on top of the template image
+type TemplateCoverDrawer struct {
+	// A background image which will be cropped to the cover size, a plain background is used if this is nil
+	Template image.Image
+
+	// List of fonts ordered by their priority, each character is drawn with the first font which contains its glyph
+	Fonts []*opentype.Font
+}
+
+// NewTemplateCoverDrawer returns a new TemplateCoverDrawer instance with the given template image and font files
+func NewTemplateCoverDrawer(template image.Image, fonts ...[]byte) (TemplateCoverDrawer, error) {
+	d := TemplateCoverDrawer{Template: template}
+	for _, data := range fonts {
+		f, err := opentype.Parse(data)
+		if err != nil {
+			return TemplateCoverDrawer{}, err
+		}
+		d.Fonts = append(d.Fonts, f)
+	}
+	return d, nil
+}
+
+// Draw returns the cover image in PNG format
+func (d TemplateCoverDrawer) Draw(c Cover) (io.Reader, error) {
+	// The font faces are not safe for concurrent use, create new ones on every drawing
+	titleFace, err := d.newFace(coverTitleSize)
+	if err != nil {
+		return nil, err
+	}
+	defer titleFace.Close()
+	textFace, err := d.newFace(coverTextSize)
+	if err != nil {
+		return nil, err
+	}
+	defer textFace.Close()
+
+	img := image.NewRGBA(image.Rect(0, 0, CoverWidth, CoverHeight))
+	if d.Template != nil {
+		draw.Draw(img, img.Bounds(), imaging.Fill(d.Template, CoverWidth, CoverHeight, imaging.Center, imaging.Lanczos), image.Point{}, draw.Src)
+		draw.Draw(img, img.Bounds(), image.NewUniform(coverOverlayColor), image.Point{}, draw.Over)
+	} else {
+		draw.Draw(img, img.Bounds(), image.NewUniform(coverBackgroundColor), image.Point{}, draw.Src)
+	}
+	draw.Draw(img, image.Rect(0, 0, CoverWidth, 12), image.NewUniform(coverAccentColor), image.Point{}, draw.Src)
+
+	drawText(img, textFace, coverAccentColor, c.SiteName, coverPadding+coverTextSize)
+
+	lineHeight := coverTitleSize * 3 / 2
+	lines := wrapText(titleFace, c.Title, fixed.I(CoverWidth-coverPadding*2), coverTitleMaxLines)
+	// Vertically center the title between the site name and the author name
+	y := (CoverHeight-len(lines)*lineHeight)/2 + coverTitleSize
+	for _, line := range lines {
+		drawText(img, titleFace, coverTitleColor, line, y)
+		y += lineHeight
+	}
+
+	drawText(img, textFace, coverTextColor, c.AuthorName, CoverHeight-coverPadding)
+
+	var buf bytes.Buffer
+	err = imaging.Encode(&buf, img, imaging.PNG)
+	return &buf, err
+}
+
+func (d TemplateCoverDrawer) newFace(size float64) (font.Face, error) {
+	if len(d.Fonts) == 0 {
+		return nil, errNoFonts
+	}
+
+	f := fallbackFace{fonts: d.Fonts}
+	for _, ft := range d.Fonts {
+		face, err := opentype.NewFace(ft, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+		if err != nil {
+			return nil, err
+		}
+		f.faces = append(f.faces, face)
+	}
+	return &f, nil
+}
+
+// drawText draws a single line of the text at the left padding with the given baseline
+func drawText(dst draw.Image, face font.Face, c color.Color, text string, baseline int) {
+	(&font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(coverPadding, baseline),
+	}).DrawString(text)
+}
+
+// wrapText breaks the text into lines which fit the maximum width, the word which is wider than a line is broken
+// between its characters since some languages (e.g. Thai) do not separate words with spaces.
+// The last line is ended with an ellipsis if the text has more lines than the maximum.
+func wrapText(face font.Face, text string, maxWidth fixed.Int26_6, maxLines int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		if line != "" {
+			if font.MeasureString(face, line+" "+word) <= maxWidth {
+				line += " " + word
+				continue
+			}
+			lines = append(lines, line)
+			line = ""
+		}
+
+		for _, cluster := range splitClusters(word) {
+			if line != "" && font.MeasureString(face, line+cluster) > maxWidth {
+				lines = append(lines, line)
+				line = ""
+			}
+			line += cluster
+		}
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+
+	if len(lines) > maxLines {
+		lines = lines[:maxLines]
+		last := []rune(lines[maxLines-1])
+		for len(last) > 0 && font.MeasureString(face, string(last)+"…") > maxWidth {
+			last = last[:len(last)-1]
+		}
+		lines[maxLines-1] = strings.TrimRightFunc(string(last), unicode.IsSpace) + "…"
+	}
+	return lines
+}
+
+// splitClusters splits the word into groups of characters which must not be separated by a line break,
+// i.e. a character with its combining marks and a Thai leading vowel with its following consonant
+func splitClusters(word string) []string {
+	var clusters []string
+	for _, r := range word {
+		n := len(clusters)
+		if n > 0 && (unicode.Is(unicode.Mn, r) || isThaiLeadingVowel(lastRune(clusters[n-1]))) {
+			clusters[n-1] += string(r)
+			continue
+		}
+		clusters = append(clusters, string(r))
+	}
+	return clusters
+}
+
+func isThaiLeadingVowel(r rune) bool {
+	return r >= 'เ' && r <= 'ไ'
+}
+
+func lastRune(s string) rune {
+	r := []rune(s)
+	return r[len(r)-1]
+}
+
+// fallbackFace draws each character with the first face which contains its glyph
+type fallbackFace struct {
+	fonts []*opentype.Font
+	faces []font.Face
+	buf   sfnt.Buffer
+}
+
+func (f *fallbackFace) face(r rune) font.Face {
+	for i, ft := range f.fonts {
+		if x, err := ft.GlyphIndex(&f.buf, r); err == nil && x != 0 {
+			return f.faces[i]
+		}
+	}
+	return f.faces[0]
+}
+
+func (f *fallbackFace) Close() error {
+	for _, face := range f.faces {
+		_ = face.Close()
+	}
+	return nil
+}
+
+func (f *fallbackFace) Glyph(dot fixed.Point26_6, r rune) (image.Rectangle, image.Image, image.Point, fixed.Int26_6, bool) {
+	return f.face(r).Glyph(dot, r)
+}
+
+func (f *fallbackFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	return f.face(r).GlyphBounds(r)
+}
+
+func (f *fallbackFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) {
+	return f.face(r).GlyphAdvance(r)
+}
+
+func (f *fallbackFace) Kern(r0, r1 rune) fixed.Int26_6 {
+	if face := f.face(r0); face == f.face(r1) {
+		return face.Kern(r0, r1)
+	}
+	return 0
+}
+
+func (f *fallbackFace) Metrics() font.Metrics {
+	return f.faces[0].Metrics()
+}
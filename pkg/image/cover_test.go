@@ -0,0 +1,72 @@
+package image_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"github.com/nomkhonwaan/myblog/pkg/data"
+	. "github.com/nomkhonwaan/myblog/pkg/image"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"testing"
+)
+
+func TestTemplateCoverDrawer_Draw(t *testing.T) {
+	r, _ := gzip.NewReader(bytes.NewReader(data.MustGzipAsset("data/fonts/NotoSansThai-Regular.ttf")))
+	thai, _ := ioutil.ReadAll(r)
+
+	cover := Cover{
+		Title:      "ทดสอบการสร้างภาพหน้าปกของบทความที่มีชื่อยาวมากจนต้องขึ้นบรรทัดใหม่หลายบรรทัด with English words",
+		AuthorName: "Natcha Luangaroonchai",
+		SiteName:   "Nomkhonwaan",
+	}
+
+	t.Run("With successful drawing a cover on the template", func(t *testing.T) {
+		// Given
+		drawer, _ := NewTemplateCoverDrawer(image.NewRGBA(image.Rect(0, 0, 1920, 1080)), goregular.TTF, thai)
+
+		// When
+		result, err := drawer.Draw(cover)
+		img, _ := png.Decode(result)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, image.Rect(0, 0, CoverWidth, CoverHeight), img.Bounds())
+	})
+
+	t.Run("With successful drawing a cover on the plain background", func(t *testing.T) {
+		// Given
+		drawer, _ := NewTemplateCoverDrawer(nil, goregular.TTF, thai)
+
+		// When
+		result, err := drawer.Draw(cover)
+		img, _ := png.Decode(result)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, image.Rect(0, 0, CoverWidth, CoverHeight), img.Bounds())
+	})
+
+	t.Run("Without fonts", func(t *testing.T) {
+		// Given
+		drawer, _ := NewTemplateCoverDrawer(nil)
+
+		// When
+		_, err := drawer.Draw(cover)
+
+		// Then
+		assert.EqualError(t, err, "no fonts to draw the cover")
+	})
+}
+
+func TestNewTemplateCoverDrawer(t *testing.T) {
+	// Given
+
+	// When
+	_, err := NewTemplateCoverDrawer(nil, []byte("invalid font content"))
+
+	// Then
+	assert.NotNil(t, err)
+}
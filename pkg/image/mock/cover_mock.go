@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/nomkhonwaan/myblog/pkg/image (interfaces: CoverDrawer)
+
+// Package mock_image is a generated GoMock package.
+package mock_image
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	image "github.com/nomkhonwaan/myblog/pkg/image"
+	io "io"
+	reflect "reflect"
+)
+
+// MockCoverDrawer is a mock of CoverDrawer interface
+type MockCoverDrawer struct {
+	ctrl     *gomock.Controller
+	recorder *MockCoverDrawerMockRecorder
+}
+
+// MockCoverDrawerMockRecorder is the mock recorder for MockCoverDrawer
+type MockCoverDrawerMockRecorder struct {
+	mock *MockCoverDrawer
+}
+
+// NewMockCoverDrawer creates a new mock instance
+func NewMockCoverDrawer(ctrl *gomock.Controller) *MockCoverDrawer {
+	mock := &MockCoverDrawer{ctrl: ctrl}
+	mock.recorder = &MockCoverDrawerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCoverDrawer) EXPECT() *MockCoverDrawerMockRecorder {
+	return m.recorder
+}
+
+// Draw mocks base method
+func (m *MockCoverDrawer) Draw(arg0 image.Cover) (io.Reader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Draw", arg0)
+	ret0, _ := ret[0].(io.Reader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Draw indicates an expected call of Draw
+func (mr *MockCoverDrawerMockRecorder) Draw(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Draw", reflect.TypeOf((*MockCoverDrawer)(nil).Draw), arg0)
+}
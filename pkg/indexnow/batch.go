@@ -0,0 +1,88 @@
+package indexnow
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// BatchSubmitter collects the submitted URLs in memory and submits them in batches,
+// the same URL which has been submitted many times during an interval, e.g. by autosaving, is submitted only once
+type BatchSubmitter struct {
+	submitter Submitter
+	interval  time.Duration
+
+	mu   sync.Mutex
+	urls map[string]struct{}
+}
+
+// NewBatchSubmitter returns a new BatchSubmitter which submits all collected URLs on every interval,
+// the interval must be positive
+func NewBatchSubmitter(submitter Submitter, interval time.Duration) (*BatchSubmitter, error) {
+	if interval <= 0 {
+		return nil, errors.New("the batch interval must be a positive duration")
+	}
+
+	return &BatchSubmitter{
+		submitter: submitter,
+		interval:  interval,
+		urls:      make(map[string]struct{}),
+	}, nil
+}
+
+// Submit collects the URLs for the next flushing
+func (s *BatchSubmitter) Submit(_ context.Context, urls ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, u := range urls {
+		s.urls[u] = struct{}{}
+	}
+}
+
+// Flush submits all collected URLs in a single submission
+func (s *BatchSubmitter) Flush(ctx context.Context) {
+	s.mu.Lock()
+	collected := s.urls
+	s.urls = make(map[string]struct{})
+	s.mu.Unlock()
+
+	if len(collected) == 0 {
+		return
+	}
+
+	urls := make([]string, 0, len(collected))
+	for u := range collected {
+		urls = append(urls, u)
+	}
+	sort.Strings(urls)
+
+	s.submitter.Submit(ctx, urls...)
+}
+
+// Start flushes the collected URLs on every interval until the stop channel is closed,
+// the returned channel will be closed after the last flushing
+func (s *BatchSubmitter) Start(stopCh <-chan struct{}) <-chan struct{} {
+	doneCh := make(chan struct{})
+
+	go func() {
+		defer close(doneCh)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.Flush(context.Background())
+			case <-stopCh:
+				s.Flush(context.Background())
+				return
+			}
+		}
+	}()
+
+	return doneCh
+}
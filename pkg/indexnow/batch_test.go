@@ -0,0 +1,61 @@
+package indexnow_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	. "github.com/nomkhonwaan/myblog/pkg/indexnow"
+	mock_indexnow "github.com/nomkhonwaan/myblog/pkg/indexnow/mock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestBatchSubmitter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		submitter = mock_indexnow.NewMockSubmitter(ctrl)
+	)
+
+	t.Run("With successful submitting the collected URLs once", func(t *testing.T) {
+		// Given
+		s, _ := NewBatchSubmitter(submitter, time.Hour)
+		s.Submit(context.Background(), "http://localhost/2020/3/29/test")
+		s.Submit(context.Background(), "http://localhost/2020/3/29/test", "http://localhost/2020/3/29/old-test")
+		submitter.EXPECT().Submit(gomock.Any(), "http://localhost/2020/3/29/old-test", "http://localhost/2020/3/29/test")
+
+		// When
+		s.Flush(context.Background())
+		s.Flush(context.Background())
+
+		// Then
+	})
+
+	t.Run("With successful flushing the collected URLs when stopped", func(t *testing.T) {
+		// Given
+		s, _ := NewBatchSubmitter(submitter, time.Hour)
+		stopCh := make(chan struct{})
+		doneCh := s.Start(stopCh)
+		s.Submit(context.Background(), "http://localhost/2020/3/29/test")
+		submitter.EXPECT().Submit(gomock.Any(), "http://localhost/2020/3/29/test")
+
+		// When
+		close(stopCh)
+		<-doneCh
+
+		// Then
+	})
+
+	t.Run("With non-positive interval", func(t *testing.T) {
+		for _, interval := range []time.Duration{0, -time.Minute} {
+			// Given
+
+			// When
+			_, err := NewBatchSubmitter(submitter, interval)
+
+			// Then
+			assert.EqualError(t, err, "the batch interval must be a positive duration")
+		}
+	})
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/nomkhonwaan/myblog/pkg/indexnow (interfaces: Submitter)
+
+// Package mock_indexnow is a generated GoMock package.
+package mock_indexnow
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockSubmitter is a mock of Submitter interface
+type MockSubmitter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubmitterMockRecorder
+}
+
+// MockSubmitterMockRecorder is the mock recorder for MockSubmitter
+type MockSubmitterMockRecorder struct {
+	mock *MockSubmitter
+}
+
+// NewMockSubmitter creates a new mock instance
+func NewMockSubmitter(ctrl *gomock.Controller) *MockSubmitter {
+	mock := &MockSubmitter{ctrl: ctrl}
+	mock.recorder = &MockSubmitterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSubmitter) EXPECT() *MockSubmitterMockRecorder {
+	return m.recorder
+}
+
+// Submit mocks base method
+func (m *MockSubmitter) Submit(arg0 context.Context, arg1 ...string) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Submit", varargs...)
+}
+
+// Submit indicates an expected call of Submit
+func (mr *MockSubmitterMockRecorder) Submit(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockSubmitter)(nil).Submit), varargs...)
+}
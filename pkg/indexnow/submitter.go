@@ -0,0 +1,126 @@
+//go:generate mockgen -destination=./mock/submitter_mock.go github.com/nomkhonwaan/myblog/pkg/indexnow Submitter
+
+package indexnow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// DefaultEndpoint is a shared IndexNow endpoint which forwards the submitted URLs to all participating search engines
+const DefaultEndpoint = "https://api.indexnow.org/indexnow"
+
+// MaxURLsPerRequest is a maximum number of URLs which can be submitted at once
+const MaxURLsPerRequest = 10000
+
+// Payload is a JSON body which will be sent to the IndexNow endpoint
+type Payload struct {
+	Host        string   `json:"host"`
+	Key         string   `json:"key"`
+	KeyLocation string   `json:"keyLocation"`
+	URLList     []string `json:"urlList"`
+}
+
+// A Submitter interface
+type Submitter interface {
+	Submit(ctx context.Context, urls ...string)
+}
+
+// HTTPSubmitter notifies the search engines about changed URLs through the IndexNow endpoint in background
+type HTTPSubmitter struct {
+	endpoint    string
+	host        string
+	key         string
+	keyLocation string
+	client      *http.Client
+
+	wg sync.WaitGroup
+}
+
+// NewHTTPSubmitter returns a new HTTPSubmitter which proves the ownership of the base URL host with the key,
+// the key file must be served at KeyLocation
+func NewHTTPSubmitter(endpoint, baseURL, key string, transport http.RoundTripper) (*HTTPSubmitter, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HTTPSubmitter{
+		endpoint:    endpoint,
+		host:        u.Host,
+		key:         key,
+		keyLocation: KeyLocation(baseURL, key),
+		client:      &http.Client{Transport: transport, Timeout: time.Second * 30},
+	}, nil
+}
+
+// KeyLocation returns a URL of the key file which is required by the IndexNow protocol
+func KeyLocation(baseURL, key string) string {
+	return baseURL + "/" + key + ".txt"
+}
+
+// Submit sends the URLs to the IndexNow endpoint without waiting for the response
+func (s *HTTPSubmitter) Submit(_ context.Context, urls ...string) {
+	for i := 0; i < len(urls); i += MaxURLsPerRequest {
+		end := i + MaxURLsPerRequest
+		if end > len(urls) {
+			end = len(urls)
+		}
+
+		s.wg.Add(1)
+		go func(urls []string) {
+			defer s.wg.Done()
+			if err := s.submit(urls); err != nil {
+				logrus.Errorf("unable to submit %d URLs to %s: %s", len(urls), s.endpoint, err)
+			}
+		}(urls[i:end])
+	}
+}
+
+// Wait blocks until all pending submissions are finished
+func (s *HTTPSubmitter) Wait() {
+	s.wg.Wait()
+}
+
+func (s *HTTPSubmitter) submit(urls []string) error {
+	body, err := json.Marshal(Payload{Host: s.host, Key: s.key, KeyLocation: s.keyLocation, URLList: urls})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+
+	// The endpoint responds "202 Accepted" when the key validation is pending
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+	return nil
+}
+
+// ServeKeyHandlerFunc provides the key file which proves the ownership of the host
+func ServeKeyHandlerFunc(key string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte(key))
+	}
+}
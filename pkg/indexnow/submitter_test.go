@@ -0,0 +1,116 @@
+package indexnow_test
+
+import (
+	"context"
+	"encoding/json"
+	. "github.com/nomkhonwaan/myblog/pkg/indexnow"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestKeyLocation(t *testing.T) {
+	assert.Equal(t, "http://localhost/secret.txt", KeyLocation("http://localhost", "secret"))
+}
+
+func TestHTTPSubmitter_Submit(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("With successful submitting URLs", func(t *testing.T) {
+		// Given
+		var received *http.Request
+		var p Payload
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ := ioutil.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &p)
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer srv.Close()
+
+		s, err := NewHTTPSubmitter(srv.URL+"/indexnow", "https://www.nomkhonwaan.com", "secret", http.DefaultTransport)
+		assert.Nil(t, err)
+
+		// When
+		s.Submit(ctx, "https://www.nomkhonwaan.com/2020/3/29/test")
+		s.Wait()
+
+		// Then
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.Equal(t, "/indexnow", received.URL.Path)
+		assert.Equal(t, "application/json; charset=utf-8", received.Header.Get("Content-Type"))
+		assert.Equal(t, Payload{
+			Host:        "www.nomkhonwaan.com",
+			Key:         "secret",
+			KeyLocation: "https://www.nomkhonwaan.com/secret.txt",
+			URLList:     []string{"https://www.nomkhonwaan.com/2020/3/29/test"},
+		}, p)
+	})
+
+	t.Run("With more URLs than the limit of a single request", func(t *testing.T) {
+		// Given
+		var mu sync.Mutex
+		var sizes []int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var p Payload
+			_ = json.NewDecoder(r.Body).Decode(&p)
+			mu.Lock()
+			sizes = append(sizes, len(p.URLList))
+			mu.Unlock()
+		}))
+		defer srv.Close()
+
+		s, _ := NewHTTPSubmitter(srv.URL, "http://localhost", "secret", http.DefaultTransport)
+
+		// When
+		s.Submit(ctx, make([]string, MaxURLsPerRequest+1)...)
+		s.Wait()
+
+		// Then
+		assert.ElementsMatch(t, []int{MaxURLsPerRequest, 1}, sizes)
+	})
+
+	t.Run("When the endpoint rejects the submission", func(t *testing.T) {
+		// Given
+		calls := 0
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer srv.Close()
+
+		s, _ := NewHTTPSubmitter(srv.URL, "http://localhost", "invalid", http.DefaultTransport)
+
+		// When
+		s.Submit(ctx, "http://localhost/2020/3/29/test")
+		s.Wait()
+
+		// Then
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("When unable to parse the base URL", func(t *testing.T) {
+		// Given
+
+		// When
+		_, err := NewHTTPSubmitter(DefaultEndpoint, "://localhost", "secret", http.DefaultTransport)
+
+		// Then
+		assert.NotNil(t, err)
+	})
+}
+
+func TestServeKeyHandlerFunc(t *testing.T) {
+	// Given
+	w := httptest.NewRecorder()
+
+	// When
+	ServeKeyHandlerFunc("secret").ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/secret.txt", nil))
+
+	// Then
+	assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "secret", w.Body.String())
+}
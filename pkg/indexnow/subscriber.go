@@ -0,0 +1,31 @@
+package indexnow
+
+import (
+	"context"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
+)
+
+// Subscribe registers handlers on the bus which submit the post URL to the search engines
+// whenever the post has been added to, removed from or changed in the sitemap,
+// the previous URL is submitted as well if it has been changed by the new slug
+func Subscribe(bus eventbus.Bus, submitter Submitter, baseURL string) {
+	bus.Subscribe(blog.PostPublished{}, func(ctx context.Context, e eventbus.Event) {
+		submitter.Submit(ctx, baseURL+e.(blog.PostPublished).Post.Permalink())
+	})
+	bus.Subscribe(blog.PostUnpublished{}, func(ctx context.Context, e eventbus.Event) {
+		submitter.Submit(ctx, baseURL+e.(blog.PostUnpublished).Post.Permalink())
+	})
+	bus.Subscribe(blog.PostContentChanged{}, func(ctx context.Context, e eventbus.Event) {
+		evt := e.(blog.PostContentChanged)
+		if !evt.Post.Status.IsPublished() {
+			return
+		}
+
+		urls := []string{baseURL + evt.Post.Permalink()}
+		if prev := evt.Previous; prev.Status.IsPublished() && prev.Permalink() != evt.Post.Permalink() {
+			urls = append(urls, baseURL+prev.Permalink())
+		}
+		submitter.Submit(ctx, urls...)
+	})
+}
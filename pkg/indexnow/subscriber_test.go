@@ -0,0 +1,77 @@
+package indexnow_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
+	. "github.com/nomkhonwaan/myblog/pkg/indexnow"
+	mock_indexnow "github.com/nomkhonwaan/myblog/pkg/indexnow/mock"
+	"testing"
+	"time"
+)
+
+func TestSubscribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		submitter = mock_indexnow.NewMockSubmitter(ctrl)
+	)
+
+	bus := eventbus.NewLocalBus()
+	Subscribe(bus, submitter, "http://localhost")
+
+	p := blog.Post{Slug: "test", Status: blog.StatusPublished, PublishedAt: time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC)}
+
+	t.Run("With successful submitting the post URL when a post has been published", func(t *testing.T) {
+		// Given
+		submitter.EXPECT().Submit(gomock.Any(), "http://localhost/2020/3/29/test")
+
+		// When
+		bus.Publish(context.Background(), blog.PostPublished{Post: p})
+
+		// Then
+	})
+
+	t.Run("With successful submitting the post URL when a post has been unpublished", func(t *testing.T) {
+		// Given
+		submitter.EXPECT().Submit(gomock.Any(), "http://localhost/2020/3/29/test")
+
+		// When
+		bus.Publish(context.Background(), blog.PostUnpublished{Post: p})
+
+		// Then
+	})
+
+	t.Run("With successful submitting the post URL when a published post has been changed", func(t *testing.T) {
+		// Given
+		submitter.EXPECT().Submit(gomock.Any(), "http://localhost/2020/3/29/test")
+
+		// When
+		bus.Publish(context.Background(), blog.PostContentChanged{Post: p})
+
+		// Then
+	})
+
+	t.Run("With successful submitting the previous URL when the slug has been changed", func(t *testing.T) {
+		// Given
+		changed := p
+		changed.Slug = "new-test"
+		submitter.EXPECT().Submit(gomock.Any(), "http://localhost/2020/3/29/new-test", "http://localhost/2020/3/29/test")
+
+		// When
+		bus.Publish(context.Background(), blog.PostContentChanged{Post: changed, Previous: p})
+
+		// Then
+	})
+
+	t.Run("When a draft post has been changed", func(t *testing.T) {
+		// Given
+
+		// When
+		bus.Publish(context.Background(), blog.PostContentChanged{Post: blog.Post{Status: blog.StatusDraft}})
+
+		// Then
+	})
+}
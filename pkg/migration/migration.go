@@ -0,0 +1,133 @@
+package migration
+
+import (
+	"context"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"sort"
+	"time"
+)
+
+// CollectionName is a name of the collection which records all applied migrations
+const CollectionName = "schema_migrations"
+
+// CollectionFunc returns a collection from its name
+type CollectionFunc func(name string) mongo.Collection
+
+// Migration is a single versioned change of the database schema
+type Migration struct {
+	// A unique and incremental version of the migration
+	Version int
+
+	// A short description of what the migration does
+	Description string
+
+	// A function to be executed for applying the migration
+	Up func(ctx context.Context, collection CollectionFunc) error
+}
+
+// Status is a migration with its applied date-time, the migration is pending if the AppliedAt is zero
+type Status struct {
+	Migration
+
+	// Date-time that the migration was applied
+	AppliedAt time.Time
+}
+
+// IsPending returns true if the migration has not been applied yet
+func (s Status) IsPending() bool {
+	return s.AppliedAt.IsZero()
+}
+
+type record struct {
+	Version     int       `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"appliedAt"`
+}
+
+// Migrator applies migrations in the version order and records them in the schema migrations collection
+type Migrator struct {
+	col        mongo.Collection
+	collection CollectionFunc
+	migrations []Migration
+}
+
+// NewMigrator returns a new Migrator instance
+func NewMigrator(db mongo.Database, migrations []Migration) Migrator {
+	return newMigrator(func(name string) mongo.Collection {
+		return mongo.NewCollection(db.Collection(name))
+	}, migrations)
+}
+
+func newMigrator(collection CollectionFunc, migrations []Migration) Migrator {
+	sorted := append([]Migration{}, migrations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+
+	return Migrator{col: collection(CollectionName), collection: collection, migrations: sorted}
+}
+
+// Status returns all migrations with their applied date-time
+func (m Migrator) Status(ctx context.Context) ([]Status, error) {
+	cur, err := m.col.Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var records []record
+	if err = cur.Decode(&records); err != nil {
+		return nil, err
+	}
+
+	appliedAt := make(map[int]time.Time)
+	for _, r := range records {
+		appliedAt[r.Version] = r.AppliedAt
+	}
+
+	statuses := make([]Status, len(m.migrations))
+	for i, mig := range m.migrations {
+		statuses[i] = Status{Migration: mig, AppliedAt: appliedAt[mig.Version]}
+	}
+
+	return statuses, nil
+}
+
+// Pending returns all migrations which have not been applied yet
+func (m Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, s := range statuses {
+		if s.IsPending() {
+			pending = append(pending, s.Migration)
+		}
+	}
+
+	return pending, nil
+}
+
+// Up applies all pending migrations in the version order and stops at the first failure
+func (m Migrator) Up(ctx context.Context) ([]Migration, error) {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, mig := range pending {
+		if err = mig.Up(ctx, m.collection); err != nil {
+			return applied, err
+		}
+
+		_, err = m.col.InsertOne(ctx, record{Version: mig.Version, Description: mig.Description, AppliedAt: time.Now()})
+		if err != nil {
+			return applied, err
+		}
+		applied = append(applied, mig)
+	}
+
+	return applied, nil
+}
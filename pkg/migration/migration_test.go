@@ -0,0 +1,128 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	mock_mongo "github.com/nomkhonwaan/myblog/pkg/mongo/mock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	mgo "go.mongodb.org/mongo-driver/mongo"
+	"testing"
+	"time"
+)
+
+func TestMigrator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		col   = mock_mongo.NewMockCollection(ctrl)
+		posts = mock_mongo.NewMockCollection(ctrl)
+		cur   = mock_mongo.NewMockCursor(ctrl)
+	)
+
+	collection := func(name string) mongo.Collection {
+		if name == CollectionName {
+			return col
+		}
+		return posts
+	}
+
+	appliedAt := time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC)
+	var calls []int
+	newMigration := func(version int, err error) Migration {
+		return Migration{Version: version, Description: "test", Up: func(_ context.Context, _ CollectionFunc) error {
+			calls = append(calls, version)
+			return err
+		}}
+	}
+
+	expectApplied := func(versions ...int) {
+		col.EXPECT().Find(gomock.Any(), bson.D{}).Return(cur, nil)
+		cur.EXPECT().Decode(gomock.Any()).DoAndReturn(func(val interface{}) error {
+			for _, v := range versions {
+				*val.(*[]record) = append(*val.(*[]record), record{Version: v, AppliedAt: appliedAt})
+			}
+			return nil
+		})
+		cur.EXPECT().Close(gomock.Any()).Return(nil)
+	}
+
+	t.Run("With successful listing migration statuses", func(t *testing.T) {
+		// Given
+		expectApplied(1)
+		m := newMigrator(collection, []Migration{newMigration(2, nil), newMigration(1, nil)})
+
+		// When
+		statuses, err := m.Status(context.Background())
+
+		// Then
+		assert.Nil(t, err)
+		assert.Len(t, statuses, 2)
+		assert.Equal(t, 1, statuses[0].Version)
+		assert.Equal(t, appliedAt, statuses[0].AppliedAt)
+		assert.True(t, statuses[1].IsPending())
+	})
+
+	t.Run("With successful applying pending migrations in order", func(t *testing.T) {
+		// Given
+		calls = nil
+		expectApplied(1)
+		col.EXPECT().InsertOne(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, doc interface{}, _ ...interface{}) (*mgo.InsertOneResult, error) {
+			assert.Contains(t, []int{2, 3}, doc.(record).Version)
+			return &mgo.InsertOneResult{}, nil
+		}).Times(2)
+		m := newMigrator(collection, []Migration{newMigration(3, nil), newMigration(1, nil), newMigration(2, nil)})
+
+		// When
+		applied, err := m.Up(context.Background())
+
+		// Then
+		assert.Nil(t, err)
+		assert.Len(t, applied, 2)
+		assert.Equal(t, []int{2, 3}, calls)
+	})
+
+	t.Run("When unable to apply the migration", func(t *testing.T) {
+		// Given
+		calls = nil
+		expectApplied()
+		m := newMigrator(collection, []Migration{newMigration(1, errors.New("test unable to apply the migration")), newMigration(2, nil)})
+
+		// When
+		applied, err := m.Up(context.Background())
+
+		// Then
+		assert.EqualError(t, err, "test unable to apply the migration")
+		assert.Len(t, applied, 0)
+		assert.Equal(t, []int{1}, calls)
+	})
+
+	t.Run("When unable to find applied migrations", func(t *testing.T) {
+		// Given
+		col.EXPECT().Find(gomock.Any(), bson.D{}).Return(nil, errors.New("test unable to find applied migrations"))
+		m := newMigrator(collection, Migrations)
+
+		// When
+		_, err := m.Pending(context.Background())
+
+		// Then
+		assert.EqualError(t, err, "test unable to find applied migrations")
+	})
+
+	t.Run("With successful creating indexes", func(t *testing.T) {
+		// Given
+		posts.EXPECT().CreateIndexes(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, models []mgo.IndexModel, _ ...interface{}) ([]string, error) {
+			assert.Equal(t, bson.D{{"categories.$id", 1}, {"status", 1}, {"publishedAt", -1}}, models[0].Keys)
+			return []string{"categories.$id_1_status_1_publishedAt_-1", "tags.$id_1_status_1_publishedAt_-1"}, nil
+		})
+
+		// When
+		err := Migrations[1].Up(context.Background(), collection)
+
+		// Then
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,80 @@
+package migration
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	mgo "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Migrations is a list of all database migrations, a new migration must be appended with the next version
+var Migrations = []Migration{
+	{
+		Version:     1,
+		Description: "create indexes on posts for listing by status and author",
+		Up: createIndexes("posts",
+			bson.D{{"status", 1}, {"publishedAt", -1}},
+			bson.D{{"status", 1}, {"createdAt", -1}},
+			bson.D{{"authorId", 1}, {"status", 1}, {"createdAt", -1}},
+		),
+	},
+	{
+		Version:     2,
+		Description: "create indexes on posts for listing by category and tag",
+		Up: createIndexes("posts",
+			bson.D{{"categories.$id", 1}, {"status", 1}, {"publishedAt", -1}},
+			bson.D{{"tags.$id", 1}, {"status", 1}, {"publishedAt", -1}},
+		),
+	},
+	{
+		Version:     3,
+		Description: "create indexes on post_views for counting page views per post per day",
+		Up: all(
+			createUniqueIndexes("post_views", bson.D{{"postId", 1}, {"date", 1}}),
+			createIndexes("post_views", bson.D{{"date", -1}}),
+		),
+	},
+	{
+		Version:     4,
+		Description: "create indexes on webhooks and webhook_deliveries for dispatching and listing deliveries",
+		Up: all(
+			createIndexes("webhooks", bson.D{{"events", 1}}),
+			createIndexes("webhook_deliveries",
+				bson.D{{"createdAt", -1}},
+				bson.D{{"webhookId", 1}, {"createdAt", -1}},
+			),
+		),
+	},
+}
+
+// all returns a migration function which runs all migration functions in order
+func all(ups ...func(context.Context, CollectionFunc) error) func(context.Context, CollectionFunc) error {
+	return func(ctx context.Context, collection CollectionFunc) error {
+		for _, up := range ups {
+			if err := up(ctx, collection); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func createIndexes(name string, keys ...bson.D) func(context.Context, CollectionFunc) error {
+	return createIndexModels(name, nil, keys...)
+}
+
+func createUniqueIndexes(name string, keys ...bson.D) func(context.Context, CollectionFunc) error {
+	return createIndexModels(name, options.Index().SetUnique(true), keys...)
+}
+
+func createIndexModels(name string, opts *options.IndexOptions, keys ...bson.D) func(context.Context, CollectionFunc) error {
+	return func(ctx context.Context, collection CollectionFunc) error {
+		models := make([]mgo.IndexModel, len(keys))
+		for i, k := range keys {
+			models[i] = mgo.IndexModel{Keys: k, Options: opts}
+		}
+
+		_, err := collection(name).CreateIndexes(ctx, models)
+		return err
+	}
+}
@@ -10,6 +10,10 @@ import (
 
 // Collection is a wrapped interface to the original mongo.Collection for testing benefit
 type Collection interface {
+	Aggregate(ctx context.Context, pipeline interface{}, opts ...*options.AggregateOptions) (Cursor, error)
+	BulkWrite(ctx context.Context, models []mongo.WriteModel, opts ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error)
+	CountDocuments(ctx context.Context, filter interface{}, opts ...*options.CountOptions) (int64, error)
+	CreateIndexes(ctx context.Context, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error)
 	DeleteOne(ctx context.Context, filter interface{}, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error)
 	Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (Cursor, error)
 	FindOne(ctx context.Context, filter interface{}, opts ...*options.FindOneOptions) SingleResult
@@ -24,6 +28,21 @@ func NewCollection(col *mongo.Collection) Collection {
 	return collection{col}
 }
 
+// Aggregate executes an aggregate command and returns a Cursor over the resulting documents
+func (col collection) Aggregate(ctx context.Context, pipeline interface{}, opts ...*options.AggregateOptions) (Cursor, error) {
+	cur, err := col.Collection.Aggregate(ctx, pipeline, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return cursor{Context: ctx, Cursor: cur}, nil
+}
+
+// CreateIndexes executes a createIndexes command to create multiple indexes on the collection
+func (col collection) CreateIndexes(ctx context.Context, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return col.Collection.Indexes().CreateMany(ctx, models, opts...)
+}
+
 // Find executes a find command and returns a Cursor over the matching documents in the collection
 func (col collection) Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (Cursor, error) {
 	cur, err := col.Collection.Find(ctx, filter, opts...)
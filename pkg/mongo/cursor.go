@@ -13,6 +13,7 @@ import (
 type Cursor interface {
 	Close(context.Context) error
 	Decode(interface{}) error
+	Err() error
 	Next(context.Context) bool
 }
 
@@ -46,3 +47,8 @@ func (cur cursor) Decode(val interface{}) error {
 
 	return nil
 }
+
+// Err returns the last error of the original Cursor instead of the context error
+func (cur cursor) Err() error {
+	return cur.Cursor.Err()
+}
@@ -0,0 +1,95 @@
+package oembed
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"net/http"
+	"strconv"
+)
+
+// ServeOEmbedHandlerFunc provides an oEmbed response of the post permalink in the "url" query parameter,
+// the response in the default width and height is stored on the cache until the post has been changed
+func ServeOEmbedHandlerFunc(cache storage.Cache, provider Provider) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		format := Format(q.Get("format"))
+		if format == "" {
+			format = JSON
+		}
+		if format != JSON && format != XML {
+			http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+			return
+		}
+
+		if q.Get("url") == "" {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		id, err := provider.PostID(q.Get("url"))
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+
+		res, err := retrieveOrEmbed(r, cache, provider, id)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+
+		maxWidth, _ := strconv.Atoi(q.Get("maxwidth"))
+		maxHeight, _ := strconv.Atoi(q.Get("maxheight"))
+
+		data, err := res.Fit(maxWidth, maxHeight).Marshal(format)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", format.ContentType())
+		_, _ = w.Write(data)
+	}
+}
+
+// retrieveOrEmbed returns the cached response of the post if exists, otherwise embeds the post and stores it on the cache
+func retrieveOrEmbed(r *http.Request, cache storage.Cache, provider Provider, id primitive.ObjectID) (Response, error) {
+	filePath := cacheFilePath(id)
+
+	if cache.Exists(filePath) {
+		body, err := cache.Retrieve(filePath)
+		if err == nil {
+			defer body.Close()
+
+			var res Response
+			if err = json.NewDecoder(body).Decode(&res); err == nil {
+				return res, nil
+			}
+		}
+		logrus.Errorf("unable to retrieve %s: %s", filePath, err)
+	}
+
+	res, err := provider.Embed(r.Context(), id)
+	if err != nil {
+		return Response{}, err
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		return Response{}, err
+	}
+	if err = cache.Store(bytes.NewReader(data), filePath); err != nil {
+		logrus.Errorf("unable to store %s: %s", filePath, err)
+	}
+
+	return res, nil
+}
+
+func cacheFilePath(id primitive.ObjectID) string {
+	return fmt.Sprintf("oembed/%s.json", id.Hex())
+}
@@ -0,0 +1,137 @@
+package oembed
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	mock_blog "github.com/nomkhonwaan/myblog/pkg/blog/mock"
+	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestServeOEmbedHandlerFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		cache          = mock_storage.NewMockCache(ctrl)
+		postRepository = mock_blog.NewMockPostRepository(ctrl)
+	)
+
+	h := ServeOEmbedHandlerFunc(cache, Provider{
+		BaseURL:        "http://localhost",
+		SiteName:       "Nomkhonwaan",
+		AuthorName:     "Natcha Luangaroonchai",
+		PostRepository: postRepository,
+	})
+
+	id := primitive.NewObjectID()
+	permalink := "http://localhost/2020/3/29/test-" + id.Hex()
+	newRequest := func(query url.Values) *http.Request {
+		return httptest.NewRequest(http.MethodGet, "/oembed?"+query.Encode(), nil)
+	}
+
+	t.Run("With successful embedding a post in JSON format", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("oembed/" + id.Hex() + ".json").Return(false)
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{ID: id, Title: "Test", Status: blog.StatusPublished}, nil)
+		cache.EXPECT().Store(gomock.Any(), "oembed/"+id.Hex()+".json").Return(errors.New("test unable to store"))
+
+		// When
+		h.ServeHTTP(w, newRequest(url.Values{"url": {permalink}}))
+
+		// Then
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), `"type":"rich","version":"1.0","title":"Test"`)
+	})
+
+	t.Run("With successful retrieving a cached response in XML format with maximum width", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("oembed/" + id.Hex() + ".json").Return(true)
+		cache.EXPECT().Retrieve("oembed/"+id.Hex()+".json").
+			Return(ioutil.NopCloser(strings.NewReader(`{"type":"rich","version":"1.0","title":"Test","width":600,"height":240}`)), nil)
+
+		// When
+		h.ServeHTTP(w, newRequest(url.Values{"url": {permalink}, "format": {"xml"}, "maxwidth": {"320"}}))
+
+		// Then
+		assert.Equal(t, "text/xml; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), "<title>Test</title>")
+		assert.Contains(t, w.Body.String(), "<width>320</width><height>240</height>")
+	})
+
+	t.Run("When the cached response is broken", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("oembed/" + id.Hex() + ".json").Return(true)
+		cache.EXPECT().Retrieve("oembed/"+id.Hex()+".json").Return(ioutil.NopCloser(strings.NewReader("{")), nil)
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{ID: id, Title: "Test", Status: blog.StatusPublished}, nil)
+		cache.EXPECT().Store(gomock.Any(), "oembed/"+id.Hex()+".json").Return(nil)
+
+		// When
+		h.ServeHTTP(w, newRequest(url.Values{"url": {permalink}}))
+
+		// Then
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("When the post is not published", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("oembed/" + id.Hex() + ".json").Return(false)
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{ID: id, Status: blog.StatusDraft}, nil)
+
+		// When
+		h.ServeHTTP(w, newRequest(url.Values{"url": {permalink}}))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("With a URL which is not a post permalink", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		// When
+		h.ServeHTTP(w, newRequest(url.Values{"url": {"http://localhost/tag/go-" + id.Hex()}}))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Without URL", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		// When
+		h.ServeHTTP(w, newRequest(url.Values{}))
+
+		// Then
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("With unsupported format", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		// When
+		h.ServeHTTP(w, newRequest(url.Values{"url": {permalink}, "format": {"yaml"}}))
+
+		// Then
+		assert.Equal(t, http.StatusNotImplemented, w.Code)
+	})
+}
@@ -0,0 +1,96 @@
+package oembed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"net/url"
+)
+
+// Format is a response format of the oEmbed endpoint
+type Format string
+
+// List of available formats
+const (
+	JSON Format = "json"
+	XML  Format = "xml"
+)
+
+// Formats contains all available formats
+var Formats = []Format{JSON, XML}
+
+// EndpointPath is a URL path of the oEmbed endpoint
+const EndpointPath = "/oembed"
+
+// errUnsupportedFormat is returned when the requested format is neither JSON nor XML
+var errUnsupportedFormat = errors.New("unsupported format")
+
+// ContentType returns a MIME type of the format
+func (f Format) ContentType() string {
+	if f == XML {
+		return "text/xml; charset=utf-8"
+	}
+	return "application/json; charset=utf-8"
+}
+
+// DiscoveryType returns a type attribute of the discovery link element
+func (f Format) DiscoveryType() string {
+	if f == XML {
+		return "text/xml+oembed"
+	}
+	return "application/json+oembed"
+}
+
+// DiscoveryURL returns a URL of the oEmbed endpoint which embeds the page in the format
+func DiscoveryURL(baseURL, pageURL string, format Format) string {
+	return baseURL + EndpointPath + "?" + url.Values{"url": {pageURL}, "format": {string(format)}}.Encode()
+}
+
+// Response is an oEmbed response of the "rich" type, https://oembed.com/#section2.3
+type Response struct {
+	XMLName xml.Name `json:"-" xml:"oembed"`
+
+	Type         string `json:"type" xml:"type"`
+	Version      string `json:"version" xml:"version"`
+	Title        string `json:"title" xml:"title"`
+	AuthorName   string `json:"author_name" xml:"author_name"`
+	ProviderName string `json:"provider_name" xml:"provider_name"`
+	ProviderURL  string `json:"provider_url" xml:"provider_url"`
+
+	// All thumbnail fields are empty if the post has no featured image
+	ThumbnailURL    string `json:"thumbnail_url,omitempty" xml:"thumbnail_url,omitempty"`
+	ThumbnailWidth  int    `json:"thumbnail_width,omitempty" xml:"thumbnail_width,omitempty"`
+	ThumbnailHeight int    `json:"thumbnail_height,omitempty" xml:"thumbnail_height,omitempty"`
+
+	HTML   string `json:"html" xml:"html"`
+	Width  int    `json:"width" xml:"width"`
+	Height int    `json:"height" xml:"height"`
+}
+
+// Fit returns a response which its width and height are not larger than the maximum values,
+// zero means no limit
+func (r Response) Fit(maxWidth, maxHeight int) Response {
+	if maxWidth > 0 && r.Width > maxWidth {
+		r.Width = maxWidth
+	}
+	if maxHeight > 0 && r.Height > maxHeight {
+		r.Height = maxHeight
+	}
+	return r
+}
+
+// Marshal returns the response in the format
+func (r Response) Marshal(format Format) ([]byte, error) {
+	switch format {
+	case JSON:
+		return json.Marshal(r)
+	case XML:
+		data, err := xml.Marshal(r)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte(xml.Header), data...), nil
+	default:
+		return nil, errUnsupportedFormat
+	}
+}
@@ -0,0 +1,95 @@
+package oembed
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDiscoveryURL(t *testing.T) {
+	// Given
+
+	// When
+	result := DiscoveryURL("http://localhost", "http://localhost/2020/3/29/test-1", XML)
+
+	// Then
+	assert.Equal(t, "http://localhost/oembed?format=xml&url=http%3A%2F%2Flocalhost%2F2020%2F3%2F29%2Ftest-1", result)
+}
+
+func TestResponse_Fit(t *testing.T) {
+	res := Response{Width: 600, Height: 240}
+
+	t.Run("With smaller maximum width and height", func(t *testing.T) {
+		// Given
+
+		// When
+		result := res.Fit(300, 100)
+
+		// Then
+		assert.Equal(t, 300, result.Width)
+		assert.Equal(t, 100, result.Height)
+	})
+
+	t.Run("Without maximum width and height", func(t *testing.T) {
+		// Given
+
+		// When
+		result := res.Fit(0, 0)
+
+		// Then
+		assert.Equal(t, res, result)
+	})
+}
+
+func TestResponse_Marshal(t *testing.T) {
+	res := Response{
+		Type:         "rich",
+		Version:      "1.0",
+		Title:        "Test",
+		AuthorName:   "Natcha Luangaroonchai",
+		ProviderName: "Nomkhonwaan",
+		ProviderURL:  "http://localhost",
+		HTML:         "<blockquote></blockquote>",
+		Width:        600,
+		Height:       240,
+	}
+
+	t.Run("With JSON format", func(t *testing.T) {
+		// Given
+		expected := `{"type":"rich","version":"1.0","title":"Test","author_name":"Natcha Luangaroonchai",` +
+			`"provider_name":"Nomkhonwaan","provider_url":"http://localhost",` +
+			`"html":"\u003cblockquote\u003e\u003c/blockquote\u003e","width":600,"height":240}`
+
+		// When
+		result, err := res.Marshal(JSON)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, expected, string(result))
+	})
+
+	t.Run("With XML format", func(t *testing.T) {
+		// Given
+		expected := `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+			`<oembed><type>rich</type><version>1.0</version><title>Test</title><author_name>Natcha Luangaroonchai</author_name>` +
+			`<provider_name>Nomkhonwaan</provider_name>` +
+			`<provider_url>http://localhost</provider_url><html>&lt;blockquote&gt;&lt;/blockquote&gt;</html>` +
+			`<width>600</width><height>240</height></oembed>`
+
+		// When
+		result, err := res.Marshal(XML)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, expected, string(result))
+	})
+
+	t.Run("With unsupported format", func(t *testing.T) {
+		// Given
+
+		// When
+		_, err := res.Marshal(Format("yaml"))
+
+		// Then
+		assert.Equal(t, errUnsupportedFormat, err)
+	})
+}
@@ -0,0 +1,150 @@
+package oembed
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/graphql"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"html/template"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	singlePageRegExp = regexp.MustCompile(`^/\d{4}/\d{1,2}/\d{1,2}/([^/]+)$`)
+
+	// errNotFound is returned when the URL is not a published post of the site
+	errNotFound = errors.New("post not found")
+
+	embedTemplate = template.Must(template.New("embed").Parse(
+		`<blockquote class="myblog-embed" cite="{{.URL}}">` +
+			`<p><a href="{{.URL}}">{{.Title}}</a></p>` +
+			`{{if .Excerpt}}<p>{{.Excerpt}}</p>{{end}}` +
+			`<p>&mdash; {{.AuthorName}}, <a href="{{.ProviderURL}}">{{.ProviderName}}</a></p>` +
+			`</blockquote>`))
+)
+
+const (
+	// DefaultWidth is a width in pixels of the embed HTML before fitting to the maximum width
+	DefaultWidth = 600
+
+	// DefaultHeight is a height in pixels of the embed HTML before fitting to the maximum height
+	DefaultHeight = 240
+
+	storageURLPath = "/api/v2.1/storage/"
+)
+
+// Provider finds the published post of the permalink and renders it as a rich card
+type Provider struct {
+	// A base URL of the blog, only the URLs on the same host will be embedded
+	BaseURL string
+
+	// Name of the site which will be shown as the provider name
+	SiteName string
+
+	// Name of the author which will be shown on the card
+	AuthorName string
+
+	// A storage where the featured image is downloaded for reading its dimensions
+	Storage storage.Storage
+
+	PostRepository blog.PostRepository
+	FileRepository storage.FileRepository
+}
+
+// PostID returns an ID of the post from its permalink on the site
+func (p Provider) PostID(rawURL string) (primitive.ObjectID, error) {
+	base, err := url.Parse(p.BaseURL)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	if !strings.EqualFold(u.Host, base.Host) {
+		return primitive.NilObjectID, errNotFound
+	}
+
+	m := singlePageRegExp.FindStringSubmatch(strings.TrimPrefix(u.Path, base.Path))
+	if m == nil {
+		return primitive.NilObjectID, errNotFound
+	}
+
+	id, err := graphql.Slug(m[1]).GetID()
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	return id.(primitive.ObjectID), nil
+}
+
+// Embed returns an oEmbed response of the published post in the default width and height
+func (p Provider) Embed(ctx context.Context, id primitive.ObjectID) (Response, error) {
+	post, err := p.PostRepository.FindByID(ctx, id)
+	if err != nil {
+		return Response{}, err
+	}
+	if !post.Status.IsPublished() {
+		return Response{}, errNotFound
+	}
+
+	res := Response{
+		Type:         "rich",
+		Version:      "1.0",
+		Title:        post.Title,
+		AuthorName:   p.AuthorName,
+		ProviderName: p.SiteName,
+		ProviderURL:  p.BaseURL,
+		Width:        DefaultWidth,
+		Height:       DefaultHeight,
+	}
+
+	if !post.FeaturedImage.ID.IsZero() {
+		res.ThumbnailURL, res.ThumbnailWidth, res.ThumbnailHeight = p.thumbnail(ctx, post.FeaturedImage.ID)
+	}
+
+	buf := bytes.Buffer{}
+	err = embedTemplate.Execute(&buf, map[string]string{
+		"URL":          p.BaseURL + post.Permalink(),
+		"Title":        post.Title,
+		"Excerpt":      strings.Split(post.Markdown, "\n")[0],
+		"AuthorName":   p.AuthorName,
+		"ProviderName": p.SiteName,
+		"ProviderURL":  p.BaseURL,
+	})
+	if err != nil {
+		return Response{}, err
+	}
+	res.HTML = buf.String()
+
+	return res, nil
+}
+
+// thumbnail returns a URL and dimensions of the featured image,
+// returns empty values if the image cannot be found or decoded because all of them must be present together
+func (p Provider) thumbnail(ctx context.Context, id primitive.ObjectID) (string, int, int) {
+	f, err := p.FileRepository.FindByID(ctx, id)
+	if err != nil {
+		return "", 0, 0
+	}
+
+	body, err := p.Storage.Download(ctx, f.Path)
+	if err != nil {
+		return "", 0, 0
+	}
+	defer body.Close()
+
+	cfg, _, err := image.DecodeConfig(body)
+	if err != nil {
+		return "", 0, 0
+	}
+	return p.BaseURL + storageURLPath + f.Slug, cfg.Width, cfg.Height
+}
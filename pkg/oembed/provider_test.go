@@ -0,0 +1,180 @@
+package oembed
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	mock_blog "github.com/nomkhonwaan/myblog/pkg/blog/mock"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestProvider_PostID(t *testing.T) {
+	p := Provider{BaseURL: "https://www.nomkhonwaan.com"}
+	id := primitive.NewObjectID()
+
+	t.Run("With a post permalink", func(t *testing.T) {
+		// Given
+
+		// When
+		result, err := p.PostID("https://www.nomkhonwaan.com/2020/3/29/test-" + id.Hex())
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, id, result)
+	})
+
+	t.Run("With a permalink on another host", func(t *testing.T) {
+		// Given
+
+		// When
+		_, err := p.PostID("https://example.com/2020/3/29/test-" + id.Hex())
+
+		// Then
+		assert.Equal(t, errNotFound, err)
+	})
+
+	t.Run("With a URL which is not a post permalink", func(t *testing.T) {
+		// Given
+
+		// When
+		_, err := p.PostID("https://www.nomkhonwaan.com/tag/go-" + id.Hex())
+
+		// Then
+		assert.Equal(t, errNotFound, err)
+	})
+
+	t.Run("With invalid slug", func(t *testing.T) {
+		// Given
+
+		// When
+		_, err := p.PostID("https://www.nomkhonwaan.com/2020/3/29/test")
+
+		// Then
+		assert.NotNil(t, err)
+	})
+}
+
+func TestProvider_Embed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		bucket         = mock_storage.NewMockStorage(ctrl)
+		postRepository = mock_blog.NewMockPostRepository(ctrl)
+		fileRepository = mock_storage.NewMockFileRepository(ctrl)
+	)
+
+	p := Provider{
+		BaseURL:        "http://localhost",
+		SiteName:       "Nomkhonwaan",
+		AuthorName:     "Natcha Luangaroonchai",
+		Storage:        bucket,
+		PostRepository: postRepository,
+		FileRepository: fileRepository,
+	}
+
+	id := primitive.NewObjectID()
+	post := blog.Post{ID: id, Title: "Test", Slug: "test-" + id.Hex(), Status: blog.StatusPublished, AuthorID: "github|1",
+		Markdown: "Lorem ipsum dolor sit amet.\nAenean at ornare ipsum.", PublishedAt: time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC)}
+
+	t.Run("With successful embedding a post", func(t *testing.T) {
+		// Given
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(post, nil)
+
+		expected := Response{
+			Type:         "rich",
+			Version:      "1.0",
+			Title:        "Test",
+			AuthorName:   "Natcha Luangaroonchai",
+			ProviderName: "Nomkhonwaan",
+			ProviderURL:  "http://localhost",
+			HTML: `<blockquote class="myblog-embed" cite="http://localhost/2020/3/29/test-` + id.Hex() + `">` +
+				`<p><a href="http://localhost/2020/3/29/test-` + id.Hex() + `">Test</a></p>` +
+				`<p>Lorem ipsum dolor sit amet.</p>` +
+				`<p>&mdash; Natcha Luangaroonchai, <a href="http://localhost">Nomkhonwaan</a></p></blockquote>`,
+			Width:  DefaultWidth,
+			Height: DefaultHeight,
+		}
+
+		// When
+		result, err := p.Embed(context.Background(), id)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("With successful embedding a post with featured image", func(t *testing.T) {
+		// Given
+		post := post
+		post.FeaturedImage = mongo.DBRef{ID: primitive.NewObjectID()}
+		buf := bytes.Buffer{}
+		_ = png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 120, 63)))
+
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(post, nil)
+		fileRepository.EXPECT().FindByID(gomock.Any(), post.FeaturedImage.ID).
+			Return(storage.File{Path: "authors/1/featured-image.png", Slug: "featured-image.png"}, nil)
+		bucket.EXPECT().Download(gomock.Any(), "authors/1/featured-image.png").Return(ioutil.NopCloser(&buf), nil)
+
+		// When
+		result, err := p.Embed(context.Background(), id)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, "http://localhost/api/v2.1/storage/featured-image.png", result.ThumbnailURL)
+		assert.Equal(t, 120, result.ThumbnailWidth)
+		assert.Equal(t, 63, result.ThumbnailHeight)
+	})
+
+	t.Run("When unable to download the featured image", func(t *testing.T) {
+		// Given
+		post := post
+		post.FeaturedImage = mongo.DBRef{ID: primitive.NewObjectID()}
+
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(post, nil)
+		fileRepository.EXPECT().FindByID(gomock.Any(), post.FeaturedImage.ID).Return(storage.File{Path: "authors/1/featured-image.png"}, nil)
+		bucket.EXPECT().Download(gomock.Any(), "authors/1/featured-image.png").Return(nil, errors.New("test unable to download"))
+
+		// When
+		result, err := p.Embed(context.Background(), id)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Empty(t, result.ThumbnailURL)
+		assert.Zero(t, result.ThumbnailWidth)
+		assert.Zero(t, result.ThumbnailHeight)
+	})
+
+	t.Run("When the post is not published", func(t *testing.T) {
+		// Given
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{ID: id, Status: blog.StatusDraft}, nil)
+
+		// When
+		_, err := p.Embed(context.Background(), id)
+
+		// Then
+		assert.Equal(t, errNotFound, err)
+	})
+
+	t.Run("When unable to find the post", func(t *testing.T) {
+		// Given
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{}, errors.New("test unable to find the post"))
+
+		// When
+		_, err := p.Embed(context.Background(), id)
+
+		// Then
+		assert.EqualError(t, err, "test unable to find the post")
+	})
+}
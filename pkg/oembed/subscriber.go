@@ -0,0 +1,30 @@
+package oembed
+
+import (
+	"context"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// Subscribe registers handlers on the bus which invalidate the cached oEmbed response of the post
+// whenever the post has been unpublished or changed
+func Subscribe(bus eventbus.Bus, cache storage.Cache) {
+	invalidate := func(_ context.Context, e eventbus.Event, p blog.Post) {
+		filePath := cacheFilePath(p.ID)
+		if !cache.Exists(filePath) {
+			return
+		}
+		if err := cache.Delete(filePath); err != nil {
+			logrus.Errorf("unable to invalidate %s on the event %s: %s", filePath, e.EventName(), err)
+		}
+	}
+
+	bus.Subscribe(blog.PostUnpublished{}, func(ctx context.Context, e eventbus.Event) {
+		invalidate(ctx, e, e.(blog.PostUnpublished).Post)
+	})
+	bus.Subscribe(blog.PostContentChanged{}, func(ctx context.Context, e eventbus.Event) {
+		invalidate(ctx, e, e.(blog.PostContentChanged).Post)
+	})
+}
@@ -0,0 +1,58 @@
+package oembed
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
+	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+)
+
+func TestSubscribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		cache = mock_storage.NewMockCache(ctrl)
+	)
+
+	bus := eventbus.NewLocalBus()
+	Subscribe(bus, cache)
+
+	p := blog.Post{ID: primitive.NewObjectID()}
+
+	t.Run("With successful invalidating the cached response when the post has been changed", func(t *testing.T) {
+		// Given
+		cache.EXPECT().Exists(cacheFilePath(p.ID)).Return(true)
+		cache.EXPECT().Delete(cacheFilePath(p.ID)).Return(nil)
+
+		// When
+		bus.Publish(context.Background(), blog.PostContentChanged{Post: p})
+
+		// Then
+	})
+
+	t.Run("When the response has not been cached", func(t *testing.T) {
+		// Given
+		cache.EXPECT().Exists(cacheFilePath(p.ID)).Return(false)
+
+		// When
+		bus.Publish(context.Background(), blog.PostUnpublished{Post: p})
+
+		// Then
+	})
+
+	t.Run("When unable to delete the cached response", func(t *testing.T) {
+		// Given
+		cache.EXPECT().Exists(cacheFilePath(p.ID)).Return(true)
+		cache.EXPECT().Delete(cacheFilePath(p.ID)).Return(errors.New("test unable to delete"))
+
+		// When
+		bus.Publish(context.Background(), blog.PostUnpublished{Post: p})
+
+		// Then
+	})
+}
@@ -0,0 +1,72 @@
+package opengraph
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-chi/chi"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/image"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// ServeCoverHandlerFunc provides a generated cover image of the published post which is named by the "slug" URL parameter,
+// the image is stored on the cache until the post has been changed
+func ServeCoverHandlerFunc(cache storage.Cache, drawer image.CoverDrawer, renderer Renderer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := blog.GetIDFromSlug(chi.URLParam(r, "slug"))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		filePath := coverCacheFilePath(id)
+		if cache.Exists(filePath) {
+			body, err := cache.Retrieve(filePath)
+			if err == nil {
+				defer body.Close()
+				w.Header().Set("Content-Type", "image/png")
+				_, _ = io.Copy(w, body)
+				return
+			}
+			logrus.Errorf("unable to retrieve %s: %s", filePath, err)
+		}
+
+		p, err := renderer.findPublishedPost(r.Context(), id)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		img, err := drawer.Draw(image.Cover{Title: p.Title, AuthorName: renderer.AuthorName, SiteName: renderer.SiteName})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		data, err := ioutil.ReadAll(img)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if err = cache.Store(bytes.NewReader(data), filePath); err != nil {
+			logrus.Errorf("unable to store %s: %s", filePath, err)
+		}
+
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(data)
+	}
+}
+
+// coverURLPath returns a URL path of the generated cover image of the post
+func coverURLPath(p blog.Post) string {
+	return "/covers/" + p.Slug + ".png"
+}
+
+func coverCacheFilePath(id primitive.ObjectID) string {
+	return fmt.Sprintf("covers/%s.png", id.Hex())
+}
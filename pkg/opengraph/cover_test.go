@@ -0,0 +1,138 @@
+package opengraph
+
+import (
+	"context"
+	"errors"
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	mock_blog "github.com/nomkhonwaan/myblog/pkg/blog/mock"
+	"github.com/nomkhonwaan/myblog/pkg/image"
+	mock_image "github.com/nomkhonwaan/myblog/pkg/image/mock"
+	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServeCoverHandlerFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		cache          = mock_storage.NewMockCache(ctrl)
+		drawer         = mock_image.NewMockCoverDrawer(ctrl)
+		postRepository = mock_blog.NewMockPostRepository(ctrl)
+	)
+
+	h := ServeCoverHandlerFunc(cache, drawer, Renderer{
+		BaseURL:        "http://localhost",
+		SiteName:       "Nomkhonwaan",
+		AuthorName:     "Natcha Luangaroonchai",
+		PostRepository: postRepository,
+	})
+
+	id := primitive.NewObjectID()
+	newRequest := func(slug string) *http.Request {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("slug", slug)
+		return httptest.NewRequest(http.MethodGet, "/covers/"+slug+".png", nil).
+			WithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx))
+	}
+
+	t.Run("With successful drawing a cover image of the post", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("covers/" + id.Hex() + ".png").Return(false)
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{ID: id, Title: "Test", Status: blog.StatusPublished}, nil)
+		drawer.EXPECT().Draw(image.Cover{Title: "Test", AuthorName: "Natcha Luangaroonchai", SiteName: "Nomkhonwaan"}).
+			Return(strings.NewReader("test cover image"), nil)
+		cache.EXPECT().Store(gomock.Any(), "covers/"+id.Hex()+".png").Return(errors.New("test unable to store"))
+
+		// When
+		h.ServeHTTP(w, newRequest("test-"+id.Hex()))
+
+		// Then
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+		assert.Equal(t, "test cover image", w.Body.String())
+	})
+
+	t.Run("With successful retrieving a cached cover image", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("covers/" + id.Hex() + ".png").Return(true)
+		cache.EXPECT().Retrieve("covers/"+id.Hex()+".png").Return(ioutil.NopCloser(strings.NewReader("test cover image")), nil)
+
+		// When
+		h.ServeHTTP(w, newRequest("test-"+id.Hex()))
+
+		// Then
+		assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+		assert.Equal(t, "test cover image", w.Body.String())
+	})
+
+	t.Run("When unable to retrieve the cached cover image", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("covers/" + id.Hex() + ".png").Return(true)
+		cache.EXPECT().Retrieve("covers/"+id.Hex()+".png").Return(nil, errors.New("test unable to retrieve"))
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{ID: id, Title: "Test", Status: blog.StatusPublished}, nil)
+		drawer.EXPECT().Draw(gomock.Any()).Return(strings.NewReader("test cover image"), nil)
+		cache.EXPECT().Store(gomock.Any(), "covers/"+id.Hex()+".png").Return(nil)
+
+		// When
+		h.ServeHTTP(w, newRequest("test-"+id.Hex()))
+
+		// Then
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "test cover image", w.Body.String())
+	})
+
+	t.Run("When unable to draw the cover image", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("covers/" + id.Hex() + ".png").Return(false)
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{ID: id, Title: "Test", Status: blog.StatusPublished}, nil)
+		drawer.EXPECT().Draw(gomock.Any()).Return(nil, errors.New("test unable to draw"))
+
+		// When
+		h.ServeHTTP(w, newRequest("test-"+id.Hex()))
+
+		// Then
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("When the post is not published", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("covers/" + id.Hex() + ".png").Return(false)
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{ID: id, Status: blog.StatusDraft}, nil)
+
+		// When
+		h.ServeHTTP(w, newRequest("test-"+id.Hex()))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("With invalid slug", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		// When
+		h.ServeHTTP(w, newRequest("test"))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,144 @@
+package opengraph
+
+import (
+	"encoding/json"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"html/template"
+)
+
+const schemaContext = "https://schema.org"
+
+// blogPosting is a schema.org BlogPosting structured data of the published post
+type blogPosting struct {
+	Context          string       `json:"@context"`
+	Type             string       `json:"@type"`
+	Headline         string       `json:"headline"`
+	Description      string       `json:"description,omitempty"`
+	URL              string       `json:"url"`
+	MainEntityOfPage string       `json:"mainEntityOfPage"`
+	Image            []string     `json:"image,omitempty"`
+	DatePublished    string       `json:"datePublished"`
+	DateModified     string       `json:"dateModified,omitempty"`
+	Author           person       `json:"author"`
+	Publisher        organization `json:"publisher"`
+	ArticleSection   []string     `json:"articleSection,omitempty"`
+	Keywords         []string     `json:"keywords,omitempty"`
+}
+
+type person struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+type organization struct {
+	Type string      `json:"@type"`
+	Name string      `json:"name"`
+	URL  string      `json:"url"`
+	Logo imageObject `json:"logo"`
+}
+
+type imageObject struct {
+	Type string `json:"@type"`
+	URL  string `json:"url"`
+}
+
+// breadcrumbList is a schema.org BreadcrumbList structured data of the navigation path to the page
+type breadcrumbList struct {
+	Context         string     `json:"@context"`
+	Type            string     `json:"@type"`
+	ItemListElement []listItem `json:"itemListElement"`
+}
+
+type listItem struct {
+	Type     string `json:"@type"`
+	Position int    `json:"position"`
+	Name     string `json:"name"`
+	Item     string `json:"item"`
+}
+
+// webSite is a schema.org WebSite structured data of the whole site
+type webSite struct {
+	Context         string        `json:"@context"`
+	Type            string        `json:"@type"`
+	Name            string        `json:"name"`
+	URL             string        `json:"url"`
+	Description     string        `json:"description,omitempty"`
+	PotentialAction *searchAction `json:"potentialAction,omitempty"`
+}
+
+// searchAction describes how to search the site, the target must contain the "{search_term_string}" placeholder
+type searchAction struct {
+	Type       string `json:"@type"`
+	Target     string `json:"target"`
+	QueryInput string `json:"query-input"`
+}
+
+// JSONLD returns all structured data of the page in JSON-LD format which are safe to be embedded in the script element
+func (p Page) JSONLD() []template.JS {
+	scripts := make([]template.JS, 0, len(p.StructuredData))
+	for _, v := range p.StructuredData {
+		// The encoder escapes "<", ">" and "&", the result cannot close the script element
+		data, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		scripts = append(scripts, template.JS(data))
+	}
+	return scripts
+}
+
+func (r Renderer) newWebSite() webSite {
+	site := webSite{Context: schemaContext, Type: "WebSite", Name: r.SiteName, URL: r.BaseURL, Description: r.Description}
+	if r.SearchURLTemplate != "" {
+		site.PotentialAction = &searchAction{
+			Type:       "SearchAction",
+			Target:     r.SearchURLTemplate,
+			QueryInput: "required name=search_term_string",
+		}
+	}
+	return site
+}
+
+func (r Renderer) newBlogPosting(page Page, p blog.Post, tree blog.CategoryTree) blogPosting {
+	posting := blogPosting{
+		Context:          schemaContext,
+		Type:             "BlogPosting",
+		Headline:         p.Title,
+		Description:      page.Description,
+		URL:              page.URL,
+		MainEntityOfPage: page.URL,
+		Image:            []string{page.FeaturedImage},
+		DatePublished:    page.PublishedTime,
+		DateModified:     page.ModifiedTime,
+		Author:           person{Type: "Person", Name: r.AuthorName},
+		Publisher: organization{
+			Type: "Organization",
+			Name: r.SiteName,
+			URL:  r.BaseURL,
+			Logo: imageObject{Type: "ImageObject", URL: r.BaseURL + defaultImagePath},
+		},
+		Keywords: page.Tags,
+	}
+	if posting.DateModified == "" {
+		posting.DateModified = posting.DatePublished
+	}
+	for _, ref := range p.Categories {
+		if c, ok := tree.Get(ref.ID); ok {
+			posting.ArticleSection = append(posting.ArticleSection, c.Name)
+		}
+	}
+	return posting
+}
+
+// newBreadcrumbList returns the navigation path which always begins with the home page
+func (r Renderer) newBreadcrumbList(breadcrumbs []blog.Breadcrumb) breadcrumbList {
+	list := breadcrumbList{
+		Context:         schemaContext,
+		Type:            "BreadcrumbList",
+		ItemListElement: []listItem{{Type: "ListItem", Position: 1, Name: r.SiteName, Item: r.BaseURL}},
+	}
+	for i, b := range breadcrumbs {
+		list.ItemListElement = append(list.ItemListElement, listItem{Type: "ListItem", Position: i + 2, Name: b.Name, Item: r.BaseURL + b.Path})
+	}
+	return list
+}
@@ -0,0 +1,173 @@
+package opengraph
+
+import (
+	"bytes"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	mock_blog "github.com/nomkhonwaan/myblog/pkg/blog/mock"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	"github.com/nomkhonwaan/myblog/pkg/oembed"
+	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"html/template"
+	"testing"
+	"time"
+)
+
+func TestRenderer_Page_StructuredData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		categoryRepository = mock_blog.NewMockCategoryRepository(ctrl)
+		tagRepository      = mock_blog.NewMockTagRepository(ctrl)
+		postRepository     = mock_blog.NewMockPostRepository(ctrl)
+		fileRepository     = mock_storage.NewMockFileRepository(ctrl)
+	)
+
+	r := Renderer{
+		BaseURL:            "http://localhost",
+		SiteName:           "Nomkhonwaan",
+		Description:        "Trust me I'm Petdo",
+		AuthorName:         "Natcha Luangaroonchai",
+		CategoryRepository: categoryRepository,
+		TagRepository:      tagRepository,
+		PostRepository:     postRepository,
+		FileRepository:     fileRepository,
+	}
+
+	parent := blog.Category{ID: primitive.NewObjectID(), Name: "Programming"}
+	parent.Slug = "programming-" + parent.ID.Hex()
+	cat := blog.Category{ID: primitive.NewObjectID(), Name: "Go", Parent: mongo.DBRef{ID: parent.ID}}
+	cat.Slug = "go-" + cat.ID.Hex()
+
+	t.Run("With a published post", func(t *testing.T) {
+		// Given
+		id := primitive.NewObjectID()
+		tagID := primitive.NewObjectID()
+		p := blog.Post{ID: id, Title: "Test", Slug: "test-" + id.Hex(), Status: blog.StatusPublished,
+			Markdown: "Lorem ipsum dolor sit amet.", AuthorID: "github|1",
+			PublishedAt: time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC),
+			Categories:  []mongo.DBRef{{ID: cat.ID}}, Tags: []mongo.DBRef{{ID: tagID}}}
+
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(p, nil)
+		tagRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{tagID}).Return([]blog.Tag{{ID: tagID, Name: "Golang"}}, nil)
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{parent, cat}, nil)
+
+		permalink := "http://localhost/2020/3/29/test-" + id.Hex()
+		expected := []template.JS{
+			template.JS(`{"@context":"https://schema.org","@type":"BlogPosting","headline":"Test","description":"Lorem ipsum dolor sit amet.",` +
+				`"url":"` + permalink + `","mainEntityOfPage":"` + permalink + `","image":["http://localhost/covers/test-` + id.Hex() + `.png"],` +
+				`"datePublished":"2020-03-29T10:00:00Z","dateModified":"2020-03-29T10:00:00Z",` +
+				`"author":{"@type":"Person","name":"Natcha Luangaroonchai"},` +
+				`"publisher":{"@type":"Organization","name":"Nomkhonwaan","url":"http://localhost",` +
+				`"logo":{"@type":"ImageObject","url":"http://localhost/assets/images/303589.webp"}},` +
+				`"articleSection":["Go"],"keywords":["Golang"]}`),
+			template.JS(`{"@context":"https://schema.org","@type":"BreadcrumbList","itemListElement":[` +
+				`{"@type":"ListItem","position":1,"name":"Nomkhonwaan","item":"http://localhost"},` +
+				`{"@type":"ListItem","position":2,"name":"Programming","item":"http://localhost/category/` + parent.Slug + `"},` +
+				`{"@type":"ListItem","position":3,"name":"Go","item":"http://localhost/category/` + parent.Slug + `/` + cat.Slug + `"},` +
+				`{"@type":"ListItem","position":4,"name":"Test","item":"` + permalink + `"}]}`),
+		}
+
+		// When
+		page, err := r.Page(context.Background(), "/2020/3/29/test-"+id.Hex())
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, expected, page.JSONLD())
+		assert.Equal(t, []Alternate{
+			{Type: "application/json+oembed", URL: oembed.DiscoveryURL("http://localhost", permalink, oembed.JSON)},
+			{Type: "text/xml+oembed", URL: oembed.DiscoveryURL("http://localhost", permalink, oembed.XML)},
+		}, page.Alternates)
+	})
+
+	t.Run("With a category page", func(t *testing.T) {
+		// Given
+		categoryRepository.EXPECT().FindByID(gomock.Any(), cat.ID).Return(cat, nil)
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{parent, cat}, nil)
+
+		expected := []template.JS{
+			template.JS(`{"@context":"https://schema.org","@type":"BreadcrumbList","itemListElement":[` +
+				`{"@type":"ListItem","position":1,"name":"Nomkhonwaan","item":"http://localhost"},` +
+				`{"@type":"ListItem","position":2,"name":"Programming","item":"http://localhost/category/` + parent.Slug + `"},` +
+				`{"@type":"ListItem","position":3,"name":"Go","item":"http://localhost/category/` + parent.Slug + `/` + cat.Slug + `"}]}`),
+		}
+
+		// When
+		page, err := r.Page(context.Background(), "/category/"+parent.Slug+"/"+cat.Slug)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, expected, page.JSONLD())
+	})
+
+	t.Run("With a tag page", func(t *testing.T) {
+		// Given
+		tag := blog.Tag{ID: primitive.NewObjectID(), Name: "Go"}
+		tag.Slug = "go-" + tag.ID.Hex()
+
+		tagRepository.EXPECT().FindByID(gomock.Any(), tag.ID).Return(tag, nil)
+
+		expected := []template.JS{
+			template.JS(`{"@context":"https://schema.org","@type":"BreadcrumbList","itemListElement":[` +
+				`{"@type":"ListItem","position":1,"name":"Nomkhonwaan","item":"http://localhost"},` +
+				`{"@type":"ListItem","position":2,"name":"Go","item":"http://localhost/tag/` + tag.Slug + `"}]}`),
+		}
+
+		// When
+		page, err := r.Page(context.Background(), "/tag/"+tag.Slug)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, expected, page.JSONLD())
+	})
+
+	t.Run("With the home page", func(t *testing.T) {
+		// Given
+		expected := []template.JS{
+			template.JS(`{"@context":"https://schema.org","@type":"WebSite","name":"Nomkhonwaan","url":"http://localhost","description":"Trust me I'm Petdo"}`),
+		}
+
+		// When
+		page, err := r.Page(context.Background(), "/")
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, expected, page.JSONLD())
+	})
+
+	t.Run("With the home page and site search", func(t *testing.T) {
+		// Given
+		r := r
+		r.SearchURLTemplate = "http://localhost/search?q={search_term_string}"
+
+		expected := []template.JS{
+			template.JS(`{"@context":"https://schema.org","@type":"WebSite","name":"Nomkhonwaan","url":"http://localhost","description":"Trust me I'm Petdo",` +
+				`"potentialAction":{"@type":"SearchAction","target":"http://localhost/search?q={search_term_string}","query-input":"required name=search_term_string"}}`),
+		}
+
+		// When
+		page, err := r.Page(context.Background(), "/")
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, expected, page.JSONLD())
+	})
+}
+
+func TestPage_JSONLD(t *testing.T) {
+	// Given
+	tmpl := template.Must(template.New("test-jsonld-template").Parse(`{{range .JSONLD}}<script type="application/ld+json">{{.}}</script>{{end}}`))
+	page := Page{StructuredData: []interface{}{map[string]string{"headline": "</script><script>alert(1)</script>"}}}
+	buf := bytes.Buffer{}
+
+	// When
+	err := tmpl.Execute(&buf, page)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, `<script type="application/ld+json">{"headline":"\u003c/script\u003e\u003cscript\u003ealert(1)\u003c/script\u003e"}</script>`, buf.String())
+}
@@ -2,42 +2,56 @@ package opengraph
 
 import (
 	"bytes"
-	"github.com/nomkhonwaan/myblog/pkg/blog"
-	"github.com/nomkhonwaan/myblog/pkg/graphql"
 	"github.com/nomkhonwaan/myblog/pkg/storage"
-	"github.com/nomkhonwaan/myblog/pkg/timeutil"
-	"html/template"
+	"github.com/sirupsen/logrus"
+	"io"
 	"net/http"
-	"regexp"
 	"strings"
 )
 
-var (
-	singlePageRegExp = regexp.MustCompile(`\d{4}/\d{1,2}/\d{1,2}/(.+)$`)
-)
+// DefaultCrawlers contains user agents of the link preview crawlers of social networks and chat applications
+var DefaultCrawlers = []string{
+	"facebookexternalhit",
+	"Facebot",
+	"Twitterbot",
+	"LinkedInBot",
+	"Slackbot",
+	"Discordbot",
+	"line-poker",
+	"WhatsApp",
+	"TelegramBot",
+	"Pinterestbot",
+}
 
-// ServeStaticSinglePageMiddleware provides a static HTML page for crawler bot on the single page
-func ServeStaticSinglePageMiddleware(baseURL string, ogTmpl *template.Template, postRepository blog.PostRepository, fileRepository storage.FileRepository) func(http.Handler) http.Handler {
+// ServeCrawlerPageMiddleware provides a static HTML page which contains the opengraph and Twitter Card metadata
+// for the crawlers, all other user agents and pages without metadata are passed to the next handler
+func ServeCrawlerPageMiddleware(crawlers []string, renderer Renderer) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if isFacebookCrawler(r.UserAgent()) {
-				if slug, yes := isSinglePage(r.URL.Path); yes {
-					id := graphql.Slug(slug).MustGetID()
-					p, err := postRepository.FindByID(r.Context(), id)
-					if err != nil {
-						http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
-						return
-					}
+			// The same URL responds differently to the crawlers, any shared cache must not mix them up
+			addVaryUserAgent(w.Header())
 
-					if p.Status == blog.StatusPublished {
-						var f storage.File
-						if !p.FeaturedImage.ID.IsZero() {
-							f, _ = fileRepository.FindByID(r.Context(), p.FeaturedImage.ID)
-						}
-
-						_, _ = w.Write(renderStaticSinglePage(baseURL, ogTmpl, p, f))
+			if isCrawler(crawlers, r.UserAgent()) {
+				p, err := renderer.Page(r.Context(), r.URL.Path)
+				if err == nil {
+					buf := bytes.Buffer{}
+					if err = renderer.Template.Execute(&buf, p); err != nil {
+						http.Error(w, err.Error(), http.StatusInternalServerError)
 						return
 					}
+
+					w.Header().Set("Content-Type", "text/html; charset=utf-8")
+					_, _ = w.Write(buf.Bytes())
+					return
+				}
+				if isNotFound(err) {
+					http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+					return
+				}
+				if err != errNoPage {
+					logrus.Errorf("unable to render the opengraph page %s: %s", r.URL.Path, err)
+					http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+					return
 				}
 			}
 
@@ -46,38 +60,85 @@ func ServeStaticSinglePageMiddleware(baseURL string, ogTmpl *template.Template,
 	}
 }
 
-func renderStaticSinglePage(baseURL string, tmpl *template.Template, p blog.Post, f storage.File) []byte {
-	featuredImage := baseURL + "/assets/images/303589.webp"
-	if f.Slug != "" {
-		featuredImage = baseURL + "/api/v2.1/storage/" + f.Slug
-	}
+// ServePrerenderedPageMiddleware provides a complete HTML page of the home, post, category and tag pages for the bots,
+// the page is stored on the cache until its content has been changed,
+// all other user agents and pages are passed to the next handler
+func ServePrerenderedPageMiddleware(bots []string, cache storage.Cache, prerenderer Prerenderer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			addVaryUserAgent(w.Header())
 
-	buf := bytes.Buffer{}
-	_ = tmpl.Execute(&buf, struct {
-		URL           string
-		Type          string
-		Title         string
-		Description   string
-		FeaturedImage string
-	}{
-		URL:           baseURL + "/" + p.PublishedAt.In(timeutil.TimeZoneAsiaBangkok).Format("2006/1/2") + "/" + p.Slug,
-		Type:          "article",
-		Title:         p.Title,
-		Description:   strings.Split(p.Markdown, "\n")[0],
-		FeaturedImage: featuredImage,
-	})
-
-	return buf.Bytes()
+			if !isCrawler(bots, r.UserAgent()) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rt, err := parseRoute(r.URL.Path)
+			if err == errNoPage {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if err != nil {
+				http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+				return
+			}
+
+			filePath := rt.cacheFilePath()
+			if cache.Exists(filePath) {
+				body, err := cache.Retrieve(filePath)
+				if err == nil {
+					defer body.Close()
+					w.Header().Set("Content-Type", "text/html; charset=utf-8")
+					_, _ = io.Copy(w, body)
+					return
+				}
+				logrus.Errorf("unable to retrieve %s: %s", filePath, err)
+			}
+
+			name, data, err := prerenderer.page(r.Context(), rt)
+			if isNotFound(err) {
+				http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+				return
+			}
+			if err != nil {
+				logrus.Errorf("unable to prerender %s: %s", r.URL.Path, err)
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+
+			buf := bytes.Buffer{}
+			if err = prerenderer.Template.ExecuteTemplate(&buf, name, data); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			if err = cache.Store(bytes.NewReader(buf.Bytes()), filePath); err != nil {
+				logrus.Errorf("unable to store %s: %s", filePath, err)
+			}
+
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write(buf.Bytes())
+		})
+	}
 }
 
-func isFacebookCrawler(userAgent string) bool {
-	return regexp.MustCompile("facebookexternalhit").MatchString(userAgent)
+// addVaryUserAgent adds the "User-Agent" to the "Vary" header only once, the middlewares can be chained together
+func addVaryUserAgent(h http.Header) {
+	for _, v := range h.Values("Vary") {
+		if v == "User-Agent" {
+			return
+		}
+	}
+	h.Add("Vary", "User-Agent")
 }
 
-func isSinglePage(url string) (string, bool) {
-	if !singlePageRegExp.MatchString(url) {
-		return "", false
+// isCrawler returns "true" if the user agent contains one of the crawler names, case-insensitively
+func isCrawler(crawlers []string, userAgent string) bool {
+	userAgent = strings.ToLower(userAgent)
+	for _, c := range crawlers {
+		if c != "" && strings.Contains(userAgent, strings.ToLower(c)) {
+			return true
+		}
 	}
-	matches := singlePageRegExp.FindStringSubmatch(url)
-	return matches[1], true
+	return false
 }
@@ -10,113 +10,504 @@ import (
 	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	mgo "go.mongodb.org/mongo-driver/mongo"
 	"html/template"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 )
 
-func TestServeStaticSinglePageMiddleware(t *testing.T) {
+func TestServeCrawlerPageMiddleware(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	var (
-		postRepository = mock_blog.NewMockPostRepository(ctrl)
-		fileRepository = mock_storage.NewMockFileRepository(ctrl)
+		categoryRepository = mock_blog.NewMockCategoryRepository(ctrl)
+		tagRepository      = mock_blog.NewMockTagRepository(ctrl)
+		postRepository     = mock_blog.NewMockPostRepository(ctrl)
+		fileRepository     = mock_storage.NewMockFileRepository(ctrl)
 	)
 
 	tmpl := template.Must(template.New("test-opengraph-template").Parse(`
+{{.SiteName}}
 {{.URL}}
 {{.Type}}
 {{.Title}}
 {{.Description}}
 {{.FeaturedImage}}
+{{.PublishedTime}}
+{{.ModifiedTime}}
+{{range .Tags}}{{.}},{{end}}
 `))
-	middleware := ServeStaticSinglePageMiddleware("http://localhost", tmpl, postRepository, fileRepository)
+	middleware := ServeCrawlerPageMiddleware(DefaultCrawlers, Renderer{
+		BaseURL:            "http://localhost",
+		SiteName:           "Nomkhonwaan",
+		Description:        "Trust me I'm Petdo",
+		AuthorName:         "Natcha Luangaroonchai",
+		Template:           tmpl,
+		CategoryRepository: categoryRepository,
+		TagRepository:      tagRepository,
+		PostRepository:     postRepository,
+		FileRepository:     fileRepository,
+	})
 	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		_, _ = w.Write([]byte("OK"))
 	})
 
-	newFacebookCrawlerBot := func(url string) *http.Request {
+	newCrawlerRequest := func(url, userAgent string) *http.Request {
 		req := httptest.NewRequest(http.MethodGet, url, nil)
-		req.Header.Set("User-Agent", "facebookexternalhit/1.1")
+		req.Header.Set("User-Agent", userAgent)
 		return req
 	}
 
-	t.Run("With successful rendering template for Facebook crawler bot", func(t *testing.T) {
+	publishedAt := time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2020, 3, 30, 10, 0, 0, 0, time.UTC)
+
+	t.Run("With successful rendering a post page for Facebook crawler", func(t *testing.T) {
 		// Given
 		w := httptest.NewRecorder()
-		now := time.Now()
 		id := primitive.NewObjectID()
-		p := blog.Post{ID: primitive.NewObjectID(), Title: "Test", Slug: "test-" + id.Hex(), Status: blog.StatusPublished,
+		tagID := primitive.NewObjectID()
+		p := blog.Post{ID: id, Title: "Test", Slug: "test-" + id.Hex(), Status: blog.StatusPublished,
 			Markdown:    "Lorem ipsum dolor sit amet, consectetur adipiscing elit.\nAenean at ornare ipsum.",
-			PublishedAt: now, FeaturedImage: mongo.DBRef{ID: primitive.NewObjectID()}}
+			PublishedAt: publishedAt, UpdatedAt: updatedAt, FeaturedImage: mongo.DBRef{ID: primitive.NewObjectID()},
+			Tags: []mongo.DBRef{{ID: tagID}}}
 
 		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(p, nil)
 		fileRepository.EXPECT().FindByID(gomock.Any(), p.FeaturedImage.ID).Return(storage.File{Slug: "test-featured-image"}, nil)
+		tagRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{tagID}).Return([]blog.Tag{{ID: tagID, Name: "Go"}}, nil)
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
 
 		expected := `
-http://localhost/` + now.Format("2006/1/2") + `/test-` + id.Hex() + `
+Nomkhonwaan
+http://localhost/2020/3/29/test-` + id.Hex() + `
 article
 Test
 Lorem ipsum dolor sit amet, consectetur adipiscing elit.
 http://localhost/api/v2.1/storage/test-featured-image
+2020-03-29T10:00:00Z
+2020-03-30T10:00:00Z
+Go,
 `
 
 		// When
-		middleware(next).ServeHTTP(w, newFacebookCrawlerBot("http://localhost/"+now.Format("2006/1/2")+"/test-"+id.Hex()))
+		middleware(next).ServeHTTP(w, newCrawlerRequest("http://localhost/2020/3/29/test-"+id.Hex(), "facebookexternalhit/1.1"))
 
 		// Then
+		assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "User-Agent", w.Header().Get("Vary"))
 		assert.Equal(t, expected, w.Body.String())
 	})
 
-	t.Run("With successful rendering template with default featured image", func(t *testing.T) {
+	t.Run("With successful rendering a post page with generated cover image for Twitter crawler", func(t *testing.T) {
 		// Given
 		w := httptest.NewRecorder()
-		now := time.Now()
 		id := primitive.NewObjectID()
-		p := blog.Post{ID: primitive.NewObjectID(), Title: "Test", Slug: "test-" + id.Hex(), Status: blog.StatusPublished,
-			Markdown: "Lorem ipsum dolor sit amet, consectetur adipiscing elit.\nAenean at ornare ipsum.", PublishedAt: now}
+		p := blog.Post{ID: id, Title: "Test", Slug: "test-" + id.Hex(), Status: blog.StatusPublished,
+			Markdown: "Lorem ipsum dolor sit amet.", PublishedAt: publishedAt}
 
 		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(p, nil)
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
 
 		expected := `
-http://localhost/` + now.Format("2006/1/2") + `/test-` + id.Hex() + `
+Nomkhonwaan
+http://localhost/2020/3/29/test-` + id.Hex() + `
 article
 Test
-Lorem ipsum dolor sit amet, consectetur adipiscing elit.
+Lorem ipsum dolor sit amet.
+http://localhost/covers/test-` + id.Hex() + `.png
+2020-03-29T10:00:00Z
+
+
+`
+
+		// When
+		middleware(next).ServeHTTP(w, newCrawlerRequest("http://localhost/2020/3/29/test-"+id.Hex(), "Twitterbot/1.0"))
+
+		// Then
+		assert.Equal(t, expected, w.Body.String())
+	})
+
+	t.Run("With successful rendering the home page for LINE crawler", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		expected := `
+Nomkhonwaan
+http://localhost
+website
+Nomkhonwaan
+Trust me I&#39;m Petdo
+http://localhost/assets/images/303589.webp
+
+
+
+`
+
+		// When
+		middleware(next).ServeHTTP(w, newCrawlerRequest("http://localhost/", "facebookexternalhit/1.1;line-poker/1.0"))
+
+		// Then
+		assert.Equal(t, expected, w.Body.String())
+	})
+
+	t.Run("With successful rendering a category page for Slack crawler", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		parent := blog.Category{ID: primitive.NewObjectID(), Name: "Programming", Slug: "programming"}
+		cat := blog.Category{ID: primitive.NewObjectID(), Name: "Go", Parent: mongo.DBRef{ID: parent.ID}}
+		cat.Slug = "go-" + cat.ID.Hex()
+
+		categoryRepository.EXPECT().FindByID(gomock.Any(), cat.ID).Return(cat, nil)
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{parent, cat}, nil)
+
+		expected := `
+Nomkhonwaan
+http://localhost/category/programming/` + cat.Slug + `
+website
+Go - Nomkhonwaan
+All posts in Go
 http://localhost/assets/images/303589.webp
+
+
+
 `
 
 		// When
-		middleware(next).ServeHTTP(w, newFacebookCrawlerBot("http://localhost/"+now.Format("2006/1/2")+"/test-"+id.Hex()))
+		middleware(next).ServeHTTP(w, newCrawlerRequest("http://localhost/category/programming/"+cat.Slug+"/2",
+			"Slackbot-LinkExpanding 1.0 (+https://api.slack.com/robots)"))
 
 		// Then
 		assert.Equal(t, expected, w.Body.String())
 	})
 
-	t.Run("When accessing to non single page", func(t *testing.T) {
+	t.Run("With successful rendering a tag page for Discord crawler", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		tag := blog.Tag{ID: primitive.NewObjectID(), Name: "Go"}
+		tag.Slug = "go-" + tag.ID.Hex()
+
+		tagRepository.EXPECT().FindByID(gomock.Any(), tag.ID).Return(tag, nil)
+
+		expected := `
+Nomkhonwaan
+http://localhost/tag/` + tag.Slug + `
+website
+Go - Nomkhonwaan
+All posts tagged Go
+http://localhost/assets/images/303589.webp
+
+
+
+`
+
+		// When
+		middleware(next).ServeHTTP(w, newCrawlerRequest("http://localhost/tag/"+tag.Slug, "Mozilla/5.0 (compatible; Discordbot/2.0; +https://discordapp.com)"))
+
+		// Then
+		assert.Equal(t, expected, w.Body.String())
+	})
+
+	t.Run("When accessing by a browser", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		// When
+		middleware(next).ServeHTTP(w, newCrawlerRequest("http://localhost/", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_4)"))
+
+		// Then
+		assert.Equal(t, "OK", w.Body.String())
+		assert.Equal(t, "User-Agent", w.Header().Get("Vary"))
+	})
+
+	t.Run("When accessing to a page without opengraph metadata", func(t *testing.T) {
 		// Given
 		w := httptest.NewRecorder()
 
 		// When
-		middleware(next).ServeHTTP(w, newFacebookCrawlerBot("http://localhost"))
+		middleware(next).ServeHTTP(w, newCrawlerRequest("http://localhost/my-posts", "facebookexternalhit/1.1"))
 
 		// Then
 		assert.Equal(t, "OK", w.Body.String())
 	})
 
+	t.Run("When accessing to a draft post", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		id := primitive.NewObjectID()
+
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{ID: id, Status: blog.StatusDraft}, nil)
+
+		// When
+		middleware(next).ServeHTTP(w, newCrawlerRequest("http://localhost/2020/3/29/test-"+id.Hex(), "facebookexternalhit/1.1"))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("When the post does not exist", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		id := primitive.NewObjectID()
+
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{}, mgo.ErrNoDocuments)
+
+		// When
+		middleware(next).ServeHTTP(w, newCrawlerRequest("http://localhost/2006/1/2/test-"+id.Hex(), "facebookexternalhit/1.1"))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
 	t.Run("When unable to find a post", func(t *testing.T) {
 		// Given
 		w := httptest.NewRecorder()
+		id := primitive.NewObjectID()
+
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{}, errors.New("test unable to find a post"))
+
+		// When
+		middleware(next).ServeHTTP(w, newCrawlerRequest("http://localhost/2006/1/2/test-"+id.Hex(), "facebookexternalhit/1.1"))
+
+		// Then
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("With invalid slug", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
 
-		postRepository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(blog.Post{}, errors.New("test unable to find a post"))
+		// When
+		middleware(next).ServeHTTP(w, newCrawlerRequest("http://localhost/tag/go", "Twitterbot/1.0"))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestServePrerenderedPageMiddleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		cache              = mock_storage.NewMockCache(ctrl)
+		categoryRepository = mock_blog.NewMockCategoryRepository(ctrl)
+		tagRepository      = mock_blog.NewMockTagRepository(ctrl)
+		postRepository     = mock_blog.NewMockPostRepository(ctrl)
+		fileRepository     = mock_storage.NewMockFileRepository(ctrl)
+	)
+
+	tmpl := template.Must(template.New("test-prerender-template").Parse(`
+{{define "post"}}{{.Page.Title}}|{{.Post.Title}}|{{.HTML}}|{{range .Categories}}{{.Name}}={{.Path}},{{end}}|{{range .Tags}}{{.Name}}={{.Path}},{{end}}{{end}}
+{{define "list"}}{{.Page.URL}}|{{.Heading}}|{{range .Posts}}{{.Title}},{{end}}|{{.Prev}}|{{.Next}}{{end}}
+`))
+	middleware := ServePrerenderedPageMiddleware(DefaultBots, cache, Prerenderer{
+		Renderer: Renderer{
+			BaseURL:            "http://localhost",
+			SiteName:           "Nomkhonwaan",
+			CategoryRepository: categoryRepository,
+			TagRepository:      tagRepository,
+			PostRepository:     postRepository,
+			FileRepository:     fileRepository,
+		},
+		Template:     tmpl,
+		ItemsPerPage: 2,
+	})
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("OK"))
+	})
+
+	newBotRequest := func(url string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)")
+		return req
+	}
+
+	publishedAt := time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC)
+
+	t.Run("With successful prerendering a post page", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		id := primitive.NewObjectID()
+		cat := blog.Category{ID: primitive.NewObjectID(), Name: "Go"}
+		cat.Slug = "go-" + cat.ID.Hex()
+		tag := blog.Tag{ID: primitive.NewObjectID(), Name: "Concurrency"}
+		tag.Slug = "concurrency-" + tag.ID.Hex()
+		p := blog.Post{ID: id, Title: "Test", Slug: "test-" + id.Hex(), Status: blog.StatusPublished,
+			HTML: "<p>Lorem ipsum</p>", PublishedAt: publishedAt,
+			Categories: []mongo.DBRef{{ID: cat.ID}}, Tags: []mongo.DBRef{{ID: tag.ID}}}
+
+		cache.EXPECT().Exists("prerender/post/" + id.Hex() + ".html").Return(false)
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(p, nil)
+		tagRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{tag.ID}).Return([]blog.Tag{tag}, nil)
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{cat}, nil)
+		cache.EXPECT().Store(gomock.Any(), "prerender/post/"+id.Hex()+".html").Return(nil)
+
+		expected := "Test|Test|<p>Lorem ipsum</p>|Go=/category/" + cat.Slug + ",|Concurrency=/tag/" + tag.Slug + ","
+
+		// When
+		middleware(next).ServeHTTP(w, newBotRequest("http://localhost/2020/3/29/test-"+id.Hex()))
+
+		// Then
+		assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "User-Agent", w.Header().Get("Vary"))
+		assert.Equal(t, expected, w.Body.String())
+	})
+
+	t.Run("With successful retrieving a prerendered page from the cache", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		id := primitive.NewObjectID()
+
+		cache.EXPECT().Exists("prerender/post/" + id.Hex() + ".html").Return(true)
+		cache.EXPECT().Retrieve("prerender/post/"+id.Hex()+".html").Return(ioutil.NopCloser(strings.NewReader("cached")), nil)
+
+		// When
+		middleware(next).ServeHTTP(w, newBotRequest("http://localhost/2020/3/29/test-"+id.Hex()))
+
+		// Then
+		assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "cached", w.Body.String())
+	})
+
+	t.Run("With successful prerendering the home page", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("prerender/home/1.html").Return(false)
+		postRepository.EXPECT().FindAll(gomock.Any(), blog.NewPostQueryBuilder().WithStatus(blog.StatusPublished).
+			WithOffset(0).WithLimit(3).Build()).Return([]blog.Post{{Title: "A"}, {Title: "B"}, {Title: "C"}}, nil)
+		cache.EXPECT().Store(gomock.Any(), "prerender/home/1.html").Return(nil)
+
+		// When
+		middleware(next).ServeHTTP(w, newBotRequest("http://localhost/"))
+
+		// Then
+		assert.Equal(t, "http://localhost||A,B,||/2", w.Body.String())
+	})
+
+	t.Run("With successful prerendering the second page of a category", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		parent := blog.Category{ID: primitive.NewObjectID(), Name: "Programming"}
+		parent.Slug = "programming-" + parent.ID.Hex()
+		cat := blog.Category{ID: primitive.NewObjectID(), Name: "Go", Parent: mongo.DBRef{ID: parent.ID}}
+		cat.Slug = "go-" + cat.ID.Hex()
+		child := blog.Category{ID: primitive.NewObjectID(), Name: "Concurrency", Parent: mongo.DBRef{ID: cat.ID}}
+		basePath := "/category/" + parent.Slug + "/" + cat.Slug
+
+		cache.EXPECT().Exists("prerender/category/" + cat.ID.Hex() + "/2.html").Return(false)
+		categoryRepository.EXPECT().FindByID(gomock.Any(), cat.ID).Return(cat, nil)
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{parent, cat, child}, nil)
+		postRepository.EXPECT().FindAll(gomock.Any(), blog.NewPostQueryBuilder().WithCategory(cat, child).
+			WithStatus(blog.StatusPublished).WithOffset(2).WithLimit(3).Build()).Return([]blog.Post{{Title: "C"}}, nil)
+		cache.EXPECT().Store(gomock.Any(), "prerender/category/"+cat.ID.Hex()+"/2.html").Return(nil)
+
+		expected := "http://localhost" + basePath + "/2|Go|C,|" + basePath + "/1|"
+
+		// When
+		middleware(next).ServeHTTP(w, newBotRequest("http://localhost"+basePath+"/2"))
+
+		// Then
+		assert.Equal(t, expected, w.Body.String())
+	})
+
+	t.Run("With successful prerendering a tag page", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		tag := blog.Tag{ID: primitive.NewObjectID(), Name: "Go"}
+		tag.Slug = "go-" + tag.ID.Hex()
+
+		cache.EXPECT().Exists("prerender/tag/" + tag.ID.Hex() + "/1.html").Return(false)
+		tagRepository.EXPECT().FindByID(gomock.Any(), tag.ID).Return(tag, nil)
+		postRepository.EXPECT().FindAll(gomock.Any(), blog.NewPostQueryBuilder().WithTag(tag).
+			WithStatus(blog.StatusPublished).WithOffset(0).WithLimit(3).Build()).Return([]blog.Post{{Title: "A"}}, nil)
+		cache.EXPECT().Store(gomock.Any(), "prerender/tag/"+tag.ID.Hex()+"/1.html").Return(errors.New("test unable to store"))
+
+		// When
+		middleware(next).ServeHTTP(w, newBotRequest("http://localhost/tag/"+tag.Slug))
+
+		// Then
+		assert.Equal(t, "http://localhost/tag/"+tag.Slug+"|#Go|A,||", w.Body.String())
+	})
+
+	t.Run("When the listing page is out of range", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("prerender/home/9.html").Return(false)
+		postRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		// When
+		middleware(next).ServeHTTP(w, newBotRequest("http://localhost/9"))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("When accessing to a draft post", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		id := primitive.NewObjectID()
+
+		cache.EXPECT().Exists("prerender/post/" + id.Hex() + ".html").Return(false)
+		postRepository.EXPECT().FindByID(gomock.Any(), id).Return(blog.Post{ID: id, Status: blog.StatusDraft}, nil)
+
+		// When
+		middleware(next).ServeHTTP(w, newBotRequest("http://localhost/2020/3/29/test-"+id.Hex()))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("When unable to find the posts of the listing page", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("prerender/home/1.html").Return(false)
+		postRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, errors.New("test unable to find all posts"))
+
+		// When
+		middleware(next).ServeHTTP(w, newBotRequest("http://localhost/"))
+
+		// Then
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("When accessing to a page which cannot be prerendered", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		// When
+		middleware(next).ServeHTTP(w, newBotRequest("http://localhost/author/github%7C1"))
+
+		// Then
+		assert.Equal(t, "OK", w.Body.String())
+	})
+
+	t.Run("When accessing by a browser", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "http://localhost/", nil)
+		req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_4)")
+
+		// When
+		middleware(next).ServeHTTP(w, req)
+
+		// Then
+		assert.Equal(t, "OK", w.Body.String())
+	})
+
+	t.Run("With invalid slug", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
 
 		// When
-		middleware(next).ServeHTTP(w, newFacebookCrawlerBot("http://localhost/2006/1/2/test-1"))
+		middleware(next).ServeHTTP(w, newBotRequest("http://localhost/tag/go"))
 
 		// Then
-		assert.Equal(t, "Not Found\n", w.Body.String())
+		assert.Equal(t, http.StatusNotFound, w.Code)
 	})
 }
@@ -0,0 +1,280 @@
+package opengraph
+
+import (
+	"context"
+	"errors"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/oembed"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mgo "go.mongodb.org/mongo-driver/mongo"
+	"html/template"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	singlePageRegExp   = regexp.MustCompile(`^/\d{4}/\d{1,2}/\d{1,2}/([^/]+)$`)
+	categoryPageRegExp = regexp.MustCompile(`^/category/(?:[^/]+/)*?([^/]+)(?:/(\d+))?$`)
+	tagPageRegExp      = regexp.MustCompile(`^/tag/([^/]+)(?:/(\d+))?$`)
+
+	// errNoPage is returned when the URL path does not belong to any page which has opengraph metadata
+	errNoPage = errors.New("no opengraph page")
+
+	// errNotFound is returned when the content of the page does not exist or is not published yet
+	errNotFound = errors.New("not found")
+)
+
+const (
+	defaultImagePath = "/assets/images/303589.webp"
+	storageURLPath   = "/api/v2.1/storage/"
+)
+
+// Page is an opengraph metadata of a single page
+type Page struct {
+	// Name of the site which the page belongs to
+	SiteName string
+
+	// Canonical URL of the page
+	URL string
+
+	// Type of the page, e.g. "website", "article" or "profile"
+	Type string
+
+	Title       string
+	Description string
+
+	// URL of the image which represents the page, always has a value
+	FeaturedImage string
+
+	// Date-time in RFC 3339 format that the article was first published, empty for other types
+	PublishedTime string
+
+	// Date-time in RFC 3339 format that the article was last changed, empty for other types
+	ModifiedTime string
+
+	// Name of all tags of the article
+	Tags []string
+
+	// List of schema.org structured data which will be rendered in JSON-LD format
+	StructuredData []interface{}
+
+	// Discovery links of the oEmbed endpoint, only available on the article
+	Alternates []Alternate
+}
+
+// Alternate is an alternate representation of the page which is rendered as the link element
+type Alternate struct {
+	// A MIME type of the representation
+	Type string
+
+	URL string
+}
+
+// Renderer finds the content which is shown on the requested URL path and renders its opengraph metadata
+type Renderer struct {
+	// A base URL of the blog which uses for composing canonical URLs
+	BaseURL string
+
+	// Name of the site which will be shown on the home page and every page title
+	SiteName string
+
+	// A short description of the site which will be shown on the home page
+	Description string
+
+	// Name of the author which will be shown on the cover image and the structured data
+	AuthorName string
+
+	// A URL template of the site search which contains the "{search_term_string}" placeholder,
+	// the WebSite structured data has no SearchAction if this is empty
+	SearchURLTemplate string
+
+	// A template which renders the Page
+	Template *template.Template
+
+	CategoryRepository blog.CategoryRepository
+	TagRepository      blog.TagRepository
+	PostRepository     blog.PostRepository
+	FileRepository     storage.FileRepository
+}
+
+// Page returns an opengraph metadata of the home, post, category or tag page,
+// returns errNoPage if the path has no opengraph metadata or errNotFound if its content does not exist
+func (r Renderer) Page(ctx context.Context, path string) (Page, error) {
+	if path == "" || path == "/" {
+		return r.newHomePage(), nil
+	}
+	if m := singlePageRegExp.FindStringSubmatch(path); m != nil {
+		return r.postPage(ctx, m[1])
+	}
+	if m := categoryPageRegExp.FindStringSubmatch(path); m != nil {
+		return r.categoryPage(ctx, m[1])
+	}
+	if m := tagPageRegExp.FindStringSubmatch(path); m != nil {
+		return r.tagPage(ctx, m[1])
+	}
+	return Page{}, errNoPage
+}
+
+func (r Renderer) postPage(ctx context.Context, slug string) (Page, error) {
+	id, err := blog.GetIDFromSlug(slug)
+	if err != nil {
+		return Page{}, errNotFound
+	}
+
+	p, err := r.findPublishedPost(ctx, id)
+	if err != nil {
+		return Page{}, err
+	}
+
+	tags, tree, err := r.findTaxonomies(ctx, p)
+	if err != nil {
+		return Page{}, err
+	}
+
+	return r.newPostPage(ctx, p, tags, tree), nil
+}
+
+func (r Renderer) categoryPage(ctx context.Context, slug string) (Page, error) {
+	id, err := blog.GetIDFromSlug(slug)
+	if err != nil {
+		return Page{}, errNotFound
+	}
+
+	c, tree, err := r.findCategory(ctx, id)
+	if err != nil {
+		return Page{}, err
+	}
+
+	return r.newCategoryPage(c, tree), nil
+}
+
+func (r Renderer) tagPage(ctx context.Context, slug string) (Page, error) {
+	id, err := blog.GetIDFromSlug(slug)
+	if err != nil {
+		return Page{}, errNotFound
+	}
+
+	t, err := r.TagRepository.FindByID(ctx, id)
+	if err != nil {
+		return Page{}, err
+	}
+
+	return r.newTagPage(t), nil
+}
+
+// findPublishedPost returns errNotFound for the draft post which must not be exposed to the crawlers
+func (r Renderer) findPublishedPost(ctx context.Context, id primitive.ObjectID) (blog.Post, error) {
+	p, err := r.PostRepository.FindByID(ctx, id)
+	if err != nil {
+		return blog.Post{}, err
+	}
+	if !p.Status.IsPublished() {
+		return blog.Post{}, errNotFound
+	}
+	return p, nil
+}
+
+// isNotFound reports whether the error means the content does not exist, either from the renderer or the repositories
+func isNotFound(err error) bool {
+	return err == errNotFound || err == mgo.ErrNoDocuments
+}
+
+// findTaxonomies returns all tags of the post and the tree of all categories
+func (r Renderer) findTaxonomies(ctx context.Context, p blog.Post) ([]blog.Tag, blog.CategoryTree, error) {
+	var tags []blog.Tag
+	if len(p.Tags) > 0 {
+		ids := make([]primitive.ObjectID, len(p.Tags))
+		for i, t := range p.Tags {
+			ids[i] = t.ID
+		}
+
+		var err error
+		if tags, err = r.TagRepository.FindAllByIDs(ctx, ids); err != nil {
+			return nil, blog.CategoryTree{}, err
+		}
+	}
+
+	cats, err := r.CategoryRepository.FindAll(ctx)
+	if err != nil {
+		return nil, blog.CategoryTree{}, err
+	}
+
+	return tags, blog.NewCategoryTree(cats), nil
+}
+
+// findCategory returns the category and the tree of all categories
+func (r Renderer) findCategory(ctx context.Context, id primitive.ObjectID) (blog.Category, blog.CategoryTree, error) {
+	c, err := r.CategoryRepository.FindByID(ctx, id)
+	if err != nil {
+		return blog.Category{}, blog.CategoryTree{}, err
+	}
+
+	cats, err := r.CategoryRepository.FindAll(ctx)
+	if err != nil {
+		return blog.Category{}, blog.CategoryTree{}, err
+	}
+
+	return c, blog.NewCategoryTree(cats), nil
+}
+
+func (r Renderer) newHomePage() Page {
+	page := r.newPage("website", r.SiteName, r.Description, r.BaseURL)
+	page.StructuredData = []interface{}{r.newWebSite()}
+	return page
+}
+
+func (r Renderer) newPostPage(ctx context.Context, p blog.Post, tags []blog.Tag, tree blog.CategoryTree) Page {
+	page := r.newPage("article", p.Title, strings.Split(p.Markdown, "\n")[0], r.BaseURL+p.Permalink())
+	page.PublishedTime = p.PublishedAt.Format(time.RFC3339)
+	if !p.UpdatedAt.IsZero() {
+		page.ModifiedTime = p.UpdatedAt.Format(time.RFC3339)
+	}
+
+	// The post without featured image is represented by its generated cover image
+	page.FeaturedImage = r.BaseURL + coverURLPath(p)
+	if !p.FeaturedImage.ID.IsZero() {
+		if f, err := r.FileRepository.FindByID(ctx, p.FeaturedImage.ID); err == nil {
+			page.FeaturedImage = r.BaseURL + storageURLPath + f.Slug
+		}
+	}
+
+	for _, t := range tags {
+		page.Tags = append(page.Tags, t.Name)
+	}
+
+	page.StructuredData = []interface{}{r.newBlogPosting(page, p, tree), r.newBreadcrumbList(tree.Breadcrumbs(p))}
+	for _, f := range oembed.Formats {
+		page.Alternates = append(page.Alternates, Alternate{Type: f.DiscoveryType(), URL: oembed.DiscoveryURL(r.BaseURL, page.URL, f)})
+	}
+	return page
+}
+
+func (r Renderer) newCategoryPage(c blog.Category, tree blog.CategoryTree) Page {
+	var breadcrumbs []blog.Breadcrumb
+	for _, a := range append(tree.Ancestors(c), c) {
+		breadcrumbs = append(breadcrumbs, blog.Breadcrumb{Name: a.Name, Path: tree.Permalink(a)})
+	}
+
+	page := r.newPage("website", c.Name+" - "+r.SiteName, "All posts in "+c.Name, r.BaseURL+tree.Permalink(c))
+	page.StructuredData = []interface{}{r.newBreadcrumbList(breadcrumbs)}
+	return page
+}
+
+func (r Renderer) newTagPage(t blog.Tag) Page {
+	page := r.newPage("website", t.Name+" - "+r.SiteName, "All posts tagged "+t.Name, r.BaseURL+"/tag/"+t.Slug)
+	page.StructuredData = []interface{}{r.newBreadcrumbList([]blog.Breadcrumb{{Name: t.Name, Path: "/tag/" + t.Slug}})}
+	return page
+}
+
+func (r Renderer) newPage(typ, title, description, canonicalURL string) Page {
+	return Page{
+		SiteName:      r.SiteName,
+		URL:           canonicalURL,
+		Type:          typ,
+		Title:         title,
+		Description:   description,
+		FeaturedImage: r.BaseURL + defaultImagePath,
+	}
+}
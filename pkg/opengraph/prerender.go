@@ -0,0 +1,238 @@
+package opengraph
+
+import (
+	"context"
+	"fmt"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"html/template"
+	"regexp"
+	"strconv"
+)
+
+var homePageRegExp = regexp.MustCompile(`^/(\d+)?$`)
+
+// DefaultBots contains user agents of the search engine crawlers which will be served the prerendered pages
+var DefaultBots = []string{
+	"Googlebot",
+	"bingbot",
+	"Slurp",
+	"DuckDuckBot",
+	"Baiduspider",
+	"YandexBot",
+	"Applebot",
+}
+
+// Prerenderer renders complete HTML of the home, post, category and tag pages for the bots which do not run JavaScript
+type Prerenderer struct {
+	// A renderer which provides the opengraph metadata of the page and finds its content
+	Renderer Renderer
+
+	// A template which defines "post" and "list" templates, the opengraph metadata is available as .Page
+	Template *template.Template
+
+	// Number of posts on each listing page, must be the same as the front-end
+	ItemsPerPage int
+}
+
+// prerenderedPage is a data of the "post" or "list" template
+type prerenderedPage struct {
+	Page Page
+
+	// The post and its taxonomies, only available on the "post" template
+	Post       blog.Post
+	HTML       template.HTML
+	Categories []link
+	Tags       []link
+
+	// Posts and pagination of the "list" template, previous and next URL paths are empty on the first and last page
+	Heading string
+	Posts   []blog.Post
+	Prev    string
+	Next    string
+}
+
+type link struct {
+	Name string
+	Path string
+}
+
+// route identifies the prerendered page by the content ID instead of the slug,
+// the same content may be accessed from many URL paths
+type route struct {
+	kind string
+	id   primitive.ObjectID
+	page int
+}
+
+// parseRoute returns errNoPage if the path cannot be prerendered or errNotFound if the slug or page number is invalid
+func parseRoute(path string) (route, error) {
+	if m := homePageRegExp.FindStringSubmatch(path); m != nil {
+		return newRoute("home", primitive.NilObjectID, m[1])
+	}
+	if m := singlePageRegExp.FindStringSubmatch(path); m != nil {
+		id, err := blog.GetIDFromSlug(m[1])
+		if err != nil {
+			return route{}, errNotFound
+		}
+		return route{kind: "post", id: id}, nil
+	}
+	if m := categoryPageRegExp.FindStringSubmatch(path); m != nil {
+		id, err := blog.GetIDFromSlug(m[1])
+		if err != nil {
+			return route{}, errNotFound
+		}
+		return newRoute("category", id, m[2])
+	}
+	if m := tagPageRegExp.FindStringSubmatch(path); m != nil {
+		id, err := blog.GetIDFromSlug(m[1])
+		if err != nil {
+			return route{}, errNotFound
+		}
+		return newRoute("tag", id, m[2])
+	}
+	return route{}, errNoPage
+}
+
+// newRoute returns a route of the listing page, the first page is used if the page number is empty
+func newRoute(kind string, id primitive.ObjectID, page string) (route, error) {
+	if page == "" {
+		return route{kind: kind, id: id, page: 1}, nil
+	}
+
+	n, err := strconv.Atoi(page)
+	if err != nil || n < 1 {
+		return route{}, errNotFound
+	}
+	return route{kind: kind, id: id, page: n}, nil
+}
+
+// cacheFilePath returns a path on the cache where the prerendered page is stored
+func (rt route) cacheFilePath() string {
+	switch rt.kind {
+	case "home":
+		return homeCacheFilePath(rt.page)
+	case "post":
+		return postCacheFilePath(rt.id)
+	case "category":
+		return categoryCacheFilePath(rt.id, rt.page)
+	default:
+		return tagCacheFilePath(rt.id, rt.page)
+	}
+}
+
+func homeCacheFilePath(page int) string {
+	return fmt.Sprintf("prerender/home/%d.html", page)
+}
+
+func postCacheFilePath(id primitive.ObjectID) string {
+	return fmt.Sprintf("prerender/post/%s.html", id.Hex())
+}
+
+func categoryCacheFilePath(id primitive.ObjectID, page int) string {
+	return fmt.Sprintf("prerender/category/%s/%d.html", id.Hex(), page)
+}
+
+func tagCacheFilePath(id primitive.ObjectID, page int) string {
+	return fmt.Sprintf("prerender/tag/%s/%d.html", id.Hex(), page)
+}
+
+// page returns the template name and its data of the route, returns errNotFound if the post is not published yet
+func (p Prerenderer) page(ctx context.Context, rt route) (string, prerenderedPage, error) {
+	switch rt.kind {
+	case "home":
+		data, err := p.home(ctx, rt.page)
+		return "list", data, err
+	case "post":
+		data, err := p.post(ctx, rt.id)
+		return "post", data, err
+	case "category":
+		data, err := p.category(ctx, rt.id, rt.page)
+		return "list", data, err
+	default:
+		data, err := p.tag(ctx, rt.id, rt.page)
+		return "list", data, err
+	}
+}
+
+func (p Prerenderer) home(ctx context.Context, page int) (prerenderedPage, error) {
+	data := prerenderedPage{Page: p.Renderer.newHomePage()}
+	err := p.list(ctx, &data, blog.NewPostQueryBuilder(), "", page)
+	return data, err
+}
+
+func (p Prerenderer) post(ctx context.Context, id primitive.ObjectID) (prerenderedPage, error) {
+	post, err := p.Renderer.findPublishedPost(ctx, id)
+	if err != nil {
+		return prerenderedPage{}, err
+	}
+
+	tags, tree, err := p.Renderer.findTaxonomies(ctx, post)
+	if err != nil {
+		return prerenderedPage{}, err
+	}
+
+	data := prerenderedPage{
+		Page: p.Renderer.newPostPage(ctx, post, tags, tree),
+		Post: post,
+		HTML: template.HTML(post.HTML),
+	}
+	for _, ref := range post.Categories {
+		if c, ok := tree.Get(ref.ID); ok {
+			data.Categories = append(data.Categories, link{Name: c.Name, Path: tree.Permalink(c)})
+		}
+	}
+	for _, t := range tags {
+		data.Tags = append(data.Tags, link{Name: t.Name, Path: "/tag/" + t.Slug})
+	}
+
+	return data, nil
+}
+
+func (p Prerenderer) category(ctx context.Context, id primitive.ObjectID, page int) (prerenderedPage, error) {
+	c, tree, err := p.Renderer.findCategory(ctx, id)
+	if err != nil {
+		return prerenderedPage{}, err
+	}
+
+	data := prerenderedPage{Page: p.Renderer.newCategoryPage(c, tree), Heading: c.Name}
+	err = p.list(ctx, &data, blog.NewPostQueryBuilder().WithCategory(c, tree.Descendants(c)...), tree.Permalink(c), page)
+	return data, err
+}
+
+func (p Prerenderer) tag(ctx context.Context, id primitive.ObjectID, page int) (prerenderedPage, error) {
+	t, err := p.Renderer.TagRepository.FindByID(ctx, id)
+	if err != nil {
+		return prerenderedPage{}, err
+	}
+
+	data := prerenderedPage{Page: p.Renderer.newTagPage(t), Heading: "#" + t.Name}
+	err = p.list(ctx, &data, blog.NewPostQueryBuilder().WithTag(t), "/tag/"+t.Slug, page)
+	return data, err
+}
+
+// list finds the published posts on the page of the listing under the base path, e.g. "/tag/go-{id}/2",
+// and sets the canonical URL of the page which is not the first page
+func (p Prerenderer) list(ctx context.Context, data *prerenderedPage, qb *blog.PostQueryBuilder, basePath string, page int) error {
+	// Find one more post for checking whether the next page exists
+	posts, err := p.Renderer.PostRepository.FindAll(ctx, qb.WithStatus(blog.StatusPublished).
+		WithOffset(int64((page-1)*p.ItemsPerPage)).WithLimit(int64(p.ItemsPerPage+1)).Build())
+	if err != nil {
+		return err
+	}
+	if page > 1 && len(posts) == 0 {
+		return errNotFound
+	}
+
+	if len(posts) > p.ItemsPerPage {
+		posts = posts[:p.ItemsPerPage]
+		data.Next = basePath + "/" + strconv.Itoa(page+1)
+	}
+	if page > 1 {
+		data.Prev = basePath + "/" + strconv.Itoa(page-1)
+		data.Page.URL = p.Renderer.BaseURL + basePath + "/" + strconv.Itoa(page)
+	}
+	data.Posts = posts
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package opengraph
+
+import (
+	"context"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Subscribe registers handlers on the bus which invalidate the prerendered pages and the cover image of the post, the home page,
+// and all categories including their ancestors and tags of the post whenever the list of published posts could be changed.
+// The categories and tags which have been removed from the published post are invalidated as well.
+// The listing pages after the first page which has not been cached and the post pages which link to the moved category
+// are left to be expired by the cache.
+func Subscribe(bus eventbus.Bus, cache storage.Cache, categoryRepository blog.CategoryRepository) {
+	remove := func(e eventbus.Event, filePath string) {
+		if err := cache.Delete(filePath); err != nil {
+			logrus.Errorf("unable to invalidate %s on the event %s: %s", filePath, e.EventName(), err)
+		}
+	}
+	removeCategories := func(ctx context.Context, e eventbus.Event, ids []primitive.ObjectID) {
+		cats, err := categoryRepository.FindAll(ctx)
+		if err != nil {
+			logrus.Errorf("unable to find all categories on the event %s: %s", e.EventName(), err)
+		}
+		tree := blog.NewCategoryTree(cats)
+
+		for _, id := range ids {
+			c, ok := tree.Get(id)
+			if !ok {
+				c = blog.Category{ID: id}
+			}
+			for _, a := range append(tree.Ancestors(c), c) {
+				for page := 1; cache.Exists(categoryCacheFilePath(a.ID, page)); page++ {
+					remove(e, categoryCacheFilePath(a.ID, page))
+				}
+			}
+		}
+	}
+	invalidate := func(ctx context.Context, e eventbus.Event, p blog.Post, previous ...blog.Post) {
+		for _, filePath := range []string{postCacheFilePath(p.ID), coverCacheFilePath(p.ID)} {
+			if cache.Exists(filePath) {
+				remove(e, filePath)
+			}
+		}
+		for page := 1; cache.Exists(homeCacheFilePath(page)); page++ {
+			remove(e, homeCacheFilePath(page))
+		}
+
+		var (
+			categoryIDs, tagIDs []primitive.ObjectID
+			seen                = make(map[primitive.ObjectID]bool)
+		)
+		for _, p := range append([]blog.Post{p}, previous...) {
+			for _, c := range p.Categories {
+				if !seen[c.ID] {
+					categoryIDs, seen[c.ID] = append(categoryIDs, c.ID), true
+				}
+			}
+			for _, t := range p.Tags {
+				if !seen[t.ID] {
+					tagIDs, seen[t.ID] = append(tagIDs, t.ID), true
+				}
+			}
+		}
+
+		for _, id := range tagIDs {
+			for page := 1; cache.Exists(tagCacheFilePath(id, page)); page++ {
+				remove(e, tagCacheFilePath(id, page))
+			}
+		}
+		removeCategories(ctx, e, categoryIDs)
+	}
+
+	bus.Subscribe(blog.PostPublished{}, func(ctx context.Context, e eventbus.Event) {
+		invalidate(ctx, e, e.(blog.PostPublished).Post)
+	})
+	bus.Subscribe(blog.PostUnpublished{}, func(ctx context.Context, e eventbus.Event) {
+		invalidate(ctx, e, e.(blog.PostUnpublished).Post)
+	})
+	bus.Subscribe(blog.PostContentChanged{}, func(ctx context.Context, e eventbus.Event) {
+		if evt := e.(blog.PostContentChanged); evt.Post.Status.IsPublished() {
+			invalidate(ctx, e, evt.Post, evt.Previous)
+		}
+	})
+	bus.Subscribe(blog.CategoryParentChanged{}, func(ctx context.Context, e eventbus.Event) {
+		removeCategories(ctx, e, []primitive.ObjectID{e.(blog.CategoryParentChanged).Category.ID})
+	})
+}
@@ -0,0 +1,105 @@
+package opengraph
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	mock_blog "github.com/nomkhonwaan/myblog/pkg/blog/mock"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+)
+
+func TestSubscribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		cache              = mock_storage.NewMockCache(ctrl)
+		categoryRepository = mock_blog.NewMockCategoryRepository(ctrl)
+	)
+
+	bus := eventbus.NewLocalBus()
+	Subscribe(bus, cache, categoryRepository)
+
+	parent := blog.Category{ID: primitive.NewObjectID()}
+	cat := blog.Category{ID: primitive.NewObjectID(), Parent: mongo.DBRef{ID: parent.ID}}
+	tagID := primitive.NewObjectID()
+	p := blog.Post{ID: primitive.NewObjectID(), Status: blog.StatusPublished,
+		Categories: []mongo.DBRef{{ID: cat.ID}}, Tags: []mongo.DBRef{{ID: tagID}}}
+
+	t.Run("With successful invalidating all prerendered pages and the cover image of the post when it has been published", func(t *testing.T) {
+		// Given
+		cache.EXPECT().Exists(postCacheFilePath(p.ID)).Return(true)
+		cache.EXPECT().Delete(postCacheFilePath(p.ID)).Return(nil)
+		cache.EXPECT().Exists(coverCacheFilePath(p.ID)).Return(true)
+		cache.EXPECT().Delete(coverCacheFilePath(p.ID)).Return(nil)
+		cache.EXPECT().Exists(homeCacheFilePath(1)).Return(true)
+		cache.EXPECT().Delete(homeCacheFilePath(1)).Return(nil)
+		cache.EXPECT().Exists(homeCacheFilePath(2)).Return(true)
+		cache.EXPECT().Delete(homeCacheFilePath(2)).Return(nil)
+		cache.EXPECT().Exists(homeCacheFilePath(3)).Return(false)
+		cache.EXPECT().Exists(tagCacheFilePath(tagID, 1)).Return(true)
+		cache.EXPECT().Delete(tagCacheFilePath(tagID, 1)).Return(nil)
+		cache.EXPECT().Exists(tagCacheFilePath(tagID, 2)).Return(false)
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{parent, cat}, nil)
+		cache.EXPECT().Exists(categoryCacheFilePath(parent.ID, 1)).Return(true)
+		cache.EXPECT().Delete(categoryCacheFilePath(parent.ID, 1)).Return(nil)
+		cache.EXPECT().Exists(categoryCacheFilePath(parent.ID, 2)).Return(false)
+		cache.EXPECT().Exists(categoryCacheFilePath(cat.ID, 1)).Return(true)
+		cache.EXPECT().Delete(categoryCacheFilePath(cat.ID, 1)).Return(errors.New("test unable to delete"))
+		cache.EXPECT().Exists(categoryCacheFilePath(cat.ID, 2)).Return(false)
+
+		// When
+		bus.Publish(context.Background(), blog.PostPublished{Post: p})
+
+		// Then
+	})
+
+	t.Run("With successful invalidating the prerendered pages of the category when its parent has been changed", func(t *testing.T) {
+		// Given
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return(nil, errors.New("test unable to find all categories"))
+		cache.EXPECT().Exists(categoryCacheFilePath(cat.ID, 1)).Return(true)
+		cache.EXPECT().Delete(categoryCacheFilePath(cat.ID, 1)).Return(nil)
+		cache.EXPECT().Exists(categoryCacheFilePath(cat.ID, 2)).Return(false)
+
+		// When
+		bus.Publish(context.Background(), blog.CategoryParentChanged{Category: cat})
+
+		// Then
+	})
+
+	t.Run("With successful invalidating the prerendered pages of the removed category and tag", func(t *testing.T) {
+		// Given
+		changed := blog.Post{ID: p.ID, Status: blog.StatusPublished}
+
+		cache.EXPECT().Exists(postCacheFilePath(p.ID)).Return(false)
+		cache.EXPECT().Exists(coverCacheFilePath(p.ID)).Return(false)
+		cache.EXPECT().Exists(homeCacheFilePath(1)).Return(false)
+		cache.EXPECT().Exists(tagCacheFilePath(tagID, 1)).Return(true)
+		cache.EXPECT().Delete(tagCacheFilePath(tagID, 1)).Return(nil)
+		cache.EXPECT().Exists(tagCacheFilePath(tagID, 2)).Return(false)
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{parent, cat}, nil)
+		cache.EXPECT().Exists(categoryCacheFilePath(parent.ID, 1)).Return(false)
+		cache.EXPECT().Exists(categoryCacheFilePath(cat.ID, 1)).Return(true)
+		cache.EXPECT().Delete(categoryCacheFilePath(cat.ID, 1)).Return(nil)
+		cache.EXPECT().Exists(categoryCacheFilePath(cat.ID, 2)).Return(false)
+
+		// When
+		bus.Publish(context.Background(), blog.PostContentChanged{Post: changed, Previous: p})
+
+		// Then
+	})
+
+	t.Run("When a draft post has been changed", func(t *testing.T) {
+		// Given
+
+		// When
+		bus.Publish(context.Background(), blog.PostContentChanged{Post: blog.Post{Status: blog.StatusDraft}})
+
+		// Then
+	})
+}
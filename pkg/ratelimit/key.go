@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"github.com/nomkhonwaan/myblog/pkg/auth"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// KeyFunc returns an identity of the client who sends the request
+type KeyFunc func(r *http.Request) string
+
+// NewKeyFunc returns a KeyFunc which identifies the client with the "sub" claim of the authorization token,
+// an anonymous client will be identified with its IP address instead
+func NewKeyFunc(trustedProxies []*net.IPNet) KeyFunc {
+	return func(r *http.Request) string {
+		if sub, ok := auth.GetAuthorizedUserID(r.Context()).(string); ok && sub != "" {
+			return "sub:" + sub
+		}
+		return "ip:" + ClientIP(r, trustedProxies)
+	}
+}
+
+// ParseTrustedProxies parses list of IP addresses or CIDR blocks of the reverse proxies in front of the server
+func ParseTrustedProxies(values []string) ([]*net.IPNet, error) {
+	var proxies []*net.IPNet
+	for _, value := range values {
+		if !strings.Contains(value, "/") {
+			if ip := net.ParseIP(value); ip != nil && ip.To4() != nil {
+				value += "/32"
+			} else {
+				value += "/128"
+			}
+		}
+
+		_, ipNet, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, err
+		}
+		proxies = append(proxies, ipNet)
+	}
+	return proxies, nil
+}
+
+// ClientIP returns an IP address of the client who sends the request.
+//
+// The "X-Forwarded-For" header is only trusted when the request comes from the trusted proxies,
+// the header will be read from right to left until the first address which is not a trusted proxy.
+func ClientIP(r *http.Request, trustedProxies []*net.IPNet) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !isTrustedProxy(ip, trustedProxies) {
+		return host
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr := net.ParseIP(strings.TrimSpace(forwarded[i]))
+		if addr == nil {
+			break
+		}
+
+		ip = addr
+		if !isTrustedProxy(ip, trustedProxies) {
+			break
+		}
+	}
+	return ip.String()
+}
+
+func isTrustedProxy(ip net.IP, trustedProxies []*net.IPNet) bool {
+	for _, ipNet := range trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/nomkhonwaan/myblog/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewKeyFunc(t *testing.T) {
+	keyFunc := NewKeyFunc(nil)
+
+	t.Run("With authorized request", func(t *testing.T) {
+		// Given
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r = r.WithContext(context.WithValue(r.Context(), auth.UserProperty, &jwt.Token{Claims: jwt.MapClaims{"sub": "authorizedID"}}))
+
+		// When
+		key := keyFunc(r)
+
+		// Then
+		assert.Equal(t, "sub:authorizedID", key)
+	})
+
+	t.Run("With anonymous request", func(t *testing.T) {
+		// Given
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = "192.0.2.10:51234"
+
+		// When
+		key := keyFunc(r)
+
+		// Then
+		assert.Equal(t, "ip:192.0.2.10", key)
+	})
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	t.Run("With IP addresses and CIDR blocks", func(t *testing.T) {
+		// Given
+
+		// When
+		proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.0.2.1", "::1"})
+
+		// Then
+		assert.Nil(t, err)
+		assert.Len(t, proxies, 3)
+		assert.Equal(t, "10.0.0.0/8", proxies[0].String())
+		assert.Equal(t, "192.0.2.1/32", proxies[1].String())
+		assert.Equal(t, "::1/128", proxies[2].String())
+	})
+
+	t.Run("With invalid IP address", func(t *testing.T) {
+		// Given
+
+		// When
+		_, err := ParseTrustedProxies([]string{"localhost"})
+
+		// Then
+		assert.NotNil(t, err)
+	})
+}
+
+func TestClientIP(t *testing.T) {
+	// Given
+	proxies, _ := ParseTrustedProxies([]string{"10.0.0.0/8"})
+
+	tests := map[string]struct {
+		remoteAddr    string
+		xForwardedFor []string
+		expected      string
+	}{
+		"With direct request": {
+			remoteAddr: "192.0.2.10:51234",
+			expected:   "192.0.2.10",
+		},
+		"With spoofed header from untrusted client": {
+			remoteAddr:    "192.0.2.10:51234",
+			xForwardedFor: []string{"198.51.100.1"},
+			expected:      "192.0.2.10",
+		},
+		"With request through the trusted proxy": {
+			remoteAddr:    "10.0.0.1:51234",
+			xForwardedFor: []string{"198.51.100.1, 192.0.2.10"},
+			expected:      "192.0.2.10",
+		},
+		"With request through multiple trusted proxies": {
+			remoteAddr:    "10.0.0.1:51234",
+			xForwardedFor: []string{"198.51.100.1, 192.0.2.10", "10.0.0.2"},
+			expected:      "192.0.2.10",
+		},
+		"With malformed header": {
+			remoteAddr:    "10.0.0.1:51234",
+			xForwardedFor: []string{"unknown, 10.0.0.2"},
+			expected:      "10.0.0.2",
+		},
+	}
+
+	// When
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = test.remoteAddr
+			for _, value := range test.xForwardedFor {
+				r.Header.Add("X-Forwarded-For", value)
+			}
+
+			// Then
+			assert.Equal(t, test.expected, ClientIP(r, proxies))
+		})
+	}
+}
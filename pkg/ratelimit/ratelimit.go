@@ -0,0 +1,94 @@
+package ratelimit
+
+import (
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Policy is a maximum number of requests which each client can send to the route group within the window
+type Policy struct {
+	// Name of the route group, counters of each policy are separated by this name
+	Name string
+
+	// Maximum number of requests in the window, a zero limit means no limit
+	Limit int
+
+	// Duration of the window which begins at the first request of the client
+	Window time.Duration
+}
+
+// ParsePolicy parses the "<limit>/<window>" string of the route group, e.g. "60/1m"
+func ParsePolicy(name, value string) (Policy, error) {
+	sl := strings.SplitN(value, "/", 2)
+	if len(sl) != 2 {
+		return Policy{}, fmt.Errorf("invalid rate limit policy %q of %s: must be in <limit>/<window> format", value, name)
+	}
+
+	limit, err := strconv.Atoi(sl[0])
+	if err != nil || limit < 0 {
+		return Policy{}, fmt.Errorf("invalid rate limit policy %q of %s: limit must be a non-negative integer", value, name)
+	}
+
+	window, err := time.ParseDuration(sl[1])
+	if err != nil || window <= 0 {
+		return Policy{}, fmt.Errorf("invalid rate limit policy %q of %s: window must be a positive duration", value, name)
+	}
+
+	return Policy{Name: name, Limit: limit, Window: window}, nil
+}
+
+// Limiter limits number of requests of each client according to the policy of the route group
+type Limiter struct {
+	store   Store
+	keyFunc KeyFunc
+}
+
+// NewLimiter returns a new Limiter which counts requests on the store
+func NewLimiter(store Store, keyFunc KeyFunc) Limiter {
+	return Limiter{store: store, keyFunc: keyFunc}
+}
+
+// Middleware rejects the request which exceeds the policy with "429 Too Many Requests".
+//
+// All responses will have the "RateLimit-Limit", "RateLimit-Remaining" and "RateLimit-Reset" headers,
+// a rejected response will also have the "Retry-After" header.
+// The request will be allowed if unable to count it on the store.
+func (l Limiter) Middleware(p Policy) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if p.Limit <= 0 {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			count, resetAt, err := l.store.Increment(r.Context(), p.Name+":"+l.keyFunc(r), p.Window)
+			if err != nil {
+				logrus.Errorf("unable to count the request on the %s rate limit policy: %s", p.Name, err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			remaining := p.Limit - count
+			if remaining < 0 {
+				remaining = 0
+			}
+			reset := strconv.Itoa(int(math.Ceil(resetAt.Sub(time.Now()).Seconds())))
+
+			w.Header().Set("RateLimit-Limit", strconv.Itoa(p.Limit))
+			w.Header().Set("RateLimit-Remaining", strconv.Itoa(remaining))
+			w.Header().Set("RateLimit-Reset", reset)
+
+			if count > p.Limit {
+				w.Header().Set("Retry-After", reset)
+				http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package ratelimit
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	mock_ratelimit "github.com/nomkhonwaan/myblog/pkg/ratelimit/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/tkuchiki/faketime"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParsePolicy(t *testing.T) {
+	t.Run("With valid policy", func(t *testing.T) {
+		// Given
+
+		// When
+		p, err := ParsePolicy("api", "60/1m")
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, Policy{Name: "api", Limit: 60, Window: time.Minute}, p)
+	})
+
+	t.Run("With invalid policies", func(t *testing.T) {
+		// Given
+		values := []string{"60", "-1/1m", "sixty/1m", "60/1", "60/0s"}
+
+		// When
+		for _, value := range values {
+			_, err := ParsePolicy("api", value)
+
+			// Then
+			assert.NotNil(t, err, value)
+		}
+	})
+}
+
+func TestLimiter_Middleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2020, 6, 1, 9, 0, 0, 0, time.UTC)
+	f := faketime.NewFaketimeWithTime(now)
+	defer f.Undo()
+	f.Do()
+
+	var (
+		store = mock_ratelimit.NewMockStore(ctrl)
+	)
+
+	limiter := NewLimiter(store, func(r *http.Request) string { return "ip:192.0.2.10" })
+	policy := Policy{Name: "api", Limit: 2, Window: time.Minute}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	t.Run("With the request under the limit", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		store.EXPECT().Increment(gomock.Any(), "api:ip:192.0.2.10", time.Minute).Return(2, now.Add(time.Second*30), nil)
+
+		// When
+		limiter.Middleware(policy)(next).ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, "2", w.Header().Get("RateLimit-Limit"))
+		assert.Equal(t, "0", w.Header().Get("RateLimit-Remaining"))
+		assert.Equal(t, "30", w.Header().Get("RateLimit-Reset"))
+		assert.Equal(t, "", w.Header().Get("Retry-After"))
+	})
+
+	t.Run("When the request exceeds the limit", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		store.EXPECT().Increment(gomock.Any(), "api:ip:192.0.2.10", time.Minute).Return(3, now.Add(time.Second*30), nil)
+
+		// When
+		limiter.Middleware(policy)(next).ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "0", w.Header().Get("RateLimit-Remaining"))
+		assert.Equal(t, "30", w.Header().Get("Retry-After"))
+	})
+
+	t.Run("When unable to count the request", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		store.EXPECT().Increment(gomock.Any(), gomock.Any(), gomock.Any()).Return(0, time.Time{}, errors.New("test unable to count the request"))
+
+		// When
+		limiter.Middleware(policy)(next).ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, "", w.Header().Get("RateLimit-Limit"))
+	})
+
+	t.Run("With no limit", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		// When
+		limiter.Middleware(Policy{Name: "api"})(next).ServeHTTP(w, r)
+
+		// Then
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, "", w.Header().Get("RateLimit-Limit"))
+	})
+}
@@ -0,0 +1,68 @@
+//go:generate mockgen -destination=./mock/store_mock.go github.com/nomkhonwaan/myblog/pkg/ratelimit Store
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is an interval for removing counters of the expired windows from the MemoryStore
+const sweepInterval = time.Minute
+
+// A Store interface
+type Store interface {
+	// Increment counts a request of the key in the current window which begins at the first request of the key,
+	// returns a number of requests in the window along with the date-time that the window will be reset
+	Increment(ctx context.Context, key string, window time.Duration) (int, time.Time, error)
+}
+
+type counter struct {
+	count   int
+	resetAt time.Time
+}
+
+// MemoryStore implements Store interface in-process,
+// counters are not shared between multiple instances of the server
+type MemoryStore struct {
+	mu       sync.Mutex
+	counters map[string]*counter
+	sweptAt  time.Time
+}
+
+// NewMemoryStore returns a new MemoryStore instance
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{counters: make(map[string]*counter), sweptAt: time.Now()}
+}
+
+// Increment counts a request of the key in the current window
+func (s *MemoryStore) Increment(_ context.Context, key string, window time.Duration) (int, time.Time, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.sweptAt) >= sweepInterval {
+		s.sweep(now)
+	}
+
+	c, ok := s.counters[key]
+	if !ok || !now.Before(c.resetAt) {
+		c = &counter{resetAt: now.Add(window)}
+		s.counters[key] = c
+	}
+	c.count++
+
+	return c.count, c.resetAt, nil
+}
+
+// sweep removes all counters which their windows have been expired
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, c := range s.counters {
+		if !now.Before(c.resetAt) {
+			delete(s.counters, key)
+		}
+	}
+	s.sweptAt = now
+}
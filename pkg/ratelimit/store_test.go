@@ -0,0 +1,71 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/tkuchiki/faketime"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore_Increment(t *testing.T) {
+	now := time.Date(2020, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("With requests in the same window", func(t *testing.T) {
+		// Given
+		f := faketime.NewFaketimeWithTime(now)
+		defer f.Undo()
+		f.Do()
+
+		s := NewMemoryStore()
+		_, _, _ = s.Increment(context.Background(), "test:ip:127.0.0.1", time.Minute)
+
+		// When
+		count, resetAt, err := s.Increment(context.Background(), "test:ip:127.0.0.1", time.Minute)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, 2, count)
+		assert.Equal(t, now.Add(time.Minute), resetAt)
+	})
+
+	t.Run("With requests of different keys", func(t *testing.T) {
+		// Given
+		f := faketime.NewFaketimeWithTime(now)
+		defer f.Undo()
+		f.Do()
+
+		s := NewMemoryStore()
+		_, _, _ = s.Increment(context.Background(), "test:ip:127.0.0.1", time.Minute)
+
+		// When
+		count, _, err := s.Increment(context.Background(), "test:ip:127.0.0.2", time.Minute)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("When the window has been expired", func(t *testing.T) {
+		// Given
+		f := faketime.NewFaketimeWithTime(now)
+		f.Do()
+
+		s := NewMemoryStore()
+		_, _, _ = s.Increment(context.Background(), "test:ip:127.0.0.1", time.Minute)
+		f.Undo()
+
+		f = faketime.NewFaketimeWithTime(now.Add(time.Minute))
+		defer f.Undo()
+		f.Do()
+
+		// When
+		count, resetAt, err := s.Increment(context.Background(), "test:ip:127.0.0.1", time.Minute)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, 1, count)
+		assert.Equal(t, now.Add(time.Minute*2), resetAt)
+		assert.Len(t, s.counters, 1)
+	})
+}
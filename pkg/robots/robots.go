@@ -0,0 +1,38 @@
+package robots
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+)
+
+// Marshal returns the robots.txt file content which applies the allow and disallow rules to all user agents
+// and references the sitemap index file
+func Marshal(sitemapURL string, allows, disallows []string) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString("User-agent: *\n")
+	for _, path := range allows {
+		_, _ = fmt.Fprintf(&buf, "Allow: %s\n", path)
+	}
+	for _, path := range disallows {
+		_, _ = fmt.Fprintf(&buf, "Disallow: %s\n", path)
+	}
+	if len(allows) == 0 && len(disallows) == 0 {
+		// An empty disallow rule allows crawling the whole site, a group must have at least one rule
+		buf.WriteString("Disallow:\n")
+	}
+	_, _ = fmt.Fprintf(&buf, "\nSitemap: %s\n", sitemapURL)
+
+	return buf.Bytes()
+}
+
+// ServeRobotsHandlerFunc provides robots.txt file for search engine robot
+func ServeRobotsHandlerFunc(baseURL string, allows, disallows []string) http.HandlerFunc {
+	data := Marshal(baseURL+"/sitemap.xml", allows, disallows)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write(data)
+	}
+}
@@ -0,0 +1,57 @@
+package robots
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMarshal(t *testing.T) {
+	t.Run("With allow and disallow rules", func(t *testing.T) {
+		// Given
+		expected := "User-agent: *\n" +
+			"Allow: /api/v2.1/storage/\n" +
+			"Disallow: /graphiql\n" +
+			"Disallow: /api/\n" +
+			"\n" +
+			"Sitemap: http://localhost/sitemap.xml\n"
+
+		// When
+		data := Marshal("http://localhost/sitemap.xml", []string{"/api/v2.1/storage/"}, []string{"/graphiql", "/api/"})
+
+		// Then
+		assert.Equal(t, expected, string(data))
+	})
+
+	t.Run("Without any rule", func(t *testing.T) {
+		// Given
+		expected := "User-agent: *\n" +
+			"Disallow:\n" +
+			"\n" +
+			"Sitemap: http://localhost/sitemap.xml\n"
+
+		// When
+		data := Marshal("http://localhost/sitemap.xml", nil, nil)
+
+		// Then
+		assert.Equal(t, expected, string(data))
+	})
+}
+
+func TestServeRobotsHandlerFunc(t *testing.T) {
+	// Given
+	w := httptest.NewRecorder()
+	expected := "User-agent: *\n" +
+		"Disallow: /graphiql\n" +
+		"\n" +
+		"Sitemap: http://localhost/sitemap.xml\n"
+
+	// When
+	ServeRobotsHandlerFunc("http://localhost", nil, []string{"/graphiql"}).
+		ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/robots.txt", nil))
+
+	// Then
+	assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, expected, w.Body.String())
+}
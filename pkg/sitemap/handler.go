@@ -4,63 +4,187 @@ import (
 	"bytes"
 	"context"
 	"encoding/xml"
+	"errors"
+	"fmt"
+	"github.com/go-chi/chi"
 	"github.com/nomkhonwaan/myblog/pkg/blog"
 	"github.com/nomkhonwaan/myblog/pkg/storage"
-	"github.com/nomkhonwaan/myblog/pkg/timeutil"
 	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 )
 
+var errPageNotFound = errors.New("sitemap page not found")
+
 const (
 	cacheFilePath = "sitemap.xml"
+
+	// postsPerBatch is a number of posts per query when generating all post URLs
+	postsPerBatch = 1000
+
+	storageURLPath = "/api/v2.1/storage/"
 )
 
-// ServeSiteMapHandlerFunc provides sitemap.xml file for search engine robot
-func ServeSiteMapHandlerFunc(cache storage.Cache, genURLsFunc ...func() ([]URL, error)) http.HandlerFunc {
+// Section is a group of URLs which will be split into one or more child sitemap files of the sitemap index
+type Section struct {
+	// Name of the section which is a part of the child sitemap file names, must not contain a dash
+	Name string
+
+	GenerateURLs func() ([]URL, error)
+}
+
+// ServeSiteMapIndexHandlerFunc provides sitemap.xml file which references all child sitemap files of the sections,
+// a section which contains more than MaxURLsPerSiteMap URLs will be split into multiple child sitemap files
+func ServeSiteMapIndexHandlerFunc(baseURL string, cache storage.Cache, sections ...Section) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "text/xml")
-
-		if cache.Exists("sitemap.xml") {
-			body, err := cache.Retrieve(cacheFilePath)
-			if err == nil {
-				defer body.Close()
-				_, _ = io.Copy(w, body)
-				return
+		serveCachedFile(w, cache, cacheFilePath, func() ([]byte, error) {
+			return MarshalIndex(baseURL, sections...)
+		})
+	}
+}
+
+// ServeSiteMapHandlerFunc provides a child sitemap file of the section which is named by the "name" and "page" URL parameters
+func ServeSiteMapHandlerFunc(cache storage.Cache, sections ...Section) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := chi.URLParam(r, "name")
+		page, err := strconv.Atoi(chi.URLParam(r, "page"))
+		if err != nil || page < 1 {
+			http.NotFound(w, r)
+			return
+		}
+
+		var section *Section
+		for i := range sections {
+			if sections[i].Name == name {
+				section = &sections[i]
+				break
 			}
-			logrus.Errorf("unable to retrieve sitemap.xml: %s", err)
+		}
+		if section == nil {
+			http.NotFound(w, r)
+			return
 		}
 
-		urlSet, err := generateURLSet(genURLsFunc...)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+		serveCachedFile(w, cache, childCacheFilePath(name, page), func() ([]byte, error) {
+			urls, err := section.GenerateURLs()
+			if err != nil {
+				return nil, err
+			}
+
+			chunks := chunkURLs(urls)
+			if page > len(chunks) {
+				return nil, errPageNotFound
+			}
+			return marshalURLSet(chunks[page-1]), nil
+		})
+	}
+}
+
+// serveCachedFile writes the cached file if exists, otherwise generates a new one and stores it on the cache
+func serveCachedFile(w http.ResponseWriter, cache storage.Cache, filePath string, generate func() ([]byte, error)) {
+	w.Header().Set("Content-Type", "text/xml")
+
+	if cache.Exists(filePath) {
+		body, err := cache.Retrieve(filePath)
+		if err == nil {
+			defer body.Close()
+			_, _ = io.Copy(w, body)
 			return
 		}
+		logrus.Errorf("unable to retrieve %s: %s", filePath, err)
+	}
 
-		data, _ := xml.Marshal(urlSet)
-		data = append([]byte(`<?xml version="1.0" encoding="UTF-8"?>`), data...)
+	data, err := generate()
+	if err != nil {
+		if err == errPageNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 
-		err = cache.Store(bytes.NewReader(data), cacheFilePath)
+	err = cache.Store(bytes.NewReader(data), filePath)
+	if err != nil {
+		logrus.Errorf("unable to store %s: %s", filePath, err)
+	}
+
+	_, _ = w.Write(data)
+}
+
+// MarshalIndex generates all URLs of the sections and returns the sitemap index file content
+func MarshalIndex(baseURL string, sections ...Section) ([]byte, error) {
+	index := SiteMapIndex{SiteMaps: make([]SiteMap, 0)}
+	for _, section := range sections {
+		urls, err := section.GenerateURLs()
 		if err != nil {
-			logrus.Errorf("unable to store sitemap.xml: %s", err)
+			return nil, err
 		}
 
-		_, _ = w.Write(data)
+		for i, chunk := range chunkURLs(urls) {
+			index.SiteMaps = append(index.SiteMaps, SiteMap{
+				Location:   baseURL + "/" + childCacheFilePath(section.Name, i+1),
+				LastModify: latestLastModify(chunk),
+			})
+		}
 	}
+
+	data, _ := xml.Marshal(index)
+	return append([]byte(`<?xml version="1.0" encoding="UTF-8"?>`), data...), nil
 }
 
-func generateURLSet(genURLsFunc ...func() ([]URL, error)) (URLSet, error) {
-	urlSet := URLSet{URLs: make([]URL, 0)}
+// Marshal generates all URLs and returns the sitemap.xml file content
+func Marshal(genURLsFunc ...func() ([]URL, error)) ([]byte, error) {
+	urls := make([]URL, 0)
 	for _, f := range genURLsFunc {
-		urls, err := f()
+		u, err := f()
 		if err != nil {
-			return urlSet, err
+			return nil, err
+		}
+		urls = append(urls, u...)
+	}
+
+	return marshalURLSet(urls), nil
+}
+
+func marshalURLSet(urls []URL) []byte {
+	data, _ := xml.Marshal(URLSet{ImageNS: "http://www.google.com/schemas/sitemap-image/1.1", URLs: urls})
+	return append([]byte(`<?xml version="1.0" encoding="UTF-8"?>`), data...)
+}
+
+// chunkURLs splits the URLs into chunks of MaxURLsPerSiteMap, always returns at least one chunk
+func chunkURLs(urls []URL) [][]URL {
+	chunks := [][]URL{urls[:min(len(urls), MaxURLsPerSiteMap)]}
+	for i := MaxURLsPerSiteMap; i < len(urls); i += MaxURLsPerSiteMap {
+		chunks = append(chunks, urls[i:min(len(urls), i+MaxURLsPerSiteMap)])
+	}
+	return chunks
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// latestLastModify returns the latest last modification of the URLs or an empty string if none of them has
+func latestLastModify(urls []URL) string {
+	var latest time.Time
+	for _, u := range urls {
+		if t, err := time.Parse(time.RFC3339, u.LastModify); err == nil && t.After(latest) {
+			latest = t
 		}
-		urlSet.URLs = append(urlSet.URLs, urls...)
 	}
-	return urlSet, nil
+	return formatLastModify(latest)
+}
+
+func childCacheFilePath(name string, page int) string {
+	return fmt.Sprintf("sitemap-%s-%d.xml", name, page)
 }
 
 // GenerateFixedURLs generates all fixed URLs (which is not required any data source connection)
@@ -75,67 +199,137 @@ func GenerateFixedURLs(baseURL string) func() ([]URL, error) {
 	}
 }
 
-// GeneratePostURLs generates all Post URLs
-func GeneratePostURLs(baseURL string, repository blog.PostRepository) func() ([]URL, error) {
+// GeneratePostURLs generates all Post URLs along with their featured images
+func GeneratePostURLs(baseURL string, repository blog.PostRepository, fileRepository storage.FileRepository) func() ([]URL, error) {
 	return func() ([]URL, error) {
-		posts, err := repository.FindAll(context.Background(), blog.NewPostQueryBuilder().
-			WithStatus(blog.StatusPublished).WithLimit(9999).Build())
-		if err != nil {
-			return nil, err
-		}
-		urls := make([]URL, len(posts))
-		for i, p := range posts {
-			location, _ := url.Parse(baseURL + "/" + p.PublishedAt.In(timeutil.TimeZoneAsiaBangkok).Format("2006/1/2") + "/" + p.Slug)
-			lastModify := p.PublishedAt
-			if !p.UpdatedAt.IsZero() {
-				lastModify = p.UpdatedAt
+		urls := make([]URL, 0)
+		for offset := int64(0); ; offset += postsPerBatch {
+			posts, err := repository.FindAll(context.Background(), blog.NewPostQueryBuilder().
+				WithStatus(blog.StatusPublished).WithOffset(offset).WithLimit(postsPerBatch).Build())
+			if err != nil {
+				return nil, err
 			}
-			urls[i] = URL{
-				Location:   location.String(),
-				LastModify: lastModify.Format(time.RFC3339),
-				Priority:   0.8,
+
+			files, err := findAllFeaturedImages(fileRepository, posts)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, p := range posts {
+				location, _ := url.Parse(baseURL + p.Permalink())
+				lastModify := p.PublishedAt
+				if !p.UpdatedAt.IsZero() {
+					lastModify = p.UpdatedAt
+				}
+				u := URL{
+					Location:   location.String(),
+					LastModify: lastModify.Format(time.RFC3339),
+					Priority:   0.8,
+				}
+				if f, ok := files[p.FeaturedImage.ID]; ok {
+					u.Images = []Image{{Location: baseURL + storageURLPath + f.Slug}}
+				}
+				urls = append(urls, u)
+			}
+
+			if len(posts) < postsPerBatch {
+				return urls, nil
 			}
 		}
-		return urls, nil
 	}
 }
 
-// GenerateCategoryURLs generates all Category URLs
-func GenerateCategoryURLs(baseURL string, repository blog.CategoryRepository) func() ([]URL, error) {
+func findAllFeaturedImages(repository storage.FileRepository, posts []blog.Post) (map[primitive.ObjectID]storage.File, error) {
+	var ids []primitive.ObjectID
+	for _, p := range posts {
+		if !p.FeaturedImage.ID.IsZero() {
+			ids = append(ids, p.FeaturedImage.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	files, err := repository.FindAllByIDs(context.Background(), ids)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[primitive.ObjectID]storage.File, len(files))
+	for _, f := range files {
+		m[f.ID] = f
+	}
+	return m, nil
+}
+
+// GenerateCategoryURLs generates all Category URLs which reflect the category hierarchy,
+// with the latest published date-time of their posts as a last modification
+func GenerateCategoryURLs(baseURL string, repository blog.CategoryRepository, postRepository blog.PostRepository) func() ([]URL, error) {
 	return func() ([]URL, error) {
 		cats, err := repository.FindAll(context.Background())
 		if err != nil {
 			return nil, err
 		}
 
+		ids := make([]primitive.ObjectID, len(cats))
+		for i, c := range cats {
+			ids[i] = c.ID
+		}
+		stats, err := postRepository.FindAllCategoryStats(context.Background(), ids)
+		if err != nil {
+			return nil, err
+		}
+
+		tree := blog.NewCategoryTree(cats)
 		urls := make([]URL, len(cats))
 		for i, c := range cats {
-			location, _ := url.Parse(baseURL + "/category/" + c.Slug)
+			location, _ := url.Parse(baseURL + tree.Permalink(c))
 			urls[i] = URL{
-				Location: location.String(),
-				Priority: 0.5,
+				Location:   location.String(),
+				LastModify: formatLastModify(stats[c.ID].LatestPublishedAt),
+				Priority:   0.5,
 			}
 		}
+
 		return urls, err
 	}
 }
 
-// GenerateTagURLs generates all Tag URLs
-func GenerateTagURLs(baseURL string, repository blog.TagRepository) func() ([]URL, error) {
+// GenerateTagURLs generates all Tag URLs with the latest published date-time of their posts as a last modification
+func GenerateTagURLs(baseURL string, repository blog.TagRepository, postRepository blog.PostRepository) func() ([]URL, error) {
 	return func() ([]URL, error) {
 		tags, err := repository.FindAll(context.Background())
 		if err != nil {
 			return nil, err
 		}
 
+		ids := make([]primitive.ObjectID, len(tags))
+		for i, t := range tags {
+			ids[i] = t.ID
+		}
+		stats, err := postRepository.FindAllTagStats(context.Background(), ids)
+		if err != nil {
+			return nil, err
+		}
+
 		urls := make([]URL, len(tags))
 		for i, t := range tags {
 			location, _ := url.Parse(baseURL + "/tag/" + t.Slug)
 			urls[i] = URL{
-				Location: location.String(),
-				Priority: 0.5,
+				Location:   location.String(),
+				LastModify: formatLastModify(stats[t.ID].LatestPublishedAt),
+				Priority:   0.5,
 			}
 		}
+
 		return urls, err
 	}
 }
+
+// formatLastModify returns an empty string for omitting the lastmod tag if the category or tag has no published post
+func formatLastModify(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -2,14 +2,19 @@ package sitemap
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"github.com/go-chi/chi"
 	"github.com/golang/mock/gomock"
 	"github.com/nomkhonwaan/myblog/pkg/blog"
 	mock_blog "github.com/nomkhonwaan/myblog/pkg/blog/mock"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
 	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
 	"github.com/nomkhonwaan/myblog/pkg/timeutil"
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -17,7 +22,15 @@ import (
 	"time"
 )
 
-func TestServeSiteMapHandlerFunc(t *testing.T) {
+func newRequestWithURLParams(target string, params map[string]string) *http.Request {
+	rctx := chi.NewRouteContext()
+	for k, v := range params {
+		rctx.URLParams.Add(k, v)
+	}
+	return httptest.NewRequest(http.MethodGet, target, nil).WithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx))
+}
+
+func TestServeSiteMapIndexHandlerFunc(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -28,13 +41,41 @@ func TestServeSiteMapHandlerFunc(t *testing.T) {
 	t.Run("With successful serving sitemap.xml", func(t *testing.T) {
 		// Given
 		w := httptest.NewRecorder()
-		expected := `<?xml version="1.0" encoding="UTF-8"?><urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"><url><loc>http://localhost</loc><priority>1</priority></url></urlset>`
+		expected := `<?xml version="1.0" encoding="UTF-8"?><sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">` +
+			`<sitemap><loc>http://localhost/sitemap-pages-1.xml</loc></sitemap>` +
+			`<sitemap><loc>http://localhost/sitemap-posts-1.xml</loc><lastmod>2020-03-30T10:00:00Z</lastmod></sitemap>` +
+			`</sitemapindex>`
 
 		cache.EXPECT().Exists("sitemap.xml").Return(false)
 		cache.EXPECT().Store(gomock.Any(), "sitemap.xml").Return(nil)
 
 		// When
-		ServeSiteMapHandlerFunc(cache, GenerateFixedURLs("http://localhost")).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://localhost/sitemap.xml", nil))
+		ServeSiteMapIndexHandlerFunc("http://localhost", cache,
+			Section{Name: "pages", GenerateURLs: GenerateFixedURLs("http://localhost")},
+			Section{Name: "posts", GenerateURLs: func() ([]URL, error) {
+				return []URL{{LastModify: "2020-03-29T10:00:00Z"}, {LastModify: "2020-03-30T10:00:00Z"}}, nil
+			}},
+		).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://localhost/sitemap.xml", nil))
+
+		// Then
+		assert.Equal(t, expected, w.Body.String())
+	})
+
+	t.Run("With more URLs than the limit of a single sitemap", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		expected := `<?xml version="1.0" encoding="UTF-8"?><sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">` +
+			`<sitemap><loc>http://localhost/sitemap-posts-1.xml</loc></sitemap>` +
+			`<sitemap><loc>http://localhost/sitemap-posts-2.xml</loc></sitemap>` +
+			`</sitemapindex>`
+
+		cache.EXPECT().Exists("sitemap.xml").Return(false)
+		cache.EXPECT().Store(gomock.Any(), "sitemap.xml").Return(nil)
+
+		// When
+		ServeSiteMapIndexHandlerFunc("http://localhost", cache,
+			Section{Name: "posts", GenerateURLs: func() ([]URL, error) { return make([]URL, MaxURLsPerSiteMap+1), nil }},
+		).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://localhost/sitemap.xml", nil))
 
 		// Then
 		assert.Equal(t, expected, w.Body.String())
@@ -43,13 +84,13 @@ func TestServeSiteMapHandlerFunc(t *testing.T) {
 	t.Run("With existing sitemap.xml on cache", func(t *testing.T) {
 		// Given
 		w := httptest.NewRecorder()
-		expected := `<?xml version="1.0" encoding="UTF-8"?><urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"></urlset>`
+		expected := `<?xml version="1.0" encoding="UTF-8"?><sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"></sitemapindex>`
 
 		cache.EXPECT().Exists("sitemap.xml").Return(true)
-		cache.EXPECT().Retrieve("sitemap.xml").Return(ioutil.NopCloser(bytes.NewBufferString(`<?xml version="1.0" encoding="UTF-8"?><urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"></urlset>`)), nil)
+		cache.EXPECT().Retrieve("sitemap.xml").Return(ioutil.NopCloser(bytes.NewBufferString(expected)), nil)
 
 		// When
-		ServeSiteMapHandlerFunc(cache).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://localhost/sitemap.xml", nil))
+		ServeSiteMapIndexHandlerFunc("http://localhost", cache).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://localhost/sitemap.xml", nil))
 
 		// Then
 		assert.Equal(t, expected, w.Body.String())
@@ -63,24 +104,26 @@ func TestServeSiteMapHandlerFunc(t *testing.T) {
 		cache.EXPECT().Exists("sitemap.xml").Return(false)
 
 		// When
-		ServeSiteMapHandlerFunc(cache, func() ([]URL, error) { return nil, errors.New("test unable to generate URLs") }).
-			ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://localhost/sitemap.xml", nil))
+		ServeSiteMapIndexHandlerFunc("http://localhost", cache,
+			Section{Name: "posts", GenerateURLs: func() ([]URL, error) { return nil, errors.New("test unable to generate URLs") }},
+		).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://localhost/sitemap.xml", nil))
 
 		// Then
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
 		assert.Equal(t, expected, w.Body.String())
 	})
 
 	t.Run("When unable to retrieve sitemap.xml from cache", func(t *testing.T) {
 		// Given
 		w := httptest.NewRecorder()
-		expected := `<?xml version="1.0" encoding="UTF-8"?><urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"></urlset>`
+		expected := `<?xml version="1.0" encoding="UTF-8"?><sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"></sitemapindex>`
 
 		cache.EXPECT().Exists("sitemap.xml").Return(true)
 		cache.EXPECT().Retrieve("sitemap.xml").Return(nil, errors.New("test unable to retrieve sitemap.xml from cache"))
 		cache.EXPECT().Store(gomock.Any(), "sitemap.xml").Return(nil)
 
 		// When
-		ServeSiteMapHandlerFunc(cache).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://localhost/sitemap.xml", nil))
+		ServeSiteMapIndexHandlerFunc("http://localhost", cache).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://localhost/sitemap.xml", nil))
 
 		// Then
 		assert.Equal(t, expected, w.Body.String())
@@ -89,19 +132,132 @@ func TestServeSiteMapHandlerFunc(t *testing.T) {
 	t.Run("When unable to store new sitemap.xml to cache", func(t *testing.T) {
 		// Given
 		w := httptest.NewRecorder()
-		expected := `<?xml version="1.0" encoding="UTF-8"?><urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"></urlset>`
+		expected := `<?xml version="1.0" encoding="UTF-8"?><sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"></sitemapindex>`
 
 		cache.EXPECT().Exists("sitemap.xml").Return(false)
 		cache.EXPECT().Store(gomock.Any(), "sitemap.xml").Return(errors.New("test unable to store new sitemap.xml to cache"))
 
 		// When
-		ServeSiteMapHandlerFunc(cache).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://localhost/sitemap.xml", nil))
+		ServeSiteMapIndexHandlerFunc("http://localhost", cache).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://localhost/sitemap.xml", nil))
 
 		// Then
 		assert.Equal(t, expected, w.Body.String())
 	})
 }
 
+func TestServeSiteMapHandlerFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		cache = mock_storage.NewMockCache(ctrl)
+	)
+
+	urls := make([]URL, MaxURLsPerSiteMap+1)
+	urls[MaxURLsPerSiteMap] = URL{Location: "http://localhost/last"}
+	sections := []Section{
+		{Name: "pages", GenerateURLs: GenerateFixedURLs("http://localhost")},
+		{Name: "posts", GenerateURLs: func() ([]URL, error) { return urls, nil }},
+		{Name: "tags", GenerateURLs: func() ([]URL, error) { return nil, errors.New("test unable to generate URLs") }},
+	}
+
+	t.Run("With successful serving a child sitemap", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		expected := `<?xml version="1.0" encoding="UTF-8"?><urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9" xmlns:image="http://www.google.com/schemas/sitemap-image/1.1">` +
+			`<url><loc>http://localhost</loc><priority>1</priority></url></urlset>`
+
+		cache.EXPECT().Exists("sitemap-pages-1.xml").Return(false)
+		cache.EXPECT().Store(gomock.Any(), "sitemap-pages-1.xml").Return(nil)
+
+		// When
+		ServeSiteMapHandlerFunc(cache, sections...).ServeHTTP(w, newRequestWithURLParams("http://localhost/sitemap-pages-1.xml", map[string]string{"name": "pages", "page": "1"}))
+
+		// Then
+		assert.Equal(t, expected, w.Body.String())
+	})
+
+	t.Run("With successful serving the second chunk of a child sitemap", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		expected := `<?xml version="1.0" encoding="UTF-8"?><urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9" xmlns:image="http://www.google.com/schemas/sitemap-image/1.1">` +
+			`<url><loc>http://localhost/last</loc></url></urlset>`
+
+		cache.EXPECT().Exists("sitemap-posts-2.xml").Return(false)
+		cache.EXPECT().Store(gomock.Any(), "sitemap-posts-2.xml").Return(nil)
+
+		// When
+		ServeSiteMapHandlerFunc(cache, sections...).ServeHTTP(w, newRequestWithURLParams("http://localhost/sitemap-posts-2.xml", map[string]string{"name": "posts", "page": "2"}))
+
+		// Then
+		assert.Equal(t, expected, w.Body.String())
+	})
+
+	t.Run("With existing child sitemap on cache", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+		expected := `<?xml version="1.0" encoding="UTF-8"?><urlset></urlset>`
+
+		cache.EXPECT().Exists("sitemap-posts-1.xml").Return(true)
+		cache.EXPECT().Retrieve("sitemap-posts-1.xml").Return(ioutil.NopCloser(bytes.NewBufferString(expected)), nil)
+
+		// When
+		ServeSiteMapHandlerFunc(cache, sections...).ServeHTTP(w, newRequestWithURLParams("http://localhost/sitemap-posts-1.xml", map[string]string{"name": "posts", "page": "1"}))
+
+		// Then
+		assert.Equal(t, expected, w.Body.String())
+	})
+
+	t.Run("When the page is out of range", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("sitemap-posts-3.xml").Return(false)
+
+		// When
+		ServeSiteMapHandlerFunc(cache, sections...).ServeHTTP(w, newRequestWithURLParams("http://localhost/sitemap-posts-3.xml", map[string]string{"name": "posts", "page": "3"}))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("When the page is not a positive number", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		// When
+		ServeSiteMapHandlerFunc(cache, sections...).ServeHTTP(w, newRequestWithURLParams("http://localhost/sitemap-posts-0.xml", map[string]string{"name": "posts", "page": "0"}))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("When the section does not exist", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		// When
+		ServeSiteMapHandlerFunc(cache, sections...).ServeHTTP(w, newRequestWithURLParams("http://localhost/sitemap-unknown-1.xml", map[string]string{"name": "unknown", "page": "1"}))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("When unable to generate URLs", func(t *testing.T) {
+		// Given
+		w := httptest.NewRecorder()
+
+		cache.EXPECT().Exists("sitemap-tags-1.xml").Return(false)
+
+		// When
+		ServeSiteMapHandlerFunc(cache, sections...).ServeHTTP(w, newRequestWithURLParams("http://localhost/sitemap-tags-1.xml", map[string]string{"name": "tags", "page": "1"}))
+
+		// Then
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, "test unable to generate URLs\n", w.Body.String())
+	})
+}
+
 func TestGenerateFixedURLs(t *testing.T) {
 	// Given
 	expected := []URL{
@@ -124,17 +280,20 @@ func TestGeneratePostURLs(t *testing.T) {
 	defer ctrl.Finish()
 
 	var (
-		repository = mock_blog.NewMockPostRepository(ctrl)
+		repository     = mock_blog.NewMockPostRepository(ctrl)
+		fileRepository = mock_storage.NewMockFileRepository(ctrl)
 	)
 
 	t.Run("With successful generating all post URLs", func(t *testing.T) {
 		// Given
 		now := time.Now()
+		fileID := primitive.NewObjectID()
 		expected := []URL{
 			{
 				Location:   fmt.Sprintf("http://localhost/%s/test-1", now.In(timeutil.TimeZoneAsiaBangkok).Format("2006/1/2")),
 				LastModify: now.Format(time.RFC3339),
 				Priority:   0.8,
+				Images:     []Image{{Location: "http://localhost/api/v2.1/storage/cover.png"}},
 			},
 			{
 				Location:   fmt.Sprintf("http://localhost/%s/test-2", now.In(timeutil.TimeZoneAsiaBangkok).Format("2006/1/2")),
@@ -144,26 +303,60 @@ func TestGeneratePostURLs(t *testing.T) {
 		}
 
 		repository.EXPECT().FindAll(gomock.Any(), blog.NewPostQueryBuilder().WithStatus(blog.StatusPublished).
-			WithLimit(9999).Build()).Return([]blog.Post{{Slug: "test-1", PublishedAt: now}, {Slug: "test-2", PublishedAt: now, UpdatedAt: now}}, nil)
+			WithOffset(0).WithLimit(postsPerBatch).Build()).Return([]blog.Post{
+			{Slug: "test-1", PublishedAt: now, FeaturedImage: mongo.DBRef{ID: fileID}},
+			{Slug: "test-2", PublishedAt: now, UpdatedAt: now},
+		}, nil)
+		fileRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{fileID}).
+			Return([]storage.File{{ID: fileID, Slug: "cover.png"}}, nil)
 
 		// When
-		urls, err := GeneratePostURLs("http://localhost", repository)()
+		urls, err := GeneratePostURLs("http://localhost", repository, fileRepository)()
 
 		// Then
 		assert.Nil(t, err)
 		assert.Equal(t, expected, urls)
 	})
 
+	t.Run("With more posts than a single batch", func(t *testing.T) {
+		// Given
+		posts := make([]blog.Post, postsPerBatch)
+
+		repository.EXPECT().FindAll(gomock.Any(), blog.NewPostQueryBuilder().WithStatus(blog.StatusPublished).
+			WithOffset(0).WithLimit(postsPerBatch).Build()).Return(posts, nil)
+		repository.EXPECT().FindAll(gomock.Any(), blog.NewPostQueryBuilder().WithStatus(blog.StatusPublished).
+			WithOffset(postsPerBatch).WithLimit(postsPerBatch).Build()).Return([]blog.Post{{Slug: "last"}}, nil)
+
+		// When
+		urls, err := GeneratePostURLs("http://localhost", repository, fileRepository)()
+
+		// Then
+		assert.Nil(t, err)
+		assert.Len(t, urls, postsPerBatch+1)
+	})
+
 	t.Run("When unable to find all posts", func(t *testing.T) {
 		// Given
 		repository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, errors.New("test unable to find all posts"))
 
 		// When
-		_, err := GeneratePostURLs("http://localhost", repository)()
+		_, err := GeneratePostURLs("http://localhost", repository, fileRepository)()
 
 		// Then
 		assert.EqualError(t, err, "test unable to find all posts")
 	})
+
+	t.Run("When unable to find all featured images", func(t *testing.T) {
+		// Given
+		repository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return([]blog.Post{{FeaturedImage: mongo.DBRef{ID: primitive.NewObjectID()}}}, nil)
+		fileRepository.EXPECT().FindAllByIDs(gomock.Any(), gomock.Any()).Return(nil, errors.New("test unable to find all files"))
+
+		// When
+		_, err := GeneratePostURLs("http://localhost", repository, fileRepository)()
+
+		// Then
+		assert.EqualError(t, err, "test unable to find all files")
+	})
 }
 
 func TestGenerateCategoryURLs(t *testing.T) {
@@ -171,22 +364,33 @@ func TestGenerateCategoryURLs(t *testing.T) {
 	defer ctrl.Finish()
 
 	var (
-		repository = mock_blog.NewMockCategoryRepository(ctrl)
+		repository     = mock_blog.NewMockCategoryRepository(ctrl)
+		postRepository = mock_blog.NewMockPostRepository(ctrl)
 	)
 
+	id := primitive.NewObjectID()
+	emptyID := primitive.NewObjectID()
+
 	t.Run("With successful generating all category URLs", func(t *testing.T) {
 		// Given
 		expected := []URL{
 			{
-				Location: "http://localhost/category/test",
+				Location:   "http://localhost/category/test",
+				LastModify: "2020-03-29T10:00:00Z",
+				Priority:   0.5,
+			},
+			{
+				Location: "http://localhost/category/test/empty",
 				Priority: 0.5,
 			},
 		}
 
-		repository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{{Name: "Test", Slug: "test"}}, nil)
+		repository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{{ID: id, Name: "Test", Slug: "test"}, {ID: emptyID, Name: "Empty", Slug: "empty", Parent: mongo.DBRef{ID: id}}}, nil)
+		postRepository.EXPECT().FindAllCategoryStats(gomock.Any(), []primitive.ObjectID{id, emptyID}).
+			Return(map[primitive.ObjectID]blog.Stats{id: {PostCount: 1, LatestPublishedAt: time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC)}}, nil)
 
 		// When
-		urls, err := GenerateCategoryURLs("http://localhost", repository)()
+		urls, err := GenerateCategoryURLs("http://localhost", repository, postRepository)()
 
 		// Then
 		assert.Nil(t, err)
@@ -198,11 +402,23 @@ func TestGenerateCategoryURLs(t *testing.T) {
 		repository.EXPECT().FindAll(gomock.Any()).Return(nil, errors.New("test unable to find all categories"))
 
 		// When
-		_, err := GenerateCategoryURLs("http://localhost", repository)()
+		_, err := GenerateCategoryURLs("http://localhost", repository, postRepository)()
 
 		// Then
 		assert.EqualError(t, err, "test unable to find all categories")
 	})
+
+	t.Run("When unable to find all category stats", func(t *testing.T) {
+		// Given
+		repository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{{ID: id, Name: "Test", Slug: "test"}}, nil)
+		postRepository.EXPECT().FindAllCategoryStats(gomock.Any(), []primitive.ObjectID{id}).Return(nil, errors.New("test unable to find all category stats"))
+
+		// When
+		_, err := GenerateCategoryURLs("http://localhost", repository, postRepository)()
+
+		// Then
+		assert.EqualError(t, err, "test unable to find all category stats")
+	})
 }
 
 func TestGenerateTagURLs(t *testing.T) {
@@ -210,22 +426,33 @@ func TestGenerateTagURLs(t *testing.T) {
 	defer ctrl.Finish()
 
 	var (
-		repository = mock_blog.NewMockTagRepository(ctrl)
+		repository     = mock_blog.NewMockTagRepository(ctrl)
+		postRepository = mock_blog.NewMockPostRepository(ctrl)
 	)
 
+	id := primitive.NewObjectID()
+	emptyID := primitive.NewObjectID()
+
 	t.Run("With successful generating all tag URLs", func(t *testing.T) {
 		// Given
 		expected := []URL{
 			{
-				Location: "http://localhost/tag/test",
+				Location:   "http://localhost/tag/test",
+				LastModify: "2020-03-29T10:00:00Z",
+				Priority:   0.5,
+			},
+			{
+				Location: "http://localhost/tag/empty",
 				Priority: 0.5,
 			},
 		}
 
-		repository.EXPECT().FindAll(gomock.Any()).Return([]blog.Tag{{Name: "Test", Slug: "test"}}, nil)
+		repository.EXPECT().FindAll(gomock.Any()).Return([]blog.Tag{{ID: id, Name: "Test", Slug: "test"}, {ID: emptyID, Name: "Empty", Slug: "empty"}}, nil)
+		postRepository.EXPECT().FindAllTagStats(gomock.Any(), []primitive.ObjectID{id, emptyID}).
+			Return(map[primitive.ObjectID]blog.Stats{id: {PostCount: 1, LatestPublishedAt: time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC)}}, nil)
 
 		// When
-		urls, err := GenerateTagURLs("http://localhost", repository)()
+		urls, err := GenerateTagURLs("http://localhost", repository, postRepository)()
 
 		// Then
 		assert.Nil(t, err)
@@ -237,9 +464,21 @@ func TestGenerateTagURLs(t *testing.T) {
 		repository.EXPECT().FindAll(gomock.Any()).Return(nil, errors.New("test unable to find all tags"))
 
 		// When
-		_, err := GenerateTagURLs("http://localhost", repository)()
+		_, err := GenerateTagURLs("http://localhost", repository, postRepository)()
 
 		// Then
 		assert.EqualError(t, err, "test unable to find all tags")
 	})
+
+	t.Run("When unable to find all tag stats", func(t *testing.T) {
+		// Given
+		repository.EXPECT().FindAll(gomock.Any()).Return([]blog.Tag{{ID: id, Name: "Test", Slug: "test"}}, nil)
+		postRepository.EXPECT().FindAllTagStats(gomock.Any(), []primitive.ObjectID{id}).Return(nil, errors.New("test unable to find all tag stats"))
+
+		// When
+		_, err := GenerateTagURLs("http://localhost", repository, postRepository)()
+
+		// Then
+		assert.EqualError(t, err, "test unable to find all tag stats")
+	})
 }
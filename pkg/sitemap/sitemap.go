@@ -2,9 +2,28 @@ package sitemap
 
 import "encoding/xml"
 
+// MaxURLsPerSiteMap is a maximum number of URLs in a single sitemap file which is limited by the protocol
+const MaxURLsPerSiteMap = 50000
+
+// SiteMapIndex encapsulates the index file which references all child sitemap files
+type SiteMapIndex struct {
+	XMLName  xml.Name  `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 sitemapindex"`
+	SiteMaps []SiteMap `xml:"sitemap"`
+}
+
+// SiteMap is a parent tag for each child sitemap file entry in the index file
+type SiteMap struct {
+	// URL of the child sitemap file
+	Location string `xml:"loc"`
+
+	// The date of last modification of the latest URL in the child sitemap file
+	LastModify string `xml:"lastmod,omitempty"`
+}
+
 // URLSet encapsulates the file and references the current protocol standard
 type URLSet struct {
 	XMLName xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+	ImageNS string   `xml:"xmlns:image,attr"`
 	URLs    []URL    `xml:"url"`
 }
 
@@ -55,4 +74,13 @@ type URL struct {
 	// Also, please note that assigning a high priority to all of the URLs on your site is not likely to help you.
 	// Since the priority is relative, it is only used to select between URLs on your site.
 	Priority float64 `xml:"priority,omitempty"`
+
+	// List of images on the page which uses the image sitemap extension
+	Images []Image `xml:"image:image"`
+}
+
+// Image is a parent tag for each image entry of the URL
+type Image struct {
+	// URL of the image
+	Location string `xml:"image:loc"`
 }
@@ -0,0 +1,55 @@
+package sitemap
+
+import (
+	"context"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// Subscribe registers handlers on the bus which invalidate the cached sitemap.xml file and all child sitemap files
+// of the sections whenever the list of public URLs or their last modification dates could be changed,
+// they will be regenerated on the next request
+func Subscribe(bus eventbus.Bus, cache storage.Cache, sections ...Section) {
+	remove := func(e eventbus.Event, filePath string) {
+		if err := cache.Delete(filePath); err != nil {
+			logrus.Errorf("unable to invalidate %s on the event %s: %s", filePath, e.EventName(), err)
+		}
+	}
+	invalidate := func(_ context.Context, e eventbus.Event) {
+		if cache.Exists(cacheFilePath) {
+			remove(e, cacheFilePath)
+		}
+		for _, section := range sections {
+			chunks := 0
+			if urls, err := section.GenerateURLs(); err != nil {
+				logrus.Errorf("unable to generate URLs of the %s section on the event %s: %s", section.Name, e.EventName(), err)
+			} else {
+				chunks = len(chunkURLs(urls))
+			}
+
+			// a child sitemap can be cached without its previous pages, so all pages of the current URLs must be checked,
+			// the cached pages after them are left from the time the section had more URLs
+			for page := 1; ; page++ {
+				filePath := childCacheFilePath(section.Name, page)
+				exists := cache.Exists(filePath)
+				if !exists && page > chunks {
+					break
+				}
+				if exists {
+					remove(e, filePath)
+				}
+			}
+		}
+	}
+
+	bus.Subscribe(blog.PostPublished{}, invalidate)
+	bus.Subscribe(blog.PostUnpublished{}, invalidate)
+	bus.Subscribe(blog.CategoryParentChanged{}, invalidate)
+	bus.Subscribe(blog.PostContentChanged{}, func(ctx context.Context, e eventbus.Event) {
+		if e.(blog.PostContentChanged).Post.Status.IsPublished() {
+			invalidate(ctx, e)
+		}
+	})
+}
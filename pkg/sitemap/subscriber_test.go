@@ -0,0 +1,122 @@
+package sitemap
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
+	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
+	"testing"
+)
+
+func TestSubscribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		cache = mock_storage.NewMockCache(ctrl)
+	)
+
+	bus := eventbus.NewLocalBus()
+	Subscribe(bus, cache)
+
+	t.Run("With successful invalidating sitemap.xml when a post has been published", func(t *testing.T) {
+		// Given
+		cache.EXPECT().Exists(cacheFilePath).Return(true)
+		cache.EXPECT().Delete(cacheFilePath).Return(nil)
+
+		// When
+		bus.Publish(context.Background(), blog.PostPublished{})
+
+		// Then
+	})
+
+	t.Run("With successful invalidating sitemap.xml when a published post has been changed", func(t *testing.T) {
+		// Given
+		cache.EXPECT().Exists(cacheFilePath).Return(true)
+		cache.EXPECT().Delete(cacheFilePath).Return(nil)
+
+		// When
+		bus.Publish(context.Background(), blog.PostContentChanged{Post: blog.Post{Status: blog.StatusPublished}})
+
+		// Then
+	})
+
+	t.Run("When a draft post has been changed", func(t *testing.T) {
+		// Given
+
+		// When
+		bus.Publish(context.Background(), blog.PostContentChanged{Post: blog.Post{Status: blog.StatusDraft}})
+
+		// Then
+	})
+
+	t.Run("When sitemap.xml has not been cached", func(t *testing.T) {
+		// Given
+		cache.EXPECT().Exists(cacheFilePath).Return(false)
+
+		// When
+		bus.Publish(context.Background(), blog.CategoryParentChanged{})
+
+		// Then
+	})
+
+	t.Run("When unable to delete sitemap.xml", func(t *testing.T) {
+		// Given
+		cache.EXPECT().Exists(cacheFilePath).Return(true)
+		cache.EXPECT().Delete(cacheFilePath).Return(errors.New("test unable to delete sitemap.xml"))
+
+		// When
+		bus.Publish(context.Background(), blog.PostUnpublished{})
+
+		// Then
+	})
+
+	t.Run("With successful invalidating all cached child sitemaps of the sections", func(t *testing.T) {
+		// Given
+		bus := eventbus.NewLocalBus()
+		Subscribe(bus, cache, Section{Name: "pages", GenerateURLs: generateURLs(1)}, Section{Name: "posts", GenerateURLs: generateURLs(MaxURLsPerSiteMap*2 + 1)})
+
+		cache.EXPECT().Exists(cacheFilePath).Return(false)
+		cache.EXPECT().Exists("sitemap-pages-1.xml").Return(false)
+		cache.EXPECT().Exists("sitemap-pages-2.xml").Return(false)
+		cache.EXPECT().Exists("sitemap-posts-1.xml").Return(false)
+		cache.EXPECT().Exists("sitemap-posts-2.xml").Return(true)
+		cache.EXPECT().Delete("sitemap-posts-2.xml").Return(nil)
+		cache.EXPECT().Exists("sitemap-posts-3.xml").Return(true)
+		cache.EXPECT().Delete("sitemap-posts-3.xml").Return(nil)
+		cache.EXPECT().Exists("sitemap-posts-4.xml").Return(true)
+		cache.EXPECT().Delete("sitemap-posts-4.xml").Return(nil)
+		cache.EXPECT().Exists("sitemap-posts-5.xml").Return(false)
+
+		// When
+		bus.Publish(context.Background(), blog.PostPublished{})
+
+		// Then
+	})
+
+	t.Run("When unable to generate URLs of the section", func(t *testing.T) {
+		// Given
+		bus := eventbus.NewLocalBus()
+		Subscribe(bus, cache, Section{Name: "posts", GenerateURLs: func() ([]URL, error) {
+			return nil, errors.New("test unable to generate URLs")
+		}})
+
+		cache.EXPECT().Exists(cacheFilePath).Return(false)
+		cache.EXPECT().Exists("sitemap-posts-1.xml").Return(true)
+		cache.EXPECT().Delete("sitemap-posts-1.xml").Return(nil)
+		cache.EXPECT().Exists("sitemap-posts-2.xml").Return(false)
+
+		// When
+		bus.Publish(context.Background(), blog.PostPublished{})
+
+		// Then
+	})
+}
+
+func generateURLs(n int) func() ([]URL, error) {
+	return func() ([]URL, error) {
+		return make([]URL, n), nil
+	}
+}
@@ -0,0 +1,431 @@
+package static
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/image"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	"github.com/nomkhonwaan/myblog/pkg/sitemap"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"github.com/nomkhonwaan/myblog/pkg/timeutil"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"html/template"
+	"io"
+	"mime"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// FuncMap contains all functions which are required by the static site template
+	FuncMap = template.FuncMap{
+		"date": func(t time.Time) string {
+			return t.In(timeutil.TimeZoneAsiaBangkok).Format("2 January 2006")
+		},
+		"datetime": func(t time.Time) string {
+			return t.Format(time.RFC3339)
+		},
+	}
+
+	storageURLRegExp = regexp.MustCompile(`/api/v2\.1/storage/([^"'?\s)]+)`)
+	imageTagRegExp   = regexp.MustCompile(`<img([^>]*?) src="(/api/v2\.1/storage/[^"?]+\.(?:jpe?g|png))"`)
+)
+
+const (
+	storageURLPath = "/api/v2.1/storage/"
+)
+
+// Generator renders all published content to HTML files for hosting on any static file server.
+// All output paths follow the same permalinks as the sitemap.xml and the front-end routes.
+type Generator struct {
+	// A base URL of the blog which uses for the canonical URLs and sitemap.xml
+	BaseURL string
+
+	// Name of the site which will be shown on every page title
+	SiteName string
+
+	// A file system where the output directory located
+	Fs afero.Fs
+
+	// An output directory
+	OutDir string
+
+	// A template which defines "post" and "list" templates
+	Template *template.Template
+
+	// Number of posts on each listing page, must be the same as the front-end
+	ItemsPerPage int
+
+	// List of image widths to be resized for the "srcset" attribute
+	ImageWidths []int
+
+	Storage            storage.Storage
+	Resizer            image.Resizer
+	CategoryRepository blog.CategoryRepository
+	TagRepository      blog.TagRepository
+	PostRepository     blog.PostRepository
+	FileRepository     storage.FileRepository
+}
+
+type page struct {
+	SiteName string
+	Title    string
+	URL      string
+}
+
+type postPage struct {
+	page
+	Post                blog.Post
+	HTML                template.HTML
+	Categories          []category
+	Tags                []blog.Tag
+	FeaturedImage       storage.File
+	FeaturedImageSrcset string
+}
+
+// category is a category with its URL path which composes with slugs of all ancestors
+type category struct {
+	blog.Category
+	Permalink string
+}
+
+type listPage struct {
+	page
+	Heading string
+	Posts   []blog.Post
+	Prev    string
+	Next    string
+}
+
+// Generate renders all published posts, categories, tags, archive pages and sitemap.xml to the output directory,
+// then copies all referenced storage files including the resized images
+func (g Generator) Generate(ctx context.Context) error {
+	posts, err := g.findAllPublishedPosts(ctx)
+	if err != nil {
+		return err
+	}
+
+	cats, err := g.CategoryRepository.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	tags, err := g.TagRepository.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	tree := blog.NewCategoryTree(cats)
+	fileIDs := make(map[primitive.ObjectID]bool)
+	fileSlugs := make(map[string]bool)
+
+	for _, p := range posts {
+		if err = g.generatePost(ctx, p, tree); err != nil {
+			return err
+		}
+
+		if !p.FeaturedImage.ID.IsZero() {
+			fileIDs[p.FeaturedImage.ID] = true
+		}
+		for _, atm := range p.Attachments {
+			fileIDs[atm.ID] = true
+		}
+		for _, m := range storageURLRegExp.FindAllStringSubmatch(p.HTML, -1) {
+			fileSlugs[m[1]] = true
+		}
+	}
+
+	if err = g.generateHome(posts); err != nil {
+		return err
+	}
+	if err = g.generateList(posts, "Archive", "Archive", ""); err != nil {
+		return err
+	}
+
+	for _, c := range cats {
+		ids := map[primitive.ObjectID]bool{c.ID: true}
+		for _, d := range tree.Descendants(c) {
+			ids[d.ID] = true
+		}
+
+		var filtered []blog.Post
+		for _, p := range posts {
+			for _, ref := range p.Categories {
+				if ids[ref.ID] {
+					filtered = append(filtered, p)
+					break
+				}
+			}
+		}
+		if err = g.generateList(filtered, c.Name, c.Name, tree.Permalink(c)); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range tags {
+		var filtered []blog.Post
+		for _, p := range posts {
+			for _, ref := range p.Tags {
+				if ref.ID == t.ID {
+					filtered = append(filtered, p)
+				}
+			}
+		}
+		if err = g.generateList(filtered, t.Name, "#"+t.Name, "/tag/"+t.Slug); err != nil {
+			return err
+		}
+	}
+
+	if err = g.generateSiteMap(); err != nil {
+		return err
+	}
+
+	return g.copyFiles(ctx, fileIDs, fileSlugs)
+}
+
+func (g Generator) findAllPublishedPosts(ctx context.Context) ([]blog.Post, error) {
+	var (
+		posts  []blog.Post
+		offset int64
+		limit  int64 = 100
+	)
+
+	for {
+		result, err := g.PostRepository.FindAll(ctx, blog.NewPostQueryBuilder().WithStatus(blog.StatusPublished).
+			WithOffset(offset).WithLimit(limit).Build())
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, result...)
+		if int64(len(result)) < limit {
+			return posts, nil
+		}
+		offset += limit
+	}
+}
+
+func (g Generator) generatePost(ctx context.Context, p blog.Post, tree blog.CategoryTree) error {
+	data := postPage{
+		page: page{SiteName: g.SiteName, Title: p.Title, URL: g.BaseURL + p.Permalink()},
+		Post: p,
+		HTML: template.HTML(g.addImageSrcset(p.HTML)),
+	}
+
+	var err error
+	if len(p.Categories) > 0 {
+		cats, err := g.CategoryRepository.FindAllByIDs(ctx, refIDs(p.Categories))
+		if err != nil {
+			return err
+		}
+		for _, c := range cats {
+			data.Categories = append(data.Categories, category{Category: c, Permalink: tree.Permalink(c)})
+		}
+	}
+	if len(p.Tags) > 0 {
+		if data.Tags, err = g.TagRepository.FindAllByIDs(ctx, refIDs(p.Tags)); err != nil {
+			return err
+		}
+	}
+	if !p.FeaturedImage.ID.IsZero() {
+		if data.FeaturedImage, err = g.FileRepository.FindByID(ctx, p.FeaturedImage.ID); err != nil {
+			logrus.Warnf("unable to find featured image of the post %s: %s", p.Slug, err)
+		}
+		data.FeaturedImageSrcset = g.srcset(storageURLPath + data.FeaturedImage.Slug)
+	}
+
+	return g.render("post", path.Join(p.Permalink(), "index.html"), data)
+}
+
+// generateHome renders the home page which shows the latest published posts
+func (g Generator) generateHome(posts []blog.Post) error {
+	data := listPage{
+		page:  page{SiteName: g.SiteName, URL: g.BaseURL},
+		Posts: posts,
+	}
+	if len(posts) > g.ItemsPerPage {
+		data.Posts = posts[:g.ItemsPerPage]
+		data.Next = "/2"
+	}
+
+	return g.render("list", "index.html", data)
+}
+
+// generateList renders all listing pages under the base path, e.g. "/category/web-development-{id}/2".
+// The first page of a category or tag is also rendered on the base path itself.
+func (g Generator) generateList(posts []blog.Post, title, heading, basePath string) error {
+	totalPages := (len(posts) + g.ItemsPerPage - 1) / g.ItemsPerPage
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	for i := 1; i <= totalPages; i++ {
+		start, end := (i-1)*g.ItemsPerPage, i*g.ItemsPerPage
+		if end > len(posts) {
+			end = len(posts)
+		}
+
+		pagePath := basePath + "/" + strconv.Itoa(i)
+		data := listPage{
+			page:    page{SiteName: g.SiteName, Title: title, URL: g.BaseURL + pagePath},
+			Heading: heading,
+			Posts:   posts[start:end],
+		}
+		if i > 1 {
+			data.Prev = basePath + "/" + strconv.Itoa(i-1)
+		}
+		if i < totalPages {
+			data.Next = basePath + "/" + strconv.Itoa(i+1)
+		}
+
+		if err := g.render("list", path.Join(pagePath, "index.html"), data); err != nil {
+			return err
+		}
+		if i == 1 && basePath != "" {
+			data.URL = g.BaseURL + basePath
+			if err := g.render("list", path.Join(basePath, "index.html"), data); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g Generator) generateSiteMap() error {
+	data, err := sitemap.Marshal(
+		sitemap.GenerateFixedURLs(g.BaseURL),
+		sitemap.GeneratePostURLs(g.BaseURL, g.PostRepository, g.FileRepository),
+		sitemap.GenerateCategoryURLs(g.BaseURL, g.CategoryRepository, g.PostRepository),
+		sitemap.GenerateTagURLs(g.BaseURL, g.TagRepository, g.PostRepository),
+	)
+	if err != nil {
+		return err
+	}
+
+	return g.write("sitemap.xml", bytes.NewReader(data))
+}
+
+func (g Generator) copyFiles(ctx context.Context, ids map[primitive.ObjectID]bool, slugs map[string]bool) error {
+	for slug := range slugs {
+		if id, err := storage.Slug(slug).GetID(); err == nil {
+			ids[id.(primitive.ObjectID)] = true
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	list := make([]primitive.ObjectID, 0, len(ids))
+	for id := range ids {
+		list = append(list, id)
+	}
+
+	files, err := g.FileRepository.FindAllByIDs(ctx, list)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		if err = g.copyFile(ctx, f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g Generator) copyFile(ctx context.Context, f storage.File) error {
+	logrus.Infof("copying file %s...", f.Path)
+
+	body, err := g.Storage.Download(ctx, f.Path)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	var buf bytes.Buffer
+	if err = g.write(storageURLPath+f.Slug, io.TeeReader(body, &buf)); err != nil {
+		return err
+	}
+
+	if !isResizable(f.Slug) {
+		return nil
+	}
+
+	for _, width := range g.ImageWidths {
+		resized, err := g.Resizer.Resize(bytes.NewReader(buf.Bytes()), width, 0)
+		if err != nil {
+			return err
+		}
+		if err = g.write(resizedPath(storageURLPath+f.Slug, width), resized); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addImageSrcset adds the "srcset" attribute to all images which are served from the storage,
+// the front-end does the same thing on the client-side by appending the window width to the image URL
+func (g Generator) addImageSrcset(html string) string {
+	return imageTagRegExp.ReplaceAllStringFunc(html, func(tag string) string {
+		m := imageTagRegExp.FindStringSubmatch(tag)
+		return fmt.Sprintf(`<img%s src="%s" srcset="%s"`, m[1], m[2], g.srcset(m[2]))
+	})
+}
+
+func (g Generator) srcset(src string) string {
+	if !isResizable(src) {
+		return ""
+	}
+
+	sources := make([]string, len(g.ImageWidths))
+	for i, width := range g.ImageWidths {
+		sources[i] = resizedPath(src, width) + " " + strconv.Itoa(width) + "w"
+	}
+	return strings.Join(sources, ", ")
+}
+
+func (g Generator) render(name, filePath string, data interface{}) error {
+	var buf bytes.Buffer
+	if err := g.Template.ExecuteTemplate(&buf, name, data); err != nil {
+		return err
+	}
+	return g.write(filePath, &buf)
+}
+
+func (g Generator) write(filePath string, body io.Reader) error {
+	fullPath := filepath.Join(g.OutDir, filepath.FromSlash(filePath))
+	if err := g.Fs.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return err
+	}
+
+	return afero.WriteReader(g.Fs, fullPath, body)
+}
+
+func isResizable(filePath string) bool {
+	mimeType := mime.TypeByExtension(filepath.Ext(filePath))
+	return mimeType == "image/jpeg" || mimeType == "image/png"
+}
+
+// resizedPath returns the same file name format as storage.DownloadHandlerFunc uses for caching resized images
+func resizedPath(filePath string, width int) string {
+	ext := filepath.Ext(filePath)
+	return fmt.Sprintf("%s-%d-%d%s", filePath[0:len(filePath)-len(ext)], width, 0, ext)
+}
+
+func refIDs(refs []mongo.DBRef) []primitive.ObjectID {
+	ids := make([]primitive.ObjectID, len(refs))
+	for i, ref := range refs {
+		ids[i] = ref.ID
+	}
+	return ids
+}
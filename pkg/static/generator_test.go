@@ -0,0 +1,128 @@
+package static
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	mock_blog "github.com/nomkhonwaan/myblog/pkg/blog/mock"
+	mock_image "github.com/nomkhonwaan/myblog/pkg/image/mock"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"html/template"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestGenerator_Generate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		bucket             = mock_storage.NewMockStorage(ctrl)
+		resizer            = mock_image.NewMockResizer(ctrl)
+		categoryRepository = mock_blog.NewMockCategoryRepository(ctrl)
+		tagRepository      = mock_blog.NewMockTagRepository(ctrl)
+		postRepository     = mock_blog.NewMockPostRepository(ctrl)
+		fileRepository     = mock_storage.NewMockFileRepository(ctrl)
+	)
+
+	tmpl := template.Must(template.New("test").Funcs(FuncMap).Parse(`
+{{define "post"}}{{.URL}} {{.Post.Title}} {{.HTML}} {{.FeaturedImageSrcset}}{{range .Categories}} {{.Name}}{{end}}{{end}}
+{{define "list"}}{{.URL}} {{.Heading}}{{range .Posts}} {{.Title}}{{end}} prev={{.Prev}} next={{.Next}}{{end}}
+`))
+
+	newGenerator := func(fs afero.Fs) Generator {
+		return Generator{
+			BaseURL:            "http://localhost",
+			SiteName:           "Test",
+			Fs:                 fs,
+			OutDir:             "/out",
+			Template:           tmpl,
+			ItemsPerPage:       1,
+			ImageWidths:        []int{420},
+			Storage:            bucket,
+			Resizer:            resizer,
+			CategoryRepository: categoryRepository,
+			TagRepository:      tagRepository,
+			PostRepository:     postRepository,
+			FileRepository:     fileRepository,
+		}
+	}
+
+	readFile := func(fs afero.Fs, name string) string {
+		data, err := afero.ReadFile(fs, name)
+		assert.Nil(t, err, name)
+		return string(data)
+	}
+
+	t.Run("With successful generating all pages", func(t *testing.T) {
+		// Given
+		fs := afero.NewMemMapFs()
+		publishedAt := time.Date(2020, 3, 29, 10, 0, 0, 0, time.UTC)
+		cat := blog.Category{ID: primitive.NewObjectID(), Name: "Web", Slug: "web"}
+		sub := blog.Category{ID: primitive.NewObjectID(), Name: "Go", Slug: "go", Parent: mongo.DBRef{ID: cat.ID}}
+		imageID := primitive.NewObjectID()
+		image := storage.File{ID: imageID, Path: "author/cover-" + imageID.Hex() + ".png", Slug: "cover-" + imageID.Hex() + ".png"}
+		posts := []blog.Post{
+			{
+				Title:         "First",
+				Slug:          "first",
+				PublishedAt:   publishedAt,
+				HTML:          `<p><img src="/api/v2.1/storage/` + image.Slug + `" alt="cover"></p>`,
+				Categories:    []mongo.DBRef{{ID: cat.ID}},
+				FeaturedImage: mongo.DBRef{ID: imageID},
+			},
+			{Title: "Second", Slug: "second", PublishedAt: publishedAt},
+		}
+
+		postRepository.EXPECT().FindAll(gomock.Any(), blog.NewPostQueryBuilder().WithStatus(blog.StatusPublished).
+			WithOffset(0).WithLimit(100).Build()).Return(posts, nil)
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{cat, sub}, nil).Times(2)
+		tagRepository.EXPECT().FindAll(gomock.Any()).Return(nil, nil).Times(2)
+		postRepository.EXPECT().FindAllCategoryStats(gomock.Any(), []primitive.ObjectID{cat.ID, sub.ID}).Return(map[primitive.ObjectID]blog.Stats{cat.ID: {PostCount: 1, LatestPublishedAt: publishedAt}}, nil)
+		postRepository.EXPECT().FindAllTagStats(gomock.Any(), []primitive.ObjectID{}).Return(nil, nil)
+		categoryRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{cat.ID}).Return([]blog.Category{cat}, nil)
+		fileRepository.EXPECT().FindByID(gomock.Any(), imageID).Return(image, nil)
+		postRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(posts, nil)
+		fileRepository.EXPECT().FindAllByIDs(gomock.Any(), []primitive.ObjectID{imageID}).Return([]storage.File{image}, nil).Times(2)
+		bucket.EXPECT().Download(gomock.Any(), image.Path).Return(ioutil.NopCloser(bytes.NewBufferString("original")), nil)
+		resizer.EXPECT().Resize(gomock.Any(), 420, 0).Return(bytes.NewBufferString("resized"), nil)
+
+		// When
+		err := newGenerator(fs).Generate(context.Background())
+
+		// Then
+		assert.Nil(t, err)
+		resized := "/api/v2.1/storage/cover-" + imageID.Hex() + "-420-0.png"
+		assert.Equal(t, `http://localhost/2020/3/29/first First <p><img src="/api/v2.1/storage/`+image.Slug+`" srcset="`+resized+` 420w" alt="cover"></p> `+resized+` 420w Web`,
+			readFile(fs, "/out/2020/3/29/first/index.html"))
+		assert.Equal(t, "http://localhost  First prev= next=/2", readFile(fs, "/out/index.html"))
+		assert.Equal(t, "http://localhost/2 Archive Second prev=/1 next=", readFile(fs, "/out/2/index.html"))
+		assert.Equal(t, "http://localhost/category/web Web First prev= next=", readFile(fs, "/out/category/web/index.html"))
+		assert.Equal(t, "http://localhost/category/web/1 Web First prev= next=", readFile(fs, "/out/category/web/1/index.html"))
+		assert.Equal(t, "http://localhost/category/web/go Go prev= next=", readFile(fs, "/out/category/web/go/index.html"))
+		assert.Contains(t, readFile(fs, "/out/sitemap.xml"), "<loc>http://localhost/2020/3/29/second</loc>")
+		assert.Contains(t, readFile(fs, "/out/sitemap.xml"), "<image:image><image:loc>http://localhost/api/v2.1/storage/"+image.Slug+"</image:loc></image:image>")
+		assert.Contains(t, readFile(fs, "/out/sitemap.xml"), "<loc>http://localhost/category/web</loc><lastmod>2020-03-29T10:00:00Z</lastmod>")
+		assert.Equal(t, "original", readFile(fs, "/out/api/v2.1/storage/"+image.Slug))
+		assert.Equal(t, "resized", readFile(fs, "/out"+resized))
+	})
+
+	t.Run("When unable to find all published posts", func(t *testing.T) {
+		// Given
+		postRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, errors.New("test unable to find all published posts"))
+
+		// When
+		err := newGenerator(afero.NewMemMapFs()).Generate(context.Background())
+
+		// Then
+		assert.EqualError(t, err, "test unable to find all published posts")
+	})
+}
@@ -14,6 +14,7 @@ import (
 
 // Cache uses to storing or retrieving files from hidden or inaccessible place
 type Cache interface {
+	Delete(path string) error
 	Exists(path string) bool
 	Retrieve(path string) (io.ReadCloser, error)
 	Store(body io.Reader, path string) error
@@ -0,0 +1,13 @@
+package storage
+
+// FileUploaded is an event which occurs when a new file has been uploaded to the storage server
+type FileUploaded struct{ File File }
+
+// EventName returns a name of the event
+func (FileUploaded) EventName() string { return "file.uploaded" }
+
+// FileDeleted is an event which occurs when a file has been deleted from the storage server
+type FileDeleted struct{ File File }
+
+// EventName returns a name of the event
+func (FileDeleted) EventName() string { return "file.deleted" }
@@ -8,6 +8,9 @@ import (
 	"github.com/nomkhonwaan/myblog/pkg/mongo"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"mime"
+	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -53,6 +56,16 @@ func (f File) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// IsOwnedBy returns "true" if the file was uploaded by the user, an uploaded file path always begins with its owner ID
+func (f File) IsOwnedBy(authorID string) bool {
+	return authorID != "" && strings.HasPrefix(f.Path, authorID+string(filepath.Separator))
+}
+
+// IsImage returns "true" if the file name has an image extension
+func (f File) IsImage() bool {
+	return strings.HasPrefix(mime.TypeByExtension(filepath.Ext(f.FileName)), "image/")
+}
+
 // A fileRepository interface
 type FileRepository interface {
 	Create(ctx context.Context, file File) (File, error)
@@ -40,6 +40,30 @@ func TestFile_MarshalJSON(t *testing.T) {
 	assert.Equal(t, "{\"id\":\""+id.Hex()+"\",\"path\":\"/path/to/the/file.txt\",\"fileName\":\"file.txt\",\"slug\":\"file-"+id.Hex()+".txt\",\"createdAt\":\""+createdAt.Format(time.RFC3339Nano)+"\",\"updatedAt\":\"0001-01-01T00:00:00Z\"}", string(result))
 }
 
+func TestFile_IsOwnedBy(t *testing.T) {
+	// Given
+	file := File{Path: "authorizedID/file.txt"}
+
+	// When
+
+	// Then
+	assert.True(t, file.IsOwnedBy("authorizedID"))
+	assert.False(t, file.IsOwnedBy("authorized"))
+	assert.False(t, file.IsOwnedBy(""))
+}
+
+func TestFile_IsImage(t *testing.T) {
+	// Given
+
+	// When
+
+	// Then
+	assert.True(t, File{FileName: "featured-image.JPG"}.IsImage())
+	assert.True(t, File{FileName: "featured-image.png"}.IsImage())
+	assert.False(t, File{FileName: "attachment.pdf"}.IsImage())
+	assert.False(t, File{FileName: "attachment"}.IsImage())
+}
+
 func TestMongoFileRepository_Create(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
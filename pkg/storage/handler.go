@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/go-chi/chi"
 	"github.com/nomkhonwaan/myblog/pkg/auth"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
 	"github.com/nomkhonwaan/myblog/pkg/image"
 	slugify "github.com/nomkhonwaan/myblog/pkg/slug"
 	"github.com/sirupsen/logrus"
@@ -21,7 +22,7 @@ import (
 )
 
 // DeleteHandlerFunc handles deletion request
-func DeleteHandlerFunc(storage Storage, repository FileRepository) http.HandlerFunc {
+func DeleteHandlerFunc(storage Storage, repository FileRepository, bus eventbus.Bus) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		authorizedID := auth.GetAuthorizedUserID(r.Context())
 		if authorizedID == nil {
@@ -48,6 +49,8 @@ func DeleteHandlerFunc(storage Storage, repository FileRepository) http.HandlerF
 			respondError(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
+
+		bus.Publish(r.Context(), FileDeleted{File: file})
 	}
 }
 
@@ -142,7 +145,7 @@ func downloadOriginalFile(ctx context.Context, storage Storage, cache Cache, pat
 }
 
 // UpdateHandlerFunc handles uploading request
-func UploadHandlerFunc(storage Storage, repository FileRepository) http.HandlerFunc {
+func UploadHandlerFunc(storage Storage, repository FileRepository, bus eventbus.Bus) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 
@@ -184,6 +187,8 @@ func UploadHandlerFunc(storage Storage, repository FileRepository) http.HandlerF
 			return
 		}
 
+		bus.Publish(r.Context(), FileUploaded{File: file})
+
 		val, _ := json.Marshal(file)
 		_, _ = w.Write(val)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/golang/mock/gomock"
 	"github.com/nomkhonwaan/myblog/pkg/auth"
+	mock_eventbus "github.com/nomkhonwaan/myblog/pkg/eventbus/mock"
 	mock_image "github.com/nomkhonwaan/myblog/pkg/image/mock"
 	. "github.com/nomkhonwaan/myblog/pkg/storage"
 	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
@@ -32,6 +33,7 @@ func TestDeleteHandlerFunc(t *testing.T) {
 	var (
 		bucket     = mock_storage.NewMockStorage(ctrl)
 		repository = mock_storage.NewMockFileRepository(ctrl)
+		bus        = mock_eventbus.NewMockBus(ctrl)
 	)
 
 	newDeleteRequest := func(slug string) *http.Request {
@@ -52,9 +54,10 @@ func TestDeleteHandlerFunc(t *testing.T) {
 		repository.EXPECT().FindByID(gomock.Any(), id).Return(File{Path: filepath.Join("authorizedID", slug)}, nil)
 		bucket.EXPECT().Delete(gomock.Any(), filepath.Join("authorizedID", slug)).Return(nil)
 		repository.EXPECT().Delete(gomock.Any(), id).Return(nil)
+		bus.EXPECT().Publish(gomock.Any(), FileDeleted{File: File{Path: filepath.Join("authorizedID", slug)}})
 
 		// When
-		DeleteHandlerFunc(bucket, repository).ServeHTTP(w, withAuthorizedID(newDeleteRequest(slug)))
+		DeleteHandlerFunc(bucket, repository, bus).ServeHTTP(w, withAuthorizedID(newDeleteRequest(slug)))
 
 		// Then
 		assert.Equal(t, "200 OK", w.Result().Status)
@@ -67,7 +70,7 @@ func TestDeleteHandlerFunc(t *testing.T) {
 		slug := "test-" + id.Hex() + ".txt"
 
 		// When
-		DeleteHandlerFunc(bucket, repository).ServeHTTP(w, newDeleteRequest(slug))
+		DeleteHandlerFunc(bucket, repository, bus).ServeHTTP(w, newDeleteRequest(slug))
 
 		// Then
 		assert.Equal(t, `{"error":{"code":401,"message":"Unauthorized"}}`, w.Body.String())
@@ -82,7 +85,7 @@ func TestDeleteHandlerFunc(t *testing.T) {
 		repository.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(File{}, errors.New("test unable to find a file"))
 
 		// When
-		DeleteHandlerFunc(bucket, repository).ServeHTTP(w, withAuthorizedID(newDeleteRequest(slug)))
+		DeleteHandlerFunc(bucket, repository, bus).ServeHTTP(w, withAuthorizedID(newDeleteRequest(slug)))
 
 		// Then
 		assert.Equal(t, `{"error":{"code":404,"message":"test unable to find a file"}}`, w.Body.String())
@@ -98,7 +101,7 @@ func TestDeleteHandlerFunc(t *testing.T) {
 		bucket.EXPECT().Delete(gomock.Any(), filepath.Join("authorizedID", slug)).Return(errors.New("test unable to delete file from storage"))
 
 		// When
-		DeleteHandlerFunc(bucket, repository).ServeHTTP(w, withAuthorizedID(newDeleteRequest(slug)))
+		DeleteHandlerFunc(bucket, repository, bus).ServeHTTP(w, withAuthorizedID(newDeleteRequest(slug)))
 
 		// Then
 		assert.Equal(t, `{"error":{"code":500,"message":"test unable to delete file from storage"}}`, w.Body.String())
@@ -115,7 +118,7 @@ func TestDeleteHandlerFunc(t *testing.T) {
 		repository.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(errors.New("test unable to delete file from database"))
 
 		// When
-		DeleteHandlerFunc(bucket, repository).ServeHTTP(w, withAuthorizedID(newDeleteRequest(slug)))
+		DeleteHandlerFunc(bucket, repository, bus).ServeHTTP(w, withAuthorizedID(newDeleteRequest(slug)))
 
 		// Then
 		assert.Equal(t, `{"error":{"code":500,"message":"test unable to delete file from database"}}`, w.Body.String())
@@ -370,6 +373,7 @@ func TestUploadHandlerFunc(t *testing.T) {
 	var (
 		bucket     = mock_storage.NewMockStorage(ctrl)
 		repository = mock_storage.NewMockFileRepository(ctrl)
+		bus        = mock_eventbus.NewMockBus(ctrl)
 	)
 
 	newUploadRequest := func(fileName string, body io.Reader) *http.Request {
@@ -403,12 +407,13 @@ func TestUploadHandlerFunc(t *testing.T) {
 				Slug:     filepath.Base(path),
 			}
 			repository.EXPECT().Create(gomock.Any(), f).Return(f, nil)
+			bus.EXPECT().Publish(gomock.Any(), FileUploaded{File: f})
 
 			return nil
 		})
 
 		// When
-		UploadHandlerFunc(bucket, repository).ServeHTTP(w, withAuthorizedID(newUploadRequest("test.txt", bytes.NewBufferString("test"))))
+		UploadHandlerFunc(bucket, repository, bus).ServeHTTP(w, withAuthorizedID(newUploadRequest("test.txt", bytes.NewBufferString("test"))))
 
 		// Then
 		var f File
@@ -423,7 +428,7 @@ func TestUploadHandlerFunc(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		// When
-		UploadHandlerFunc(bucket, repository).ServeHTTP(w, newUploadRequest("test.txt", bytes.NewBufferString("test")))
+		UploadHandlerFunc(bucket, repository, bus).ServeHTTP(w, newUploadRequest("test.txt", bytes.NewBufferString("test")))
 
 		// Then
 		assert.Equal(t, `{"error":{"code":401,"message":"Unauthorized"}}`, w.Body.String())
@@ -434,7 +439,7 @@ func TestUploadHandlerFunc(t *testing.T) {
 		w := httptest.NewRecorder()
 
 		// When
-		UploadHandlerFunc(bucket, repository).ServeHTTP(w, withAuthorizedID(newUploadRequest("", bytes.NewBufferString("test"))))
+		UploadHandlerFunc(bucket, repository, bus).ServeHTTP(w, withAuthorizedID(newUploadRequest("", bytes.NewBufferString("test"))))
 
 		// Then
 		assert.Equal(t, `{"error":{"code":500,"message":"http: no such file"}}`, w.Body.String())
@@ -447,7 +452,7 @@ func TestUploadHandlerFunc(t *testing.T) {
 		bucket.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("test unable to upload file to storage"))
 
 		// When
-		UploadHandlerFunc(bucket, repository).ServeHTTP(w, withAuthorizedID(newUploadRequest("test.txt", bytes.NewBufferString("test"))))
+		UploadHandlerFunc(bucket, repository, bus).ServeHTTP(w, withAuthorizedID(newUploadRequest("test.txt", bytes.NewBufferString("test"))))
 
 		// Then
 		assert.Equal(t, `{"error":{"code":500,"message":"test unable to upload file to storage"}}`, w.Body.String())
@@ -461,7 +466,7 @@ func TestUploadHandlerFunc(t *testing.T) {
 		repository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(File{}, errors.New("test unable to create new record on database"))
 
 		// When
-		UploadHandlerFunc(bucket, repository).ServeHTTP(w, withAuthorizedID(newUploadRequest("test.txt", bytes.NewBufferString("test"))))
+		UploadHandlerFunc(bucket, repository, bus).ServeHTTP(w, withAuthorizedID(newUploadRequest("test.txt", bytes.NewBufferString("test"))))
 
 		// Then
 		assert.Equal(t, `{"error":{"code":500,"message":"test unable to create new record on database"}}`, w.Body.String())
@@ -16,10 +16,10 @@ func (s Slug) GetID() (interface{}, error) {
 	return primitive.ObjectIDFromHex(fileName[0 : len(fileName)-len(filepath.Ext(fileName))])
 }
 
-// MustGetID always return ID from the slug string
+// MustGetID always return ID from the slug string, a malformed slug returns the zero ID which matches nothing
 func (s Slug) MustGetID() interface{} {
 	if id, err := s.GetID(); err == nil {
 		return id
 	}
-	return primitive.NewObjectID()
+	return primitive.NilObjectID
 }
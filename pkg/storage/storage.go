@@ -11,5 +11,6 @@ import (
 type Storage interface {
 	Delete(ctx context.Context, path string) error
 	Download(ctx context.Context, path string) (io.ReadCloser, error)
+	Exists(ctx context.Context, path string) (bool, error)
 	Upload(ctx context.Context, body io.Reader, path string) error
 }
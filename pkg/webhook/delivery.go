@@ -0,0 +1,139 @@
+//go:generate mockgen -destination=./mock/delivery_mock.go github.com/nomkhonwaan/myblog/pkg/webhook DeliveryRepository
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+// Delivery is a single attempt of sending the event payload to the webhook
+type Delivery struct {
+	// Identifier of the delivery attempt
+	ID primitive.ObjectID `bson:"_id" json:"id" graphql:"-"`
+
+	// Identifier of the webhook
+	WebhookID primitive.ObjectID `bson:"webhookId" json:"webhookId" graphql:"-"`
+
+	// Identifier of the event payload which is the same on every retry
+	EventID string `bson:"eventId" json:"eventId" graphql:"eventId"`
+
+	// Name of the event
+	Event Event `bson:"event" json:"event" graphql:"event"`
+
+	// A URL of the webhook at the time of delivery
+	URL string `bson:"url" json:"url" graphql:"url"`
+
+	// A signed JSON payload
+	Payload string `bson:"payload" json:"payload" graphql:"payload"`
+
+	// Number of the attempt, starts from 1
+	Attempt int `bson:"attempt" json:"attempt" graphql:"attempt"`
+
+	// HTTP status code of the response, zero if unable to connect
+	StatusCode int `bson:"statusCode" json:"statusCode" graphql:"statusCode"`
+
+	// An error message if the attempt was failed
+	Error string `bson:"error" json:"error,omitempty" graphql:"error"`
+
+	// Indicates that the webhook responded with 2xx status code
+	Succeeded bool `bson:"succeeded" json:"succeeded" graphql:"succeeded"`
+
+	// Indicates that the attempt has not been made yet since the server was shutting down,
+	// it will be made on the next start
+	Pending bool `bson:"pending" json:"pending" graphql:"pending"`
+
+	// Date-time that the attempt was made
+	CreatedAt time.Time `bson:"createdAt" json:"createdAt" graphql:"createdAt"`
+}
+
+// MarshalJSON is a custom JSON marshaling function of delivery entity
+func (d Delivery) MarshalJSON() ([]byte, error) {
+	type Alias Delivery
+	return json.Marshal(&struct {
+		ID        string `json:"id"`
+		WebhookID string `json:"webhookId"`
+		*Alias
+	}{
+		ID:        d.ID.Hex(),
+		WebhookID: d.WebhookID.Hex(),
+		Alias:     (*Alias)(&d),
+	})
+}
+
+// A DeliveryRepository interface
+type DeliveryRepository interface {
+	Create(ctx context.Context, d Delivery) (Delivery, error)
+	Delete(ctx context.Context, id interface{}) error
+	FindAll(ctx context.Context, webhookID primitive.ObjectID, offset, limit int64) ([]Delivery, error)
+	FindAllPending(ctx context.Context) ([]Delivery, error)
+}
+
+// NewDeliveryRepository returns a MongoDeliveryRepository instance
+func NewDeliveryRepository(db mongo.Database) DeliveryRepository {
+	return MongoDeliveryRepository{col: mongo.NewCollection(db.Collection("webhook_deliveries"))}
+}
+
+// MongoDeliveryRepository implements DeliveryRepository on MongoDB
+type MongoDeliveryRepository struct{ col mongo.Collection }
+
+// Create inserts a new delivery attempt
+func (repo MongoDeliveryRepository) Create(ctx context.Context, d Delivery) (Delivery, error) {
+	d.ID = primitive.NewObjectID()
+	if d.CreatedAt.IsZero() {
+		d.CreatedAt = time.Now()
+	}
+
+	doc, _ := bson.Marshal(d)
+	_, err := repo.col.InsertOne(ctx, doc)
+	if err != nil {
+		return Delivery{}, err
+	}
+
+	return d, nil
+}
+
+// Delete performs deletion a delivery attempt by its ID
+func (repo MongoDeliveryRepository) Delete(ctx context.Context, id interface{}) error {
+	_, err := repo.col.DeleteOne(ctx, bson.M{"_id": id.(primitive.ObjectID)})
+	return err
+}
+
+// FindAll returns list of delivery attempts sorted by the latest first,
+// all webhooks will be included if the webhook ID is zero
+func (repo MongoDeliveryRepository) FindAll(ctx context.Context, webhookID primitive.ObjectID, offset, limit int64) ([]Delivery, error) {
+	filter := bson.M{}
+	if !webhookID.IsZero() {
+		filter["webhookId"] = webhookID
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{"createdAt", -1}, {"_id", -1}}).
+		SetSkip(offset).
+		SetLimit(limit)
+
+	return repo.find(ctx, filter, opts)
+}
+
+// FindAllPending returns list of pending delivery attempts sorted by the oldest first
+func (repo MongoDeliveryRepository) FindAllPending(ctx context.Context) ([]Delivery, error) {
+	return repo.find(ctx, bson.M{"pending": true}, options.Find().SetSort(bson.D{{"createdAt", 1}, {"_id", 1}}))
+}
+
+func (repo MongoDeliveryRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]Delivery, error) {
+	cur, err := repo.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var deliveries []Delivery
+	err = cur.Decode(&deliveries)
+
+	return deliveries, err
+}
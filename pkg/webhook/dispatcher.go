@@ -0,0 +1,229 @@
+//go:generate mockgen -destination=./mock/dispatcher_mock.go github.com/nomkhonwaan/myblog/pkg/webhook Dispatcher
+
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Payload is a JSON body which will be sent to the webhook
+type Payload struct {
+	// Identifier of the event payload
+	ID string `json:"id"`
+
+	// Name of the event
+	Event Event `json:"event"`
+
+	// Date-time that the event occurred
+	CreatedAt time.Time `json:"createdAt"`
+
+	// An entity which relates to the event, e.g. post or file
+	Data interface{} `json:"data"`
+}
+
+// Sign returns a hex-encoded HMAC-SHA256 signature of the body with "sha256=" prefix
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// A Dispatcher interface
+type Dispatcher interface {
+	Dispatch(ctx context.Context, event Event, data interface{})
+}
+
+// HTTPDispatcher sends the event payload to all subscribed webhooks in background,
+// a failed delivery will be retried with an exponential backoff until the dispatcher has been shut down
+type HTTPDispatcher struct {
+	repository         WebhookRepository
+	deliveryRepository DeliveryRepository
+	client             *http.Client
+	maxAttempts        int
+	backoff            time.Duration
+
+	// A context of all deliveries in progress which will be canceled on shutdown
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	wg sync.WaitGroup
+}
+
+// NewHTTPDispatcher returns a new HTTPDispatcher which makes at most maxAttempts attempts for each delivery,
+// the delay between attempts starts from the backoff and doubles on every retry
+func NewHTTPDispatcher(repository WebhookRepository, deliveryRepository DeliveryRepository, transport http.RoundTripper, maxAttempts int, backoff time.Duration) *HTTPDispatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &HTTPDispatcher{
+		repository:         repository,
+		deliveryRepository: deliveryRepository,
+		client:             &http.Client{Transport: transport, Timeout: time.Second * 30},
+		maxAttempts:        maxAttempts,
+		backoff:            backoff,
+		ctx:                ctx,
+		cancel:             cancel,
+	}
+}
+
+// Dispatch sends the event payload to all webhooks which subscribe to the event without waiting for the responses
+func (d *HTTPDispatcher) Dispatch(ctx context.Context, event Event, data interface{}) {
+	webhooks, err := d.repository.FindAllByEvent(ctx, event)
+	if err != nil {
+		logrus.Errorf("unable to find webhooks of the event %s: %s", event, err)
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	p := Payload{ID: primitive.NewObjectID().Hex(), Event: event, CreatedAt: time.Now(), Data: data}
+	body, err := json.Marshal(p)
+	if err != nil {
+		logrus.Errorf("unable to encode payload of the event %s: %s", event, err)
+		return
+	}
+
+	for _, wh := range webhooks {
+		d.start(wh, Delivery{EventID: p.ID, Event: p.Event, Payload: string(body), Attempt: 1})
+	}
+}
+
+// Resume continues all pending deliveries which were left by the previous shutdown in background,
+// the pending deliveries of the deleted webhooks are discarded
+func (d *HTTPDispatcher) Resume(ctx context.Context) error {
+	pending, err := d.deliveryRepository.FindAllPending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	webhooks, err := d.repository.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+	byID := make(map[primitive.ObjectID]Webhook, len(webhooks))
+	for _, wh := range webhooks {
+		byID[wh.ID] = wh
+	}
+
+	for _, delivery := range pending {
+		if err = d.deliveryRepository.Delete(ctx, delivery.ID); err != nil {
+			return err
+		}
+		if wh, ok := byID[delivery.WebhookID]; ok {
+			d.start(wh, Delivery{EventID: delivery.EventID, Event: delivery.Event, Payload: delivery.Payload, Attempt: delivery.Attempt})
+		}
+	}
+	return nil
+}
+
+// Wait blocks until all deliveries in progress are finished
+func (d *HTTPDispatcher) Wait() {
+	d.wg.Wait()
+}
+
+// Shutdown cancels all deliveries in progress and waits until their remaining attempts are stored as pending
+func (d *HTTPDispatcher) Shutdown() {
+	d.cancel()
+	d.wg.Wait()
+}
+
+func (d *HTTPDispatcher) start(wh Webhook, delivery Delivery) {
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		d.deliver(d.ctx, wh, delivery)
+	}()
+}
+
+// deliver makes the attempts from the attempt number of the delivery,
+// the next attempt is stored as pending once the context has been canceled
+func (d *HTTPDispatcher) deliver(ctx context.Context, wh Webhook, delivery Delivery) {
+	backoff := d.backoff
+
+	for ; delivery.Attempt <= d.maxAttempts; delivery.Attempt++ {
+		result := d.send(ctx, wh, delivery)
+		if ctx.Err() != nil {
+			d.storePending(wh, delivery)
+			return
+		}
+
+		if _, err := d.deliveryRepository.Create(context.Background(), result); err != nil {
+			logrus.Errorf("unable to store delivery of the event %s: %s", delivery.Event, err)
+		}
+		if result.Succeeded {
+			return
+		}
+
+		logrus.Warnf("unable to deliver the event %s to %s on attempt %d: %s", delivery.Event, wh.URL, delivery.Attempt, result.Error)
+		if delivery.Attempt < d.maxAttempts {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				delivery.Attempt++
+				d.storePending(wh, delivery)
+				return
+			case <-timer.C:
+			}
+			backoff *= 2
+		}
+	}
+}
+
+func (d *HTTPDispatcher) storePending(wh Webhook, delivery Delivery) {
+	delivery.WebhookID = wh.ID
+	delivery.URL = wh.URL
+	delivery.Pending = true
+
+	// The context of the delivery has already been canceled
+	if _, err := d.deliveryRepository.Create(context.Background(), delivery); err != nil {
+		logrus.Errorf("unable to store pending delivery of the event %s: %s", delivery.Event, err)
+	}
+}
+
+func (d *HTTPDispatcher) send(ctx context.Context, wh Webhook, delivery Delivery) Delivery {
+	delivery.WebhookID = wh.ID
+	delivery.URL = wh.URL
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "myblog-webhook")
+	req.Header.Set("X-Webhook-Event", delivery.Event.String())
+	req.Header.Set("X-Webhook-Delivery", delivery.EventID)
+	req.Header.Set("X-Webhook-Signature", Sign(wh.Secret, []byte(delivery.Payload)))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+
+	delivery.StatusCode = res.StatusCode
+	delivery.Succeeded = res.StatusCode >= 200 && res.StatusCode < 300
+	if !delivery.Succeeded {
+		delivery.Error = fmt.Sprintf("unexpected status code %d", res.StatusCode)
+	}
+
+	return delivery
+}
@@ -0,0 +1,259 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/golang/mock/gomock"
+	. "github.com/nomkhonwaan/myblog/pkg/webhook"
+	mock_webhook "github.com/nomkhonwaan/myblog/pkg/webhook/mock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	// Given
+	body := []byte(`{"event":"post.published"}`)
+
+	// When
+	result := Sign("secret", body)
+
+	// Then
+	assert.Equal(t, "sha256=7ed90df2252e27588d6f3be4a105569d03af742342edbc76d53781ef4b375a95", result)
+}
+
+func TestHTTPDispatcher_Dispatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository         = mock_webhook.NewMockWebhookRepository(ctrl)
+		deliveryRepository = mock_webhook.NewMockDeliveryRepository(ctrl)
+	)
+
+	ctx := context.Background()
+
+	t.Run("With successful delivering signed payload", func(t *testing.T) {
+		// Given
+		var received *http.Request
+		var body []byte
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = ioutil.ReadAll(r.Body)
+		}))
+		defer srv.Close()
+
+		wh := Webhook{ID: primitive.NewObjectID(), URL: srv.URL, Events: []Event{EventPostPublished}, Secret: "secret"}
+		repository.EXPECT().FindAllByEvent(ctx, EventPostPublished).Return([]Webhook{wh}, nil)
+		deliveryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d Delivery) (Delivery, error) {
+			assert.Equal(t, wh.ID, d.WebhookID)
+			assert.Equal(t, 1, d.Attempt)
+			assert.Equal(t, http.StatusOK, d.StatusCode)
+			assert.True(t, d.Succeeded)
+			return d, nil
+		})
+		d := NewHTTPDispatcher(repository, deliveryRepository, http.DefaultTransport, 3, time.Millisecond)
+
+		// When
+		d.Dispatch(ctx, EventPostPublished, map[string]string{"title": "Test"})
+		d.Wait()
+
+		// Then
+		var p struct {
+			ID    string            `json:"id"`
+			Event Event             `json:"event"`
+			Data  map[string]string `json:"data"`
+		}
+		assert.Nil(t, json.Unmarshal(body, &p))
+		assert.Equal(t, EventPostPublished, p.Event)
+		assert.Equal(t, map[string]string{"title": "Test"}, p.Data)
+		assert.Equal(t, "post.published", received.Header.Get("X-Webhook-Event"))
+		assert.Equal(t, p.ID, received.Header.Get("X-Webhook-Delivery"))
+		assert.Equal(t, Sign("secret", body), received.Header.Get("X-Webhook-Signature"))
+	})
+
+	t.Run("When the webhook responds with an error status code", func(t *testing.T) {
+		// Given
+		var (
+			mu       sync.Mutex
+			attempts []int
+		)
+		calls := 0
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}))
+		defer srv.Close()
+
+		repository.EXPECT().FindAllByEvent(ctx, EventFileDeleted).Return([]Webhook{{URL: srv.URL}}, nil)
+		deliveryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d Delivery) (Delivery, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			attempts = append(attempts, d.StatusCode)
+			return d, nil
+		}).Times(3)
+		d := NewHTTPDispatcher(repository, deliveryRepository, http.DefaultTransport, 5, time.Millisecond)
+
+		// When
+		d.Dispatch(ctx, EventFileDeleted, nil)
+		d.Wait()
+
+		// Then
+		assert.Equal(t, []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK}, attempts)
+	})
+
+	t.Run("When unable to deliver after the maximum attempts", func(t *testing.T) {
+		// Given
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+
+		repository.EXPECT().FindAllByEvent(ctx, EventFileUploaded).Return([]Webhook{{URL: srv.URL}}, nil)
+		deliveryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d Delivery) (Delivery, error) {
+			assert.False(t, d.Succeeded)
+			assert.Equal(t, "unexpected status code 500", d.Error)
+			return d, nil
+		}).Times(2)
+		d := NewHTTPDispatcher(repository, deliveryRepository, http.DefaultTransport, 2, time.Millisecond)
+
+		// When
+		d.Dispatch(ctx, EventFileUploaded, nil)
+		d.Wait()
+
+		// Then
+	})
+
+	t.Run("When the dispatcher has been shut down while waiting for the next attempt", func(t *testing.T) {
+		// Given
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+
+		wh := Webhook{ID: primitive.NewObjectID(), URL: srv.URL}
+		attempted := make(chan struct{})
+		repository.EXPECT().FindAllByEvent(ctx, EventPostPublished).Return([]Webhook{wh}, nil)
+		deliveryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d Delivery) (Delivery, error) {
+			assert.Equal(t, 1, d.Attempt)
+			assert.False(t, d.Pending)
+			close(attempted)
+			return d, nil
+		})
+		deliveryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d Delivery) (Delivery, error) {
+			assert.Equal(t, wh.ID, d.WebhookID)
+			assert.Equal(t, 2, d.Attempt)
+			assert.True(t, d.Pending)
+			assert.NotEmpty(t, d.Payload)
+			return d, nil
+		})
+		d := NewHTTPDispatcher(repository, deliveryRepository, http.DefaultTransport, 5, time.Hour)
+
+		// When
+		d.Dispatch(ctx, EventPostPublished, nil)
+		<-attempted
+		done := make(chan struct{})
+		go func() {
+			d.Shutdown()
+			close(done)
+		}()
+
+		// Then
+		select {
+		case <-done:
+		case <-time.After(time.Second * 5):
+			t.Fatal("the dispatcher has not been shut down")
+		}
+	})
+
+	t.Run("When unable to find webhooks", func(t *testing.T) {
+		// Given
+		repository.EXPECT().FindAllByEvent(ctx, EventPostUpdated).Return(nil, errors.New("test unable to find webhooks"))
+		d := NewHTTPDispatcher(repository, deliveryRepository, http.DefaultTransport, 2, time.Millisecond)
+
+		// When
+		d.Dispatch(ctx, EventPostUpdated, nil)
+		d.Wait()
+
+		// Then
+	})
+}
+
+func TestHTTPDispatcher_Resume(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		repository         = mock_webhook.NewMockWebhookRepository(ctrl)
+		deliveryRepository = mock_webhook.NewMockDeliveryRepository(ctrl)
+	)
+
+	ctx := context.Background()
+
+	t.Run("With successful resuming the pending deliveries", func(t *testing.T) {
+		// Given
+		var received *http.Request
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+		}))
+		defer srv.Close()
+
+		wh := Webhook{ID: primitive.NewObjectID(), URL: srv.URL, Secret: "secret"}
+		pending := []Delivery{
+			{ID: primitive.NewObjectID(), WebhookID: wh.ID, EventID: "1", Event: EventPostPublished, Payload: `{"id":"1"}`, Attempt: 3, Pending: true},
+			{ID: primitive.NewObjectID(), WebhookID: primitive.NewObjectID(), EventID: "2", Event: EventPostPublished, Payload: `{"id":"2"}`, Attempt: 1, Pending: true},
+		}
+		deliveryRepository.EXPECT().FindAllPending(ctx).Return(pending, nil)
+		repository.EXPECT().FindAll(ctx).Return([]Webhook{wh}, nil)
+		deliveryRepository.EXPECT().Delete(ctx, pending[0].ID).Return(nil)
+		deliveryRepository.EXPECT().Delete(ctx, pending[1].ID).Return(nil)
+		deliveryRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d Delivery) (Delivery, error) {
+			assert.Equal(t, 3, d.Attempt)
+			assert.True(t, d.Succeeded)
+			assert.False(t, d.Pending)
+			return d, nil
+		})
+		d := NewHTTPDispatcher(repository, deliveryRepository, http.DefaultTransport, 5, time.Millisecond)
+
+		// When
+		err := d.Resume(ctx)
+		d.Wait()
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, "1", received.Header.Get("X-Webhook-Delivery"))
+		assert.Equal(t, Sign("secret", []byte(`{"id":"1"}`)), received.Header.Get("X-Webhook-Signature"))
+	})
+
+	t.Run("Without pending deliveries", func(t *testing.T) {
+		// Given
+		deliveryRepository.EXPECT().FindAllPending(ctx).Return(nil, nil)
+		d := NewHTTPDispatcher(repository, deliveryRepository, http.DefaultTransport, 5, time.Millisecond)
+
+		// When
+		err := d.Resume(ctx)
+
+		// Then
+		assert.Nil(t, err)
+	})
+
+	t.Run("When unable to find the pending deliveries", func(t *testing.T) {
+		// Given
+		deliveryRepository.EXPECT().FindAllPending(ctx).Return(nil, errors.New("test unable to find pending deliveries"))
+		d := NewHTTPDispatcher(repository, deliveryRepository, http.DefaultTransport, 5, time.Millisecond)
+
+		// When
+		err := d.Resume(ctx)
+
+		// Then
+		assert.EqualError(t, err, "test unable to find pending deliveries")
+	})
+}
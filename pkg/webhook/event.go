@@ -0,0 +1,42 @@
+package webhook
+
+// Event is a name of the content event which can be subscribed by a webhook
+type Event string
+
+func (e Event) String() string {
+	return string(e)
+}
+
+// IsValid returns "true" if the event is one of the supported events
+func (e Event) IsValid() bool {
+	for _, v := range Events {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// EventPostPublished indicates that a draft post has been published
+const EventPostPublished Event = "post.published"
+
+// EventPostUpdated indicates that a published post has been changed
+const EventPostUpdated Event = "post.updated"
+
+// EventPostUnpublished indicates that a published post has been moved back to draft
+const EventPostUnpublished Event = "post.unpublished"
+
+// EventFileUploaded indicates that a new file has been uploaded to the storage server
+const EventFileUploaded Event = "file.uploaded"
+
+// EventFileDeleted indicates that a file has been deleted from the storage server
+const EventFileDeleted Event = "file.deleted"
+
+// Events is a list of all supported events
+var Events = []Event{
+	EventPostPublished,
+	EventPostUpdated,
+	EventPostUnpublished,
+	EventFileUploaded,
+	EventFileDeleted,
+}
@@ -0,0 +1,94 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	. "github.com/nomkhonwaan/myblog/pkg/webhook"
+	mock_webhook "github.com/nomkhonwaan/myblog/pkg/webhook/mock"
+	"github.com/stretchr/testify/assert"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestValidateHost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		resolver = mock_webhook.NewMockResolver(ctrl)
+	)
+
+	t.Run("With a public host", func(t *testing.T) {
+		// Given
+		u, _ := url.Parse("https://example.com/hook")
+		resolver.EXPECT().LookupIPAddr(gomock.Any(), "example.com").Return([]net.IPAddr{{IP: net.ParseIP("93.184.216.34")}}, nil)
+
+		// When
+		err := ValidateHost(context.Background(), resolver, u)
+
+		// Then
+		assert.Nil(t, err)
+	})
+
+	t.Run("With a host which resolves to a private address", func(t *testing.T) {
+		// Given
+		u, _ := url.Parse("https://internal.example.com/hook")
+		resolver.EXPECT().LookupIPAddr(gomock.Any(), "internal.example.com").
+			Return([]net.IPAddr{{IP: net.ParseIP("93.184.216.34")}, {IP: net.ParseIP("10.0.0.1")}}, nil)
+
+		// When
+		err := ValidateHost(context.Background(), resolver, u)
+
+		// Then
+		assert.Equal(t, ErrInternalHost, err)
+	})
+
+	tests := map[string]string{
+		"With a loopback address":   "http://127.0.0.1:8080/hook",
+		"With an IPv6 loopback":     "http://[::1]/hook",
+		"With a link-local address": "http://169.254.169.254/latest/meta-data",
+		"With a private address":    "http://192.168.1.1/hook",
+		"With an unspecified host":  "http://0.0.0.0/hook",
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Given
+			u, _ := url.Parse(test)
+
+			// When
+			err := ValidateHost(context.Background(), resolver, u)
+
+			// Then
+			assert.Equal(t, ErrInternalHost, err)
+		})
+	}
+
+	t.Run("When unable to resolve the host", func(t *testing.T) {
+		// Given
+		u, _ := url.Parse("https://unknown.example.com/hook")
+		resolver.EXPECT().LookupIPAddr(gomock.Any(), "unknown.example.com").Return(nil, errors.New("test unable to resolve"))
+
+		// When
+		err := ValidateHost(context.Background(), resolver, u)
+
+		// Then
+		assert.EqualError(t, err, "test unable to resolve")
+	})
+}
+
+func TestNewPublicTransport(t *testing.T) {
+	// Given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	defer server.Close()
+	client := &http.Client{Transport: NewPublicTransport()}
+
+	// When
+	_, err := client.Get(server.URL)
+
+	// Then
+	assert.True(t, errors.Is(err, ErrInternalHost))
+}
@@ -0,0 +1,47 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/nomkhonwaan/myblog/pkg/webhook (interfaces: Dispatcher)
+
+// Package mock_webhook is a generated GoMock package.
+package mock_webhook
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	webhook "github.com/nomkhonwaan/myblog/pkg/webhook"
+	reflect "reflect"
+)
+
+// MockDispatcher is a mock of Dispatcher interface
+type MockDispatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockDispatcherMockRecorder
+}
+
+// MockDispatcherMockRecorder is the mock recorder for MockDispatcher
+type MockDispatcherMockRecorder struct {
+	mock *MockDispatcher
+}
+
+// NewMockDispatcher creates a new mock instance
+func NewMockDispatcher(ctrl *gomock.Controller) *MockDispatcher {
+	mock := &MockDispatcher{ctrl: ctrl}
+	mock.recorder = &MockDispatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDispatcher) EXPECT() *MockDispatcherMockRecorder {
+	return m.recorder
+}
+
+// Dispatch mocks base method
+func (m *MockDispatcher) Dispatch(arg0 context.Context, arg1 webhook.Event, arg2 interface{}) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Dispatch", arg0, arg1, arg2)
+}
+
+// Dispatch indicates an expected call of Dispatch
+func (mr *MockDispatcherMockRecorder) Dispatch(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dispatch", reflect.TypeOf((*MockDispatcher)(nil).Dispatch), arg0, arg1, arg2)
+}
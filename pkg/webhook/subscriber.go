@@ -0,0 +1,30 @@
+package webhook
+
+import (
+	"context"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+)
+
+// Subscribe registers handlers on the bus which dispatch all content events to the subscribed webhooks
+func Subscribe(bus eventbus.Bus, dispatcher Dispatcher) {
+	bus.Subscribe(blog.PostPublished{}, func(ctx context.Context, e eventbus.Event) {
+		dispatcher.Dispatch(ctx, EventPostPublished, e.(blog.PostPublished).Post)
+	})
+	bus.Subscribe(blog.PostUnpublished{}, func(ctx context.Context, e eventbus.Event) {
+		dispatcher.Dispatch(ctx, EventPostUnpublished, e.(blog.PostUnpublished).Post)
+	})
+	bus.Subscribe(blog.PostContentChanged{}, func(ctx context.Context, e eventbus.Event) {
+		// Changes on the draft post are not visible to the downstream
+		if p := e.(blog.PostContentChanged).Post; p.Status.IsPublished() {
+			dispatcher.Dispatch(ctx, EventPostUpdated, p)
+		}
+	})
+	bus.Subscribe(storage.FileUploaded{}, func(ctx context.Context, e eventbus.Event) {
+		dispatcher.Dispatch(ctx, EventFileUploaded, e.(storage.FileUploaded).File)
+	})
+	bus.Subscribe(storage.FileDeleted{}, func(ctx context.Context, e eventbus.Event) {
+		dispatcher.Dispatch(ctx, EventFileDeleted, e.(storage.FileDeleted).File)
+	})
+}
@@ -0,0 +1,70 @@
+package webhook_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	"github.com/nomkhonwaan/myblog/pkg/eventbus"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	. "github.com/nomkhonwaan/myblog/pkg/webhook"
+	mock_webhook "github.com/nomkhonwaan/myblog/pkg/webhook/mock"
+	"testing"
+)
+
+func TestSubscribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		dispatcher = mock_webhook.NewMockDispatcher(ctrl)
+	)
+
+	bus := eventbus.NewLocalBus()
+	Subscribe(bus, dispatcher)
+
+	published := blog.Post{Title: "Test", Status: blog.StatusPublished}
+	draft := blog.Post{Title: "Test", Status: blog.StatusDraft}
+	file := storage.File{FileName: "test.txt"}
+
+	tests := map[string]struct {
+		event eventbus.Event
+		setup func()
+	}{
+		"With published post": {
+			event: blog.PostPublished{Post: published},
+			setup: func() { dispatcher.EXPECT().Dispatch(gomock.Any(), EventPostPublished, published) },
+		},
+		"With unpublished post": {
+			event: blog.PostUnpublished{Post: draft},
+			setup: func() { dispatcher.EXPECT().Dispatch(gomock.Any(), EventPostUnpublished, draft) },
+		},
+		"With changed published post": {
+			event: blog.PostContentChanged{Post: published},
+			setup: func() { dispatcher.EXPECT().Dispatch(gomock.Any(), EventPostUpdated, published) },
+		},
+		"With changed draft post": {
+			event: blog.PostContentChanged{Post: draft},
+			setup: func() {},
+		},
+		"With uploaded file": {
+			event: storage.FileUploaded{File: file},
+			setup: func() { dispatcher.EXPECT().Dispatch(gomock.Any(), EventFileUploaded, file) },
+		},
+		"With deleted file": {
+			event: storage.FileDeleted{File: file},
+			setup: func() { dispatcher.EXPECT().Dispatch(gomock.Any(), EventFileDeleted, file) },
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Given
+			test.setup()
+
+			// When
+			bus.Publish(context.Background(), test.event)
+
+			// Then
+		})
+	}
+}
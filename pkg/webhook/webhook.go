@@ -0,0 +1,101 @@
+//go:generate mockgen -destination=./mock/webhook_mock.go github.com/nomkhonwaan/myblog/pkg/webhook WebhookRepository
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/nomkhonwaan/myblog/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// Webhook is a downstream URL which will be notified when any of the subscribed events occurred
+type Webhook struct {
+	// Identifier of the webhook
+	ID primitive.ObjectID `bson:"_id" json:"id" graphql:"-"`
+
+	// A URL which receives the event payload
+	URL string `bson:"url" json:"url" graphql:"url"`
+
+	// List of subscribed events
+	Events []Event `bson:"events" json:"events" graphql:"events"`
+
+	// A shared secret for signing the event payload, never be exposed
+	Secret string `bson:"secret" json:"-" graphql:"-"`
+
+	// Date-time that the webhook was created
+	CreatedAt time.Time `bson:"createdAt" json:"createdAt" graphql:"createdAt"`
+}
+
+// MarshalJSON is a custom JSON marshaling function of webhook entity
+func (wh Webhook) MarshalJSON() ([]byte, error) {
+	type Alias Webhook
+	return json.Marshal(&struct {
+		ID string `json:"id"`
+		*Alias
+	}{
+		ID:    wh.ID.Hex(),
+		Alias: (*Alias)(&wh),
+	})
+}
+
+// A WebhookRepository interface
+type WebhookRepository interface {
+	Create(ctx context.Context, wh Webhook) (Webhook, error)
+	Delete(ctx context.Context, id interface{}) error
+	FindAll(ctx context.Context) ([]Webhook, error)
+	FindAllByEvent(ctx context.Context, event Event) ([]Webhook, error)
+}
+
+// NewWebhookRepository returns a MongoWebhookRepository instance
+func NewWebhookRepository(db mongo.Database) WebhookRepository {
+	return MongoWebhookRepository{col: mongo.NewCollection(db.Collection("webhooks"))}
+}
+
+// MongoWebhookRepository implements WebhookRepository on MongoDB
+type MongoWebhookRepository struct{ col mongo.Collection }
+
+// Create inserts a new webhook
+func (repo MongoWebhookRepository) Create(ctx context.Context, wh Webhook) (Webhook, error) {
+	wh.ID = primitive.NewObjectID()
+	wh.CreatedAt = time.Now()
+
+	doc, _ := bson.Marshal(wh)
+	_, err := repo.col.InsertOne(ctx, doc)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	return wh, nil
+}
+
+// Delete performs deletion a webhook by its ID
+func (repo MongoWebhookRepository) Delete(ctx context.Context, id interface{}) error {
+	_, err := repo.col.DeleteOne(ctx, bson.M{"_id": id.(primitive.ObjectID)})
+	return err
+}
+
+// FindAll returns list of all webhooks
+func (repo MongoWebhookRepository) FindAll(ctx context.Context) ([]Webhook, error) {
+	return repo.find(ctx, bson.M{})
+}
+
+// FindAllByEvent returns list of webhooks which subscribe to the event
+func (repo MongoWebhookRepository) FindAllByEvent(ctx context.Context, event Event) ([]Webhook, error) {
+	return repo.find(ctx, bson.M{"events": event})
+}
+
+func (repo MongoWebhookRepository) find(ctx context.Context, filter bson.M) ([]Webhook, error) {
+	cur, err := repo.col.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var webhooks []Webhook
+	err = cur.Decode(&webhooks)
+
+	return webhooks, err
+}
@@ -0,0 +1,194 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/golang/mock/gomock"
+	mock_mongo "github.com/nomkhonwaan/myblog/pkg/mongo/mock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	mgo "go.mongodb.org/mongo-driver/mongo"
+	"testing"
+)
+
+func TestEvent_IsValid(t *testing.T) {
+	assert.True(t, EventPostPublished.IsValid())
+	assert.False(t, Event("post.deleted").IsValid())
+}
+
+func TestWebhook_MarshalJSON(t *testing.T) {
+	// Given
+	id := primitive.NewObjectID()
+	wh := Webhook{ID: id, URL: "https://example.com/hook", Events: []Event{EventPostPublished}, Secret: "secret"}
+
+	// When
+	result, err := json.Marshal(wh)
+
+	// Then
+	assert.Nil(t, err)
+	assert.NotContains(t, string(result), "secret")
+	assert.Contains(t, string(result), "\"id\":\""+id.Hex()+"\"")
+}
+
+func TestMongoWebhookRepository_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		col = mock_mongo.NewMockCollection(ctrl)
+	)
+
+	ctx := context.Background()
+	repo := MongoWebhookRepository{col: col}
+
+	t.Run("With successful creating a new webhook", func(t *testing.T) {
+		// Given
+		col.EXPECT().InsertOne(ctx, gomock.Any()).Return(&mgo.InsertOneResult{}, nil)
+
+		// When
+		result, err := repo.Create(ctx, Webhook{URL: "https://example.com/hook", Events: []Event{EventPostPublished}})
+
+		// Then
+		assert.Nil(t, err)
+		assert.False(t, result.ID.IsZero())
+		assert.False(t, result.CreatedAt.IsZero())
+	})
+
+	t.Run("When unable to create a new webhook", func(t *testing.T) {
+		// Given
+		col.EXPECT().InsertOne(ctx, gomock.Any()).Return(nil, errors.New("test unable to create a new webhook"))
+
+		// When
+		_, err := repo.Create(ctx, Webhook{URL: "https://example.com/hook"})
+
+		// Then
+		assert.EqualError(t, err, "test unable to create a new webhook")
+	})
+}
+
+func TestMongoWebhookRepository_FindAllByEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		col = mock_mongo.NewMockCollection(ctrl)
+		cur = mock_mongo.NewMockCursor(ctrl)
+	)
+
+	ctx := context.Background()
+	repo := MongoWebhookRepository{col: col}
+
+	t.Run("With successful finding all webhooks of the event", func(t *testing.T) {
+		// Given
+		col.EXPECT().Find(ctx, bson.M{"events": EventPostPublished}).Return(cur, nil)
+		cur.EXPECT().Decode(gomock.Any()).Return(nil)
+		cur.EXPECT().Close(ctx).Return(nil)
+
+		// When
+		_, err := repo.FindAllByEvent(ctx, EventPostPublished)
+
+		// Then
+		assert.Nil(t, err)
+	})
+
+	t.Run("When unable to find all webhooks of the event", func(t *testing.T) {
+		// Given
+		col.EXPECT().Find(ctx, bson.M{"events": EventPostPublished}).Return(nil, errors.New("test unable to find all webhooks"))
+
+		// When
+		_, err := repo.FindAllByEvent(ctx, EventPostPublished)
+
+		// Then
+		assert.EqualError(t, err, "test unable to find all webhooks")
+	})
+}
+
+func TestMongoDeliveryRepository_FindAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		col = mock_mongo.NewMockCollection(ctrl)
+		cur = mock_mongo.NewMockCursor(ctrl)
+	)
+
+	ctx := context.Background()
+	repo := MongoDeliveryRepository{col: col}
+	webhookID := primitive.NewObjectID()
+
+	tests := map[string]struct {
+		webhookID primitive.ObjectID
+		filter    bson.M
+	}{
+		"With all webhooks": {
+			filter: bson.M{},
+		},
+		"With specific webhook": {
+			webhookID: webhookID,
+			filter:    bson.M{"webhookId": webhookID},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Given
+			col.EXPECT().Find(ctx, test.filter, gomock.Any()).Return(cur, nil)
+			cur.EXPECT().Decode(gomock.Any()).Return(nil)
+			cur.EXPECT().Close(ctx).Return(nil)
+
+			// When
+			_, err := repo.FindAll(ctx, test.webhookID, 0, 10)
+
+			// Then
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestMongoDeliveryRepository_FindAllPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		col = mock_mongo.NewMockCollection(ctrl)
+		cur = mock_mongo.NewMockCursor(ctrl)
+	)
+
+	ctx := context.Background()
+	repo := MongoDeliveryRepository{col: col}
+
+	// Given
+	col.EXPECT().Find(ctx, bson.M{"pending": true}, gomock.Any()).Return(cur, nil)
+	cur.EXPECT().Decode(gomock.Any()).Return(nil)
+	cur.EXPECT().Close(ctx).Return(nil)
+
+	// When
+	_, err := repo.FindAllPending(ctx)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestMongoDeliveryRepository_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		col = mock_mongo.NewMockCollection(ctrl)
+	)
+
+	ctx := context.Background()
+	repo := MongoDeliveryRepository{col: col}
+	id := primitive.NewObjectID()
+
+	// Given
+	col.EXPECT().DeleteOne(ctx, bson.M{"_id": id}).Return(nil, nil)
+
+	// When
+	err := repo.Delete(ctx, id)
+
+	// Then
+	assert.Nil(t, err)
+}
@@ -0,0 +1,383 @@
+package wordpress
+
+import (
+	"context"
+	"fmt"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	slugify "github.com/nomkhonwaan/myblog/pkg/slug"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	"github.com/russross/blackfriday/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// postsPerBatch is a number of posts per query when finding all posts which have been imported before
+const postsPerBatch = 1000
+
+// Redirect is a pair of an original URL on the WordPress site and a new URL on the blog platform
+type Redirect struct {
+	From string
+	To   string
+}
+
+// Importer imports a parsed WXR export file into the blog platform
+type Importer struct {
+	// An author ID who will own all imported posts and files
+	AuthorID string
+
+	// A base URL of the blog platform which uses to generate redirect URLs
+	BaseURL string
+
+	// A local directory contains the "wp-content/uploads" files
+	UploadsDir string
+
+	// A file system where the uploads directory located
+	Fs afero.Fs
+
+	Storage            storage.Storage
+	CategoryRepository blog.CategoryRepository
+	TagRepository      blog.TagRepository
+	PostRepository     blog.PostRepository
+	FileRepository     storage.FileRepository
+}
+
+type importContext struct {
+	categories map[string]blog.Category
+	tags       map[string]blog.Tag
+
+	// map from attachment post ID to the uploaded file
+	files map[int]storage.File
+
+	// map from original attachment URL (without file extension) to the uploaded file
+	fileURLs map[string]storage.File
+
+	// map from post ID to the post which has been imported by the previous run
+	imported map[int]blog.Post
+
+	redirects []Redirect
+}
+
+// Import creates categories, tags, files and posts from the channel and returns a redirect map
+func (im Importer) Import(ctx context.Context, ch Channel) ([]Redirect, error) {
+	ictx := importContext{
+		categories: make(map[string]blog.Category),
+		tags:       make(map[string]blog.Tag),
+		files:      make(map[int]storage.File),
+		fileURLs:   make(map[string]storage.File),
+		imported:   make(map[int]blog.Post),
+	}
+
+	if err := im.importCategories(ctx, ch, &ictx); err != nil {
+		return nil, err
+	}
+	if err := im.importTags(ctx, ch, &ictx); err != nil {
+		return nil, err
+	}
+	if err := im.findImportedPosts(ctx, ch, &ictx); err != nil {
+		return nil, err
+	}
+	for _, item := range ch.Items {
+		if !item.IsAttachment() {
+			continue
+		}
+		// the attachment has been uploaded along with the post it belongs to
+		if _, ok := ictx.imported[item.PostParent]; ok && item.PostParent != 0 {
+			continue
+		}
+		if err := im.importAttachment(ctx, item, &ictx); err != nil {
+			return nil, err
+		}
+	}
+	for _, item := range ch.Items {
+		if !item.IsPost() || item.IsTrashed() {
+			continue
+		}
+		if err := im.importPost(ctx, item, ch.Items, &ictx); err != nil {
+			return nil, err
+		}
+	}
+
+	return ictx.redirects, nil
+}
+
+func (im Importer) importCategories(ctx context.Context, ch Channel, ictx *importContext) error {
+	existing, err := im.CategoryRepository.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+	for _, cat := range existing {
+		ictx.categories[strings.ToLower(cat.Name)] = cat
+	}
+
+	terms := make([]Term, 0, len(ch.Categories))
+	for _, c := range ch.Categories {
+		terms = append(terms, Term{Domain: "category", NiceName: c.NiceName, Name: c.Name})
+	}
+	for _, item := range ch.Items {
+		terms = append(terms, item.Categories()...)
+	}
+
+	for _, t := range terms {
+		cat, ok := ictx.categories[strings.ToLower(t.Name)]
+		if !ok {
+			logrus.Infof("creating category %q...", t.Name)
+			cat, err = im.CategoryRepository.Create(ctx, t.Name)
+			if err != nil {
+				return err
+			}
+			ictx.categories[strings.ToLower(t.Name)] = cat
+
+			if t.NiceName != "" && ch.Link != "" {
+				ictx.redirects = append(ictx.redirects, Redirect{
+					From: strings.TrimRight(ch.Link, "/") + "/category/" + t.NiceName + "/",
+					To:   im.BaseURL + "/category/" + cat.Slug,
+				})
+			}
+		}
+	}
+
+	return im.importCategoryParents(ctx, ch, ictx)
+}
+
+// importCategoryParents sets the parent of each category which WordPress refers to by its nice name,
+// a category which already has a parent will not be changed
+func (im Importer) importCategoryParents(ctx context.Context, ch Channel, ictx *importContext) error {
+	niceNames := make(map[string]string)
+	for _, c := range ch.Categories {
+		niceNames[c.NiceName] = strings.ToLower(c.Name)
+	}
+
+	for _, c := range ch.Categories {
+		if c.Parent == "" {
+			continue
+		}
+		cat, parent := ictx.categories[strings.ToLower(c.Name)], ictx.categories[niceNames[c.Parent]]
+		if parent.ID.IsZero() || !cat.Parent.ID.IsZero() {
+			continue
+		}
+
+		logrus.Infof("setting parent of category %q to %q...", cat.Name, parent.Name)
+		updated, err := im.CategoryRepository.UpdateParent(ctx, cat.ID, parent.ID)
+		if err != nil {
+			return err
+		}
+		ictx.categories[strings.ToLower(c.Name)] = updated
+	}
+
+	return nil
+}
+
+func (im Importer) importTags(ctx context.Context, ch Channel, ictx *importContext) error {
+	existing, err := im.TagRepository.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+	for _, tag := range existing {
+		ictx.tags[strings.ToLower(tag.Name)] = tag
+	}
+
+	terms := make([]Term, 0, len(ch.Tags))
+	for _, t := range ch.Tags {
+		terms = append(terms, Term{Domain: "post_tag", NiceName: t.Slug, Name: t.Name})
+	}
+	for _, item := range ch.Items {
+		terms = append(terms, item.Tags()...)
+	}
+
+	for _, t := range terms {
+		tag, ok := ictx.tags[strings.ToLower(t.Name)]
+		if !ok {
+			logrus.Infof("creating tag %q...", t.Name)
+			tag, err = im.TagRepository.Create(ctx, t.Name)
+			if err != nil {
+				return err
+			}
+			ictx.tags[strings.ToLower(t.Name)] = tag
+
+			if t.NiceName != "" && ch.Link != "" {
+				ictx.redirects = append(ictx.redirects, Redirect{
+					From: strings.TrimRight(ch.Link, "/") + "/tag/" + t.NiceName + "/",
+					To:   im.BaseURL + "/tag/" + tag.Slug,
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
+// findImportedPosts matches the items with the author's posts which have been imported before by the title part of the slug,
+// so that running the import again will not duplicate the posts
+func (im Importer) findImportedPosts(ctx context.Context, ch Channel, ictx *importContext) error {
+	existing := make(map[string][]blog.Post)
+	for offset := int64(0); ; offset += postsPerBatch {
+		posts, err := im.PostRepository.FindAll(ctx, blog.NewPostQueryBuilder().
+			WithAuthorID(im.AuthorID).WithOffset(offset).WithLimit(postsPerBatch).Build())
+		if err != nil {
+			return err
+		}
+
+		for _, p := range posts {
+			key := strings.TrimSuffix(p.Slug, "-"+p.ID.Hex())
+			existing[key] = append(existing[key], p)
+		}
+
+		if len(posts) < postsPerBatch {
+			break
+		}
+	}
+
+	for _, item := range ch.Items {
+		if !item.IsPost() || item.IsTrashed() {
+			continue
+		}
+
+		key := slugify.Make(item.Title)
+		if posts := existing[key]; len(posts) > 0 {
+			ictx.imported[item.PostID], existing[key] = posts[0], posts[1:]
+		}
+	}
+
+	return nil
+}
+
+func (im Importer) importAttachment(ctx context.Context, item Item, ictx *importContext) error {
+	i := strings.Index(item.AttachmentURL, "/uploads/")
+	if i < 0 {
+		logrus.Warnf("skipping attachment %q: not in the uploads directory", item.AttachmentURL)
+		return nil
+	}
+	localPath := filepath.Join(im.UploadsDir, filepath.FromSlash(item.AttachmentURL[i+len("/uploads/"):]))
+
+	f, err := im.Fs.Open(localPath)
+	if err != nil {
+		logrus.Warnf("skipping attachment %q: %s", item.AttachmentURL, err)
+		return nil
+	}
+	defer f.Close()
+
+	var (
+		id         = primitive.NewObjectID()
+		fileName   = path.Base(item.AttachmentURL)
+		ext        = filepath.Ext(fileName)
+		slug       = fmt.Sprintf("%s-%s%s", slugify.Make(fileName[0:len(fileName)-len(ext)]), id.Hex(), ext)
+		uploadPath = im.AuthorID + string(filepath.Separator) + slug
+	)
+	logrus.Infof("uploading file %s to the storage server...", uploadPath)
+	if err = im.Storage.Upload(ctx, f, uploadPath); err != nil {
+		return err
+	}
+
+	file, err := im.FileRepository.Create(ctx, storage.File{
+		ID:       id,
+		Path:     uploadPath,
+		FileName: fileName,
+		Slug:     slug,
+	})
+	if err != nil {
+		return err
+	}
+
+	ictx.files[item.PostID] = file
+	ictx.fileURLs[strings.TrimSuffix(item.AttachmentURL, ext)] = file
+	ictx.redirects = append(ictx.redirects, Redirect{From: item.AttachmentURL, To: im.fileURL(file)})
+
+	return nil
+}
+
+func (im Importer) importPost(ctx context.Context, item Item, items []Item, ictx *importContext) error {
+	if p, ok := ictx.imported[item.PostID]; ok {
+		logrus.Infof("skipping post %q: already imported", item.Title)
+		if p.Status.IsPublished() && item.Link != "" {
+			ictx.redirects = append(ictx.redirects, Redirect{From: item.Link, To: im.BaseURL + p.Permalink()})
+		}
+		return nil
+	}
+
+	content := im.replaceAttachmentURLs(item.Content, ictx)
+	markdown, err := ConvertHTMLToMarkdown(content)
+	if err != nil {
+		return err
+	}
+	html := blackfriday.Run([]byte(markdown), blackfriday.
+		WithExtensions(blackfriday.CommonExtensions+blackfriday.Footnotes))
+
+	p, err := im.PostRepository.Create(ctx, im.AuthorID)
+	if err != nil {
+		return err
+	}
+
+	slug := fmt.Sprintf("%s-%s", slugify.Make(item.Title), p.ID.Hex())
+	qb := blog.NewPostQueryBuilder().
+		WithTitle(item.Title).
+		WithSlug(slug).
+		WithMarkdown(markdown).
+		WithHTML(string(html))
+
+	publishedAt := item.PublishedAt()
+	if item.IsPublished() {
+		qb.WithStatus(blog.StatusPublished).WithPublishedAt(publishedAt)
+	} else {
+		qb.WithStatus(blog.StatusDraft)
+	}
+
+	cats := make([]blog.Category, 0)
+	for _, t := range item.Categories() {
+		cats = append(cats, ictx.categories[strings.ToLower(t.Name)])
+	}
+	tags := make([]blog.Tag, 0)
+	for _, t := range item.Tags() {
+		tags = append(tags, ictx.tags[strings.ToLower(t.Name)])
+	}
+	qb.WithCategories(cats).WithTags(tags)
+
+	if thumbnailID, err := strconv.Atoi(item.Meta("_thumbnail_id")); err == nil {
+		if f, ok := ictx.files[thumbnailID]; ok {
+			qb.WithFeaturedImage(f)
+		}
+	}
+
+	attachments := make([]storage.File, 0)
+	for _, it := range items {
+		if it.IsAttachment() && it.PostParent == item.PostID {
+			if f, ok := ictx.files[it.PostID]; ok {
+				attachments = append(attachments, f)
+			}
+		}
+	}
+	qb.WithAttachments(attachments)
+
+	logrus.Infof("importing post %q...", item.Title)
+	if _, err = im.PostRepository.Save(ctx, p.ID, qb.Build()); err != nil {
+		return err
+	}
+
+	if item.IsPublished() && item.Link != "" {
+		ictx.redirects = append(ictx.redirects, Redirect{
+			From: item.Link,
+			To:   im.BaseURL + blog.Post{Slug: slug, PublishedAt: publishedAt}.Permalink(),
+		})
+	}
+
+	return nil
+}
+
+// replaceAttachmentURLs rewrites all original attachment URLs including WordPress generated thumbnails,
+// e.g. "image-300x200.jpg", to the new storage URLs
+func (im Importer) replaceAttachmentURLs(content string, ictx *importContext) string {
+	for prefix, file := range ictx.fileURLs {
+		re := regexp.MustCompile(regexp.QuoteMeta(prefix) + `(-\d+x\d+)?` + regexp.QuoteMeta(filepath.Ext(file.FileName)))
+		content = re.ReplaceAllString(content, im.fileURL(file))
+	}
+	return content
+}
+
+func (im Importer) fileURL(file storage.File) string {
+	return im.BaseURL + "/api/v2.1/storage/" + file.Slug
+}
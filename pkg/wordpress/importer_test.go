@@ -0,0 +1,198 @@
+package wordpress
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/nomkhonwaan/myblog/pkg/blog"
+	mock_blog "github.com/nomkhonwaan/myblog/pkg/blog/mock"
+	"github.com/nomkhonwaan/myblog/pkg/storage"
+	mock_storage "github.com/nomkhonwaan/myblog/pkg/storage/mock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestImporter_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		bucket             = mock_storage.NewMockStorage(ctrl)
+		categoryRepository = mock_blog.NewMockCategoryRepository(ctrl)
+		tagRepository      = mock_blog.NewMockTagRepository(ctrl)
+		postRepository     = mock_blog.NewMockPostRepository(ctrl)
+		fileRepository     = mock_storage.NewMockFileRepository(ctrl)
+	)
+
+	newImporter := func(fs afero.Fs) Importer {
+		return Importer{
+			AuthorID:           "github|303589",
+			BaseURL:            "http://localhost",
+			UploadsDir:         "/uploads",
+			Fs:                 fs,
+			Storage:            bucket,
+			CategoryRepository: categoryRepository,
+			TagRepository:      tagRepository,
+			PostRepository:     postRepository,
+			FileRepository:     fileRepository,
+		}
+	}
+
+	t.Run("With successful importing all items", func(t *testing.T) {
+		// Given
+		ch, _ := Parse(strings.NewReader(testWXR))
+		ch.Items[0].Content = `<p><img src="https://old.example.com/wp-content/uploads/2019/05/cover-300x200.jpg" alt="" /></p>`
+		ch.Categories = append(ch.Categories, Category{NiceName: "go", Name: "Go", Parent: "web-development"})
+
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, "/uploads/2019/05/cover.jpg", []byte("image"), 0644)
+
+		catID := primitive.NewObjectID()
+		postID := primitive.NewObjectID()
+		cat := blog.Category{ID: catID, Name: "Web Development", Slug: "web-development-" + catID.Hex()}
+		subCatID := primitive.NewObjectID()
+		subCat := blog.Category{ID: subCatID, Name: "Go", Slug: "go-" + subCatID.Hex()}
+		tag := blog.Tag{ID: primitive.NewObjectID(), Name: "Golang", Slug: "golang-existing"}
+		var file storage.File
+
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
+		categoryRepository.EXPECT().Create(gomock.Any(), "Web Development").Return(cat, nil)
+		categoryRepository.EXPECT().Create(gomock.Any(), "Go").Return(subCat, nil)
+		categoryRepository.EXPECT().UpdateParent(gomock.Any(), subCatID, catID).Return(subCat, nil)
+		tagRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Tag{tag}, nil)
+		postRepository.EXPECT().FindAll(gomock.Any(), blog.NewPostQueryBuilder().WithAuthorID("github|303589").
+			WithOffset(0).WithLimit(postsPerBatch).Build()).Return(nil, nil)
+		bucket.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		fileRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f storage.File) (storage.File, error) {
+			assert.Equal(t, "cover.jpg", f.FileName)
+			assert.True(t, strings.HasPrefix(f.Path, "github|303589/cover-"))
+			file = f
+			return f, nil
+		})
+		postRepository.EXPECT().Create(gomock.Any(), "github|303589").Return(blog.Post{ID: postID}, nil)
+		postRepository.EXPECT().Save(gomock.Any(), postID, gomock.Any()).DoAndReturn(func(_ context.Context, _ interface{}, q blog.PostQuery) (blog.Post, error) {
+			assert.Equal(t, "Hello World", *q.Title())
+			assert.Equal(t, "hello-world-"+postID.Hex(), *q.Slug())
+			assert.Equal(t, blog.StatusPublished, *q.Status())
+			assert.Equal(t, "![](http://localhost/api/v2.1/storage/"+file.Slug+")", *q.Markdown())
+			assert.Equal(t, []blog.Category{cat}, *q.Categories())
+			assert.Equal(t, []blog.Tag{tag}, *q.Tags())
+			assert.Equal(t, file, *q.FeaturedImage())
+			assert.Equal(t, []storage.File{file}, *q.Attachments())
+			return blog.Post{}, nil
+		})
+
+		// When
+		redirects, err := newImporter(fs).Import(context.Background(), ch)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, []Redirect{
+			{From: "https://old.example.com/category/web-development/", To: "http://localhost/category/" + cat.Slug},
+			{From: "https://old.example.com/category/go/", To: "http://localhost/category/" + subCat.Slug},
+			{From: "https://old.example.com/wp-content/uploads/2019/05/cover.jpg", To: "http://localhost/api/v2.1/storage/" + file.Slug},
+			{From: "https://old.example.com/2019/05/01/hello-world/", To: "http://localhost/2019/5/1/hello-world-" + postID.Hex()},
+		}, redirects)
+	})
+
+	t.Run("With missing attachment file on the uploads directory", func(t *testing.T) {
+		// Given
+		ch, _ := Parse(strings.NewReader(testWXR))
+		ch.Items[0].Status = "draft"
+
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{{Name: "web development"}}, nil)
+		tagRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Tag{{Name: "golang"}}, nil)
+		postRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, nil)
+		postRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(blog.Post{ID: primitive.NewObjectID()}, nil)
+		postRepository.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ interface{}, q blog.PostQuery) (blog.Post, error) {
+			assert.Equal(t, blog.StatusDraft, *q.Status())
+			assert.Nil(t, q.PublishedAt())
+			assert.Nil(t, q.FeaturedImage())
+			return blog.Post{}, nil
+		})
+
+		// When
+		redirects, err := newImporter(afero.NewMemMapFs()).Import(context.Background(), ch)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Empty(t, redirects)
+	})
+
+	t.Run("With the posts which have been imported before", func(t *testing.T) {
+		// Given
+		ch, _ := Parse(strings.NewReader(testWXR))
+		ch.Items = append(ch.Items, Item{Title: "Hello World", PostID: 12, PostType: "post", Status: "draft"})
+
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, "/uploads/2019/05/cover.jpg", []byte("image"), 0644)
+
+		id := primitive.NewObjectID()
+		newID := primitive.NewObjectID()
+		imported := blog.Post{ID: id, Title: "Hello World", Slug: "hello-world-" + id.Hex(), Status: blog.StatusPublished,
+			PublishedAt: time.Date(2019, 5, 1, 10, 0, 0, 0, time.UTC)}
+
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Category{{Name: "Web Development"}}, nil)
+		tagRepository.EXPECT().FindAll(gomock.Any()).Return([]blog.Tag{{Name: "Golang"}}, nil)
+		postRepository.EXPECT().FindAll(gomock.Any(), blog.NewPostQueryBuilder().WithAuthorID("github|303589").
+			WithOffset(0).WithLimit(postsPerBatch).Build()).Return([]blog.Post{imported}, nil)
+		postRepository.EXPECT().Create(gomock.Any(), "github|303589").Return(blog.Post{ID: newID}, nil)
+		postRepository.EXPECT().Save(gomock.Any(), newID, gomock.Any()).DoAndReturn(func(_ context.Context, _ interface{}, q blog.PostQuery) (blog.Post, error) {
+			assert.Equal(t, "hello-world-"+newID.Hex(), *q.Slug())
+			return blog.Post{}, nil
+		})
+
+		// When
+		redirects, err := newImporter(fs).Import(context.Background(), ch)
+
+		// Then
+		assert.Nil(t, err)
+		assert.Equal(t, []Redirect{
+			{From: "https://old.example.com/2019/05/01/hello-world/", To: "http://localhost/2019/5/1/hello-world-" + id.Hex()},
+		}, redirects)
+	})
+
+	t.Run("When unable to find the posts which have been imported before", func(t *testing.T) {
+		// Given
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
+		tagRepository.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
+		postRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, errors.New("test unable to find all posts"))
+
+		// When
+		_, err := newImporter(afero.NewMemMapFs()).Import(context.Background(), Channel{})
+
+		// Then
+		assert.EqualError(t, err, "test unable to find all posts")
+	})
+
+	t.Run("When unable to find all categories", func(t *testing.T) {
+		// Given
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return(nil, errors.New("test unable to find all categories"))
+
+		// When
+		_, err := newImporter(afero.NewMemMapFs()).Import(context.Background(), Channel{})
+
+		// Then
+		assert.EqualError(t, err, "test unable to find all categories")
+	})
+
+	t.Run("When unable to create a new post", func(t *testing.T) {
+		// Given
+		ch := Channel{Items: []Item{{Title: "Test", PostType: "post", Status: "publish"}}}
+
+		categoryRepository.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
+		tagRepository.EXPECT().FindAll(gomock.Any()).Return(nil, nil)
+		postRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, nil)
+		postRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(blog.Post{}, errors.New("test unable to create a new post"))
+
+		// When
+		_, err := newImporter(afero.NewMemMapFs()).Import(context.Background(), ch)
+
+		// Then
+		assert.EqualError(t, err, "test unable to create a new post")
+	})
+}
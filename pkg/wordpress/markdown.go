@@ -0,0 +1,168 @@
+package wordpress
+
+import (
+	"bytes"
+	"fmt"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"regexp"
+	"strings"
+)
+
+var (
+	multipleNewLinesRegExp = regexp.MustCompile(`\n{3,}`)
+
+	// bulletMarkerRegExp and orderedMarkerRegExp match a list item marker at the beginning of a line
+	// which turns a plain text into a list
+	bulletMarkerRegExp  = regexp.MustCompile(`(?m)^([ \t]*)([-+])([ \t])`)
+	orderedMarkerRegExp = regexp.MustCompile(`(?m)^([ \t]*)(\d+)\.([ \t])`)
+
+	// markdownEscaper escapes all characters which could start an emphasis, link, heading, blockquote, code or raw HTML
+	markdownEscaper = strings.NewReplacer(
+		`\`, `\\`,
+		"`", "\\`",
+		`*`, `\*`,
+		`_`, `\_`,
+		`[`, `\[`,
+		`]`, `\]`,
+		`#`, `\#`,
+		`<`, `\<`,
+		`>`, `\>`,
+		`&`, `\&`,
+	)
+)
+
+// ConvertHTMLToMarkdown converts WordPress post content to markdown syntax.
+// An element which has no markdown equivalent (e.g. table, iframe) is kept as raw HTML
+// since it is still valid in the markdown document.
+func ConvertHTMLToMarkdown(content string) (string, error) {
+	nodes, err := html.ParseFragment(strings.NewReader(content), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	for _, n := range nodes {
+		convertNode(&buf, n, "")
+	}
+
+	return strings.TrimSpace(multipleNewLinesRegExp.ReplaceAllString(buf.String(), "\n\n")), nil
+}
+
+func convertNode(buf *bytes.Buffer, n *html.Node, listPrefix string) {
+	switch n.Type {
+	case html.TextNode:
+		buf.WriteString(escapeText(n.Data, buf.Len() == 0 || buf.Bytes()[buf.Len()-1] == '\n'))
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+
+	switch n.DataAtom {
+	case atom.P, atom.Div:
+		buf.WriteString("\n\n")
+		convertChildren(buf, n, listPrefix)
+		buf.WriteString("\n\n")
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level := int(n.Data[1] - '0')
+		buf.WriteString("\n\n" + strings.Repeat("#", level) + " ")
+		buf.WriteString(strings.TrimSpace(convertInline(n)))
+		buf.WriteString("\n\n")
+	case atom.Strong, atom.B:
+		buf.WriteString("**" + convertInline(n) + "**")
+	case atom.Em, atom.I:
+		buf.WriteString("_" + convertInline(n) + "_")
+	case atom.A:
+		buf.WriteString(fmt.Sprintf("[%s](%s)", convertInline(n), attr(n, "href")))
+	case atom.Img:
+		buf.WriteString(fmt.Sprintf("![%s](%s)", attr(n, "alt"), attr(n, "src")))
+	case atom.Br:
+		buf.WriteString("  \n")
+	case atom.Hr:
+		buf.WriteString("\n\n---\n\n")
+	case atom.Code:
+		buf.WriteString("`" + textContent(n) + "`")
+	case atom.Pre:
+		buf.WriteString("\n\n```\n" + strings.Trim(textContent(n), "\n") + "\n```\n\n")
+	case atom.Blockquote:
+		quoted := strings.TrimSpace(multipleNewLinesRegExp.ReplaceAllString(convertInline(n), "\n\n"))
+		buf.WriteString("\n\n> " + strings.ReplaceAll(quoted, "\n", "\n> ") + "\n\n")
+	case atom.Ul, atom.Ol:
+		buf.WriteString("\n\n")
+		i := 1
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.DataAtom != atom.Li {
+				continue
+			}
+			marker := "- "
+			if n.DataAtom == atom.Ol {
+				marker = fmt.Sprintf("%d. ", i)
+				i++
+			}
+			buf.WriteString(listPrefix + marker)
+			item := strings.TrimSpace(convertInlineWithPrefix(c, listPrefix+"    "))
+			buf.WriteString(item + "\n")
+		}
+		buf.WriteString("\n")
+	case atom.Span, atom.Figure, atom.Section, atom.Article:
+		convertChildren(buf, n, listPrefix)
+	default:
+		_ = html.Render(buf, n)
+	}
+}
+
+func convertChildren(buf *bytes.Buffer, n *html.Node, listPrefix string) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		convertNode(buf, c, listPrefix)
+	}
+}
+
+func convertInline(n *html.Node) string {
+	return convertInlineWithPrefix(n, "")
+}
+
+func convertInlineWithPrefix(n *html.Node, listPrefix string) string {
+	var buf bytes.Buffer
+	convertChildren(&buf, n, listPrefix)
+	return buf.String()
+}
+
+// escapeText escapes the text which would be rendered as markdown syntax otherwise,
+// the list item marker is only escaped at the beginning of a line
+func escapeText(text string, lineStart bool) string {
+	text = markdownEscaper.Replace(text)
+
+	i := 0
+	if !lineStart {
+		if i = strings.Index(text, "\n"); i < 0 {
+			return text
+		}
+	}
+	rest := bulletMarkerRegExp.ReplaceAllString(text[i:], `$1\$2$3`)
+	return text[:i] + orderedMarkerRegExp.ReplaceAllString(rest, `$1$2\.$3`)
+}
+
+func textContent(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var sb strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		sb.WriteString(textContent(c))
+	}
+	return sb.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
@@ -0,0 +1,66 @@
+package wordpress
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestConvertHTMLToMarkdown(t *testing.T) {
+	// Given
+	tests := map[string]struct {
+		html     string
+		expected string
+	}{
+		"With WordPress auto-paragraph content": {
+			html:     "First paragraph\n\nSecond <em>paragraph</em>",
+			expected: "First paragraph\n\nSecond _paragraph_",
+		},
+		"With paragraphs and inline elements": {
+			html:     `<p>Hello <strong>World</strong>, visit <a href="https://example.com">here</a></p><p>Bye</p>`,
+			expected: "Hello **World**, visit [here](https://example.com)\n\nBye",
+		},
+		"With headings": {
+			html:     "<h2>Title</h2><h3> Sub <b>title</b> </h3>",
+			expected: "## Title\n\n### Sub **title**",
+		},
+		"With an image": {
+			html:     `<img src="https://example.com/a.jpg" alt="A" />`,
+			expected: "![A](https://example.com/a.jpg)",
+		},
+		"With lists": {
+			html:     "<ul><li>one</li><li>two</li></ul><ol><li>first</li><li>second</li></ol>",
+			expected: "- one\n- two\n\n1. first\n2. second",
+		},
+		"With code": {
+			html:     "Use <code>go test</code>\n<pre>func main() {\n}\n</pre>",
+			expected: "Use `go test`\n\n```\nfunc main() {\n}\n```",
+		},
+		"With blockquote": {
+			html:     "<blockquote><p>Quote line</p></blockquote>",
+			expected: "> Quote line",
+		},
+		"With markdown syntax in the text": {
+			html:     `<p>2 * 3 = 6, snake_case, [not a link], #hashtag, <code>a_b</code> &lt;br&gt; R&amp;D</p>`,
+			expected: "2 \\* 3 = 6, snake\\_case, \\[not a link\\], \\#hashtag, `a_b` \\<br\\> R\\&D",
+		},
+		"With list item markers at the beginning of a line": {
+			html:     "- not a list\n1. not a list either\n<p><em>emphasis</em> - a dash, 1. a number</p>",
+			expected: "\\- not a list\n1\\. not a list either\n\n_emphasis_ - a dash, 1. a number",
+		},
+		"With an unsupported element": {
+			html:     `<table><tr><td>cell</td></tr></table>`,
+			expected: "<table><tbody><tr><td>cell</td></tr></tbody></table>",
+		},
+	}
+
+	// When
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := ConvertHTMLToMarkdown(test.html)
+
+			// Then
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
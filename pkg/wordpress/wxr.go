@@ -0,0 +1,186 @@
+package wordpress
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"time"
+)
+
+// RSS is a root element of the WordPress eXtended RSS (WXR) export file
+type RSS struct {
+	XMLName xml.Name `xml:"rss"`
+	Channel Channel  `xml:"channel"`
+}
+
+// Channel contains the site information and all exported items
+type Channel struct {
+	// Title of the site
+	Title string `xml:"title"`
+
+	// An original site URL
+	Link string `xml:"link"`
+
+	// List of categories which are defined on the site
+	Categories []Category `xml:"category"`
+
+	// List of tags which are defined on the site
+	Tags []Tag `xml:"tag"`
+
+	// List of posts, pages and attachments
+	Items []Item `xml:"item"`
+}
+
+// Category is a WordPress category definition
+type Category struct {
+	// A URL-friendly name of the category
+	NiceName string `xml:"category_nicename"`
+
+	// Name of the category
+	Name string `xml:"cat_name"`
+
+	// A nice name of the parent category, empty if the category is a root category
+	Parent string `xml:"category_parent"`
+}
+
+// Tag is a WordPress tag definition
+type Tag struct {
+	// A URL-friendly name of the tag
+	Slug string `xml:"tag_slug"`
+
+	// Name of the tag
+	Name string `xml:"tag_name"`
+}
+
+// Item is a single exported post, page or attachment
+type Item struct {
+	// Title of the item
+	Title string `xml:"title"`
+
+	// An original permalink of the item
+	Link string `xml:"link"`
+
+	// Date-time that the item was published in RFC 1123 format
+	PubDate string `xml:"pubDate"`
+
+	// Content of the item in HTML format
+	Content string `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+
+	// Identifier of the item on the original site
+	PostID int `xml:"post_id"`
+
+	// Date-time that the item was published in GMT
+	PostDateGMT string `xml:"post_date_gmt"`
+
+	// Status of the item which could be "publish", "draft", "pending", "private", "future", "inherit" or "trash"
+	Status string `xml:"status"`
+
+	// Identifier of the parent item, an attachment uses this field to refer the post it belongs to
+	PostParent int `xml:"post_parent"`
+
+	// Type of the item which could be "post", "page", "attachment" and other custom post types
+	PostType string `xml:"post_type"`
+
+	// An original URL of the uploaded file, for an attachment only
+	AttachmentURL string `xml:"attachment_url"`
+
+	// List of categories and tags that the item belonging to
+	Terms []Term `xml:"category"`
+
+	// List of custom fields of the item
+	PostMeta []PostMeta `xml:"postmeta"`
+}
+
+// Term is a category or tag reference in the item
+type Term struct {
+	// Taxonomy of the term which could be "category" or "post_tag"
+	Domain string `xml:"domain,attr"`
+
+	// A URL-friendly name of the term
+	NiceName string `xml:"nicename,attr"`
+
+	// Name of the term
+	Name string `xml:",chardata"`
+}
+
+// PostMeta is a custom field of the item
+type PostMeta struct {
+	Key   string `xml:"meta_key"`
+	Value string `xml:"meta_value"`
+}
+
+// IsPost returns "true" if the item should be imported as a post, WordPress pages are imported as posts too
+func (item Item) IsPost() bool {
+	return item.PostType == "post" || item.PostType == "page"
+}
+
+// IsAttachment returns "true" if the item is an uploaded file
+func (item Item) IsAttachment() bool {
+	return item.PostType == "attachment"
+}
+
+// IsPublished returns "true" if the item is publicly visible on the original site
+func (item Item) IsPublished() bool {
+	return item.Status == "publish"
+}
+
+// IsTrashed returns "true" if the item was deleted or never saved on the original site
+func (item Item) IsTrashed() bool {
+	return item.Status == "trash" || item.Status == "auto-draft"
+}
+
+// PublishedAt returns a date-time that the item was published
+func (item Item) PublishedAt() time.Time {
+	if t, err := time.Parse("2006-01-02 15:04:05", item.PostDateGMT); err == nil {
+		return t
+	}
+	if t, err := time.Parse(time.RFC1123Z, item.PubDate); err == nil {
+		return t.UTC()
+	}
+	return time.Time{}
+}
+
+// Meta returns a custom field value from its key
+func (item Item) Meta(key string) string {
+	for _, m := range item.PostMeta {
+		if m.Key == key {
+			return m.Value
+		}
+	}
+	return ""
+}
+
+// Categories returns list of category terms
+func (item Item) Categories() []Term {
+	return item.termsByDomain("category")
+}
+
+// Tags returns list of tag terms
+func (item Item) Tags() []Term {
+	return item.termsByDomain("post_tag")
+}
+
+func (item Item) termsByDomain(domain string) []Term {
+	var terms []Term
+	for _, t := range item.Terms {
+		if t.Domain == domain {
+			t.Name = strings.TrimSpace(t.Name)
+			terms = append(terms, t)
+		}
+	}
+	return terms
+}
+
+// Parse decodes the WXR export file
+func Parse(r io.Reader) (Channel, error) {
+	var rss RSS
+	dec := xml.NewDecoder(r)
+	// WXR files are mostly declared as UTF-8 but some old exports are not, read them as-is
+	dec.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	dec.Strict = false
+
+	err := dec.Decode(&rss)
+	return rss.Channel, err
+}
@@ -0,0 +1,121 @@
+package wordpress
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testWXR = `<?xml version="1.0" encoding="UTF-8" ?>
+<rss version="2.0"
+	xmlns:excerpt="http://wordpress.org/export/1.2/excerpt/"
+	xmlns:content="http://purl.org/rss/1.0/modules/content/"
+	xmlns:dc="http://purl.org/dc/elements/1.1/"
+	xmlns:wp="http://wordpress.org/export/1.2/">
+<channel>
+	<title>Old Blog</title>
+	<link>https://old.example.com</link>
+	<wp:category>
+		<wp:term_id>1</wp:term_id>
+		<wp:category_nicename>web-development</wp:category_nicename>
+		<wp:cat_name><![CDATA[Web Development]]></wp:cat_name>
+	</wp:category>
+	<wp:tag>
+		<wp:term_id>2</wp:term_id>
+		<wp:tag_slug>golang</wp:tag_slug>
+		<wp:tag_name><![CDATA[Golang]]></wp:tag_name>
+	</wp:tag>
+	<item>
+		<title>Hello World</title>
+		<link>https://old.example.com/2019/05/01/hello-world/</link>
+		<pubDate>Wed, 01 May 2019 10:00:00 +0000</pubDate>
+		<content:encoded><![CDATA[<p>Hello <strong>World</strong></p>]]></content:encoded>
+		<excerpt:encoded><![CDATA[]]></excerpt:encoded>
+		<wp:post_id>10</wp:post_id>
+		<wp:post_date_gmt>2019-05-01 10:00:00</wp:post_date_gmt>
+		<wp:status>publish</wp:status>
+		<wp:post_parent>0</wp:post_parent>
+		<wp:post_type>post</wp:post_type>
+		<category domain="category" nicename="web-development"><![CDATA[Web Development]]></category>
+		<category domain="post_tag" nicename="golang"><![CDATA[Golang]]></category>
+		<wp:postmeta>
+			<wp:meta_key>_thumbnail_id</wp:meta_key>
+			<wp:meta_value><![CDATA[11]]></wp:meta_value>
+		</wp:postmeta>
+	</item>
+	<item>
+		<title>cover</title>
+		<wp:post_id>11</wp:post_id>
+		<wp:status>inherit</wp:status>
+		<wp:post_parent>10</wp:post_parent>
+		<wp:post_type>attachment</wp:post_type>
+		<wp:attachment_url>https://old.example.com/wp-content/uploads/2019/05/cover.jpg</wp:attachment_url>
+	</item>
+</channel>
+</rss>`
+
+func TestParse(t *testing.T) {
+	// Given
+
+	// When
+	ch, err := Parse(strings.NewReader(testWXR))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "Old Blog", ch.Title)
+	assert.Equal(t, "https://old.example.com", ch.Link)
+	assert.Equal(t, []Category{{NiceName: "web-development", Name: "Web Development"}}, ch.Categories)
+	assert.Equal(t, []Tag{{Slug: "golang", Name: "Golang"}}, ch.Tags)
+	assert.Len(t, ch.Items, 2)
+
+	post := ch.Items[0]
+	assert.True(t, post.IsPost())
+	assert.True(t, post.IsPublished())
+	assert.Equal(t, "<p>Hello <strong>World</strong></p>", post.Content)
+	assert.Equal(t, time.Date(2019, 5, 1, 10, 0, 0, 0, time.UTC), post.PublishedAt())
+	assert.Equal(t, []Term{{Domain: "category", NiceName: "web-development", Name: "Web Development"}}, post.Categories())
+	assert.Equal(t, []Term{{Domain: "post_tag", NiceName: "golang", Name: "Golang"}}, post.Tags())
+	assert.Equal(t, "11", post.Meta("_thumbnail_id"))
+	assert.Equal(t, "", post.Meta("_edit_lock"))
+
+	attachment := ch.Items[1]
+	assert.True(t, attachment.IsAttachment())
+	assert.Equal(t, 10, attachment.PostParent)
+	assert.Equal(t, "https://old.example.com/wp-content/uploads/2019/05/cover.jpg", attachment.AttachmentURL)
+}
+
+func TestItem_PublishedAt(t *testing.T) {
+	// Given
+	tests := map[string]struct {
+		item     Item
+		expected time.Time
+	}{
+		"With post date in GMT": {
+			item:     Item{PostDateGMT: "2019-05-01 10:00:00"},
+			expected: time.Date(2019, 5, 1, 10, 0, 0, 0, time.UTC),
+		},
+		"With an empty post date in GMT": {
+			item:     Item{PostDateGMT: "0000-00-00 00:00:00", PubDate: "Wed, 01 May 2019 17:00:00 +0700"},
+			expected: time.Date(2019, 5, 1, 10, 0, 0, 0, time.UTC),
+		},
+		"Without any date": {
+			item:     Item{},
+			expected: time.Time{},
+		},
+	}
+
+	// When
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Then
+			assert.Equal(t, test.expected, test.item.PublishedAt())
+		})
+	}
+}
+
+func TestItem_IsTrashed(t *testing.T) {
+	assert.True(t, Item{Status: "trash"}.IsTrashed())
+	assert.True(t, Item{Status: "auto-draft"}.IsTrashed())
+	assert.False(t, Item{Status: "draft"}.IsTrashed())
+}
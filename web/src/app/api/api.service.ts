@@ -33,4 +33,13 @@ export class ApiService {
     return this.http.post<Attachment>(`${environment.url}/api/v2.1/storage/upload`, formData);
   }
 
+  /**
+   * Call to the RESTful API for counting a page view of the post
+   *
+   * @param slug string
+   */
+  recordView(slug: string): Observable<any> {
+    return this.http.post<any>(`${environment.url}/api/v2.1/views/${slug}`, null);
+  }
+
 }
@@ -0,0 +1,14 @@
+/**
+ * A single level of the navigation path to the post
+ */
+interface Breadcrumb {
+  /**
+   * Name of the category or title of the post
+   */
+  name: string;
+
+  /**
+   * A URL path of the category or post
+   */
+  path: string;
+}
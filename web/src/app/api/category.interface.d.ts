@@ -12,8 +12,38 @@ interface Category {
    */
   slug: string;
 
+  /**
+   * A parent category, null if the category is a root category
+   */
+  parent: Category | null;
+
+  /**
+   * List of direct sub-categories
+   */
+  children: Category[];
+
+  /**
+   * List of ancestors from the root category to the direct parent
+   */
+  ancestors: Category[];
+
+  /**
+   * A URL path of the category which composes with slugs of all ancestors
+   */
+  permalink: string;
+
   /**
    * List of latest published posts are belongging to the category
    */
   latestPublishedPosts: Post[];
+
+  /**
+   * Number of published posts in the category
+   */
+  postCount: number;
+
+  /**
+   * Date-time that the latest post in the category was published, null if there is no published post
+   */
+  latestPublishedAt: string | null;
 }
@@ -4,4 +4,9 @@ interface Engagement {
    * Total object shared counter
    */
   shareCount: number;
+
+  /**
+   * Total object comments counter including comments plugin
+   */
+  commentCount: number;
 }
@@ -44,6 +44,11 @@ interface Post {
    */
   categories: Category[];
 
+  /**
+   * A navigation path to the post through the ancestors of its first category
+   */
+  breadcrumbs: Breadcrumb[];
+
   /**
    * List of tags that the post belongging to
    */
@@ -64,6 +69,11 @@ interface Post {
    */
   engagement: Engagement;
 
+  /**
+   * Total number of page views of the post
+   */
+  viewCount: number;
+
   /**
    * Date-time that the post was created
    */
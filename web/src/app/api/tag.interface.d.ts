@@ -16,4 +16,14 @@ interface Tag {
    * List of latest published posts are belongging to the tag
    */
   latestPublishedPosts: Post[];
+
+  /**
+   * Number of published posts in the tag
+   */
+  postCount: number;
+
+  /**
+   * Date-time that the latest post in the tag was published, null if there is no published post
+   */
+  latestPublishedAt: string | null;
 }
@@ -1,5 +1,5 @@
 import { NgModule } from '@angular/core';
-import { RouterModule, Routes } from '@angular/router';
+import { RouterModule, Routes, UrlMatchResult, UrlSegment } from '@angular/router';
 import { AuthGuard } from '../auth';
 import { ArchiveComponent } from './archive';
 import { ContentComponent } from './content.component';
@@ -8,6 +8,28 @@ import { PageNotFoundComponent } from './page-not-found';
 import { RecentPostsComponent } from './recent-posts';
 import { SingleComponent } from './single';
 
+/**
+ * Matches the category URL which reflects the category hierarchy, e.g. "/category/programming/go/concurrency/2",
+ * only the last category slug is used for querying since the slug composes with the category ID
+ *
+ * @param segments List of URL segments
+ */
+export function categoryMatcher(segments: UrlSegment[]): UrlMatchResult {
+  if (segments.length < 2 || segments[0].path !== 'category') {
+    return null;
+  }
+
+  const slugs: UrlSegment[] = segments.slice(1);
+  const posParams: { [name: string]: UrlSegment } = {};
+
+  if (slugs.length > 1 && /^\d+$/.test(slugs[slugs.length - 1].path)) {
+    posParams.page = slugs.pop();
+  }
+  posParams.slug = slugs[slugs.length - 1];
+
+  return { consumed: segments, posParams };
+}
+
 const routes: Routes = [
   {
     path: '', component: ContentComponent,
@@ -30,14 +52,7 @@ const routes: Routes = [
         data: { from: 'all' },
       },
       {
-        path: 'category/:slug',
-        pathMatch: 'full',
-        component: ArchiveComponent,
-        data: { from: 'category' },
-      },
-      {
-        path: 'category/:slug/:page',
-        pathMatch: 'full',
+        matcher: categoryMatcher,
         component: ArchiveComponent,
         data: { from: 'category' },
       },
@@ -2,6 +2,7 @@ import { CommonModule } from '@angular/common';
 import { HTTP_INTERCEPTORS } from '@angular/common/http';
 import { NgModule } from '@angular/core';
 import { FontAwesomeModule } from '@fortawesome/angular-fontawesome';
+import { ApiModule } from '../api/api.module';
 import { AuthModule } from '../auth';
 import { GraphQLModule } from '../graphql';
 import { AppHttpInterceptor } from '../index';
@@ -17,6 +18,7 @@ import { SingleComponent } from './single';
 
 @NgModule({
   imports: [
+    ApiModule,
     AuthModule,
     CommonModule,
     ContentRoutingModule,
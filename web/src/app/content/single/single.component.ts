@@ -5,6 +5,7 @@ import { Apollo } from 'apollo-angular';
 import { ApolloQueryResult } from 'apollo-client';
 import gql from 'graphql-tag';
 import { finalize, map } from 'rxjs/operators';
+import { ApiService } from 'src/app/api/api.service';
 import { environment } from 'src/environments/environment';
 
 @Component({
@@ -22,6 +23,7 @@ export class SingleComponent implements OnInit {
 
   constructor(
     private apollo: Apollo,
+    private api: ApiService,
     private route: ActivatedRoute,
     private title: Title,
     private changeDetectorRef: ChangeDetectorRef,
@@ -60,6 +62,7 @@ export class SingleComponent implements OnInit {
             tags { name slug }
             featuredImage { slug }
             engagement { shareCount }
+            viewCount
           }
         }
       `,
@@ -73,6 +76,10 @@ export class SingleComponent implements OnInit {
       this.post = post;
 
       this.title.setTitle(`${post.title} - ${environment.title}`);
+
+      if (post.status === 'PUBLISHED') {
+        this.api.recordView(post.slug).subscribe({ error: (): void => { } });
+      }
     });
   }
 }